@@ -1,6 +1,6 @@
 #!/usr/bin/env bash
 
 mkdir -p ./data/artifact/artifacts/gloo-system
-mkdir -p ./data/config/{gateways,proxies,upstreams,upstreamgroups,routetables,authconfigs}/gloo-system
+mkdir -p ./data/config/{gateways,proxies,upstreams,upstreamgroups,routetables,virtualhostoptions,routeoptions,authconfigs}/gloo-system
 mkdir -p ./data/secret/secrets/{default,gloo-system}
 
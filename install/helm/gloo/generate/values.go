@@ -25,12 +25,13 @@ type Config struct {
 }
 
 type Global struct {
-	Image      *Image      `json:"image,omitempty"`
-	Extensions interface{} `json:"extensions,omitempty"`
-	GlooRbac   *Rbac       `json:"glooRbac,omitempty"`
-	Wasm       Wasm        `json:"wasm,omitempty"`
-	GlooStats  Stats       `json:"glooStats,omitempty" desc:"Config used as the default values for Prometheus stats published from Gloo pods. Can be overridden by individual deployments"`
-	GlooMtls   Mtls        `json:"glooMtls,omitempty" desc:"Config used to enable internal mtls authentication (currently just Gloo to Envoy communication)"`
+	Image         *Image        `json:"image,omitempty"`
+	Extensions    interface{}   `json:"extensions,omitempty"`
+	GlooRbac      *Rbac         `json:"glooRbac,omitempty"`
+	Wasm          Wasm          `json:"wasm,omitempty"`
+	GlooStats     Stats         `json:"glooStats,omitempty" desc:"Config used as the default values for Prometheus stats published from Gloo pods. Can be overridden by individual deployments"`
+	GlooMtls      Mtls          `json:"glooMtls,omitempty" desc:"Config used to enable internal mtls authentication (currently just Gloo to Envoy communication)"`
+	ConsulConnect ConsulConnect `json:"consulConnect,omitempty" desc:"Config used to originate Consul Connect mTLS from the gateway proxies to connect enabled consul upstreams"`
 }
 
 type Namespace struct {
@@ -354,3 +355,9 @@ type SdsContainer struct {
 type EnvoySidecarContainer struct {
 	Image *Image `json:"image,omitempty"`
 }
+
+type ConsulConnect struct {
+	Enabled     bool   `json:"enabled" desc:"Runs the sds sidecar next to the gateway proxies to serve them the Consul Connect certificates, and configures Gloo to originate Consul Connect mTLS to connect enabled consul upstreams through it"`
+	Service     string `json:"service,omitempty" desc:"the name of the consul service the gateway proxies identify as, which must be allowed by the Consul intentions of the upstream services"`
+	HttpAddress string `json:"httpAddress,omitempty" desc:"the address of the HTTP API of the consul agent the sds sidecar fetches the certificates from. Defaults to port 8500 of the node the proxy runs on"`
+}
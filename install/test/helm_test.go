@@ -345,6 +345,86 @@ var _ = Describe("Helm Test", func() {
 				})
 			})
 
+			Context("consul connect settings", func() {
+				var (
+					sdsSidecar = func(containers []v1.Container) *v1.Container {
+						for _, c := range containers {
+							if c.Name == "sds" {
+								return &c
+							}
+						}
+						return nil
+					}
+				)
+
+				It("should add the sds sidecar and cluster to the gateway proxy and configure gloo to use it", func() {
+					prepareMakefile(namespace, helmValues{
+						valuesArgs: []string{"global.consulConnect.enabled=true,global.consulConnect.service=my-gateway"},
+					})
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "Deployment"
+					}).ExpectAll(func(deployment *unstructured.Unstructured) {
+						deploymentObject, err := kuberesource.ConvertUnstructured(deployment)
+						Expect(err).NotTo(HaveOccurred(), fmt.Sprintf("Deployment %+v should be able to convert from unstructured", deployment))
+						structuredDeployment, ok := deploymentObject.(*appsv1.Deployment)
+						Expect(ok).To(BeTrue(), fmt.Sprintf("Deployment %+v should be able to cast to a structured deployment", deployment))
+
+						if structuredDeployment.GetName() == "gateway-proxy" {
+							sds := sdsSidecar(structuredDeployment.Spec.Template.Spec.Containers)
+							Expect(sds).NotTo(BeNil())
+							Expect(sds.Env).To(ContainElement(v1.EnvVar{Name: "GLOO_MTLS_ENABLED", Value: "false"}))
+							Expect(sds.Env).To(ContainElement(v1.EnvVar{Name: "CONSUL_CONNECT_SERVICE", Value: "my-gateway"}))
+							Expect(sds.Env).To(ContainElement(v1.EnvVar{Name: "CONSUL_HTTP_ADDR", Value: "$(HOST_IP):8500"}))
+							Expect(sds.VolumeMounts).To(BeEmpty())
+						}
+					})
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "ConfigMap"
+					}).ExpectAll(func(configMap *unstructured.Unstructured) {
+						configMapObject, err := kuberesource.ConvertUnstructured(configMap)
+						Expect(err).NotTo(HaveOccurred(), fmt.Sprintf("ConfigMap %+v should be able to convert from unstructured", configMap))
+						structuredConfigMap, ok := configMapObject.(*v1.ConfigMap)
+						Expect(ok).To(BeTrue(), fmt.Sprintf("ConfigMap %+v should be able to cast to a structured config map", configMap))
+
+						if structuredConfigMap.GetName() == "gateway-proxy-envoy-config" {
+							Expect(structuredConfigMap.Data["envoy.yaml"]).To(ContainSubstring("- name: gateway_proxy_sds"))
+							Expect(structuredConfigMap.Data["envoy.yaml"]).NotTo(ContainSubstring("transport_socket"))
+						}
+					})
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "Settings"
+					}).ExpectAll(func(settings *unstructured.Unstructured) {
+						clusterName, _, err := unstructured.NestedString(settings.Object, "spec", "consul", "connectSdsClusterName")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(clusterName).To(Equal("gateway_proxy_sds"))
+					})
+				})
+
+				It("should use the configured consul agent address", func() {
+					prepareMakefile(namespace, helmValues{
+						valuesArgs: []string{"global.consulConnect.enabled=true,global.consulConnect.httpAddress=consul.consul.svc:8500"},
+					})
+
+					testManifest.SelectResources(func(resource *unstructured.Unstructured) bool {
+						return resource.GetKind() == "Deployment"
+					}).ExpectAll(func(deployment *unstructured.Unstructured) {
+						deploymentObject, err := kuberesource.ConvertUnstructured(deployment)
+						Expect(err).NotTo(HaveOccurred(), fmt.Sprintf("Deployment %+v should be able to convert from unstructured", deployment))
+						structuredDeployment, ok := deploymentObject.(*appsv1.Deployment)
+						Expect(ok).To(BeTrue(), fmt.Sprintf("Deployment %+v should be able to cast to a structured deployment", deployment))
+
+						if structuredDeployment.GetName() == "gateway-proxy" {
+							sds := sdsSidecar(structuredDeployment.Spec.Template.Spec.Containers)
+							Expect(sds).NotTo(BeNil())
+							Expect(sds.Env).To(ContainElement(v1.EnvVar{Name: "CONSUL_HTTP_ADDR", Value: "consul.consul.svc:8500"}))
+						}
+					})
+				})
+			})
+
 			Context("gateway", func() {
 				var labels map[string]string
 				BeforeEach(func() {
@@ -104,6 +104,11 @@ var _ = Describe("RBAC Test", func() {
 				Context("cluster scope", func() {
 					It("role", func() {
 						resourceBuilder.Name += "-" + namespace
+						resourceBuilder.Rules = append(resourceBuilder.Rules, rbacv1.PolicyRule{
+							APIGroups: []string{""},
+							Resources: []string{"nodes"},
+							Verbs:     []string{"get", "list", "watch"},
+						})
 						prepareMakefile("global.glooRbac.namespaced=false")
 						testManifest.ExpectClusterRole(resourceBuilder.GetClusterRole())
 					})
@@ -398,7 +403,7 @@ var _ = Describe("RBAC Test", func() {
 						Rules: []rbacv1.PolicyRule{
 							{
 								APIGroups: []string{"gateway.solo.io"},
-								Resources: []string{"virtualservices", "routetables"},
+								Resources: []string{"virtualservices", "routetables", "virtualhostoptions", "routeoptions"},
 								Verbs:     []string{"get", "list", "watch", "update"},
 							}, {
 								APIGroups: []string{"gateway.solo.io"},
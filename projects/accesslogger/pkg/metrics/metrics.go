@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	envoy_data_accesslog_v2 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
+	envoyals "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/solo-io/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/loggingservice"
+	ocstats "go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+// the views are exported with the rest of the access logger's stats, on the /metrics endpoint of its debug port.
+func init() {
+	view.Register(UpstreamRequestsView, UpstreamRequestDurationView, UpstreamResponseTimeView)
+}
+
+var (
+	UpstreamClusterKey, _ = tag.NewKey("upstream_cluster")
+	RouteNameKey, _       = tag.NewKey("route_name")
+	StatusClassKey, _     = tag.NewKey("status_class")
+
+	tagKeys = []tag.Key{UpstreamClusterKey, RouteNameKey, StatusClassKey}
+
+	latencyBucketsMs = view.Distribution(1, 2, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000, 60000, 300000)
+
+	mUpstreamRequests    = ocstats.Int64("gloo.solo.io/accesslogging/upstream_requests", "The number of requests per upstream cluster and route.", ocstats.UnitDimensionless)
+	UpstreamRequestsView = &view.View{
+		Name:        "gloo.solo.io/accesslogging/upstream_requests",
+		Measure:     mUpstreamRequests,
+		Description: "The number of requests per upstream cluster and route.",
+		Aggregation: view.Count(),
+		TagKeys:     tagKeys,
+	}
+
+	mUpstreamRequestDuration    = ocstats.Int64("gloo.solo.io/accesslogging/upstream_request_duration", "The time from the start of the request to the last byte of the response sent downstream (ms).", ocstats.UnitMilliseconds)
+	UpstreamRequestDurationView = &view.View{
+		Name:        "gloo.solo.io/accesslogging/upstream_request_duration",
+		Measure:     mUpstreamRequestDuration,
+		Description: "The time from the start of the request to the last byte of the response sent downstream (ms).",
+		Aggregation: latencyBucketsMs,
+		TagKeys:     tagKeys,
+	}
+
+	mUpstreamResponseTime    = ocstats.Int64("gloo.solo.io/accesslogging/upstream_response_time", "The time from the last byte of the request sent upstream to the first byte of the response received (ms).", ocstats.UnitMilliseconds)
+	UpstreamResponseTimeView = &view.View{
+		Name:        "gloo.solo.io/accesslogging/upstream_response_time",
+		Measure:     mUpstreamResponseTime,
+		Description: "The time from the last byte of the request sent upstream to the first byte of the response received (ms).",
+		Aggregation: latencyBucketsMs,
+		TagKeys:     tagKeys,
+	}
+)
+
+var _ loggingservice.AlsCallback = Callback
+
+// Callback records the request count, status class and latencies of each http access log entry,
+// keyed by upstream cluster and route name.
+func Callback(ctx context.Context, message *envoyals.StreamAccessLogsMessage) error {
+	for _, entry := range message.GetHttpLogs().GetLogEntry() {
+		record(ctx, entry)
+	}
+	return nil
+}
+
+func record(ctx context.Context, entry *envoy_data_accesslog_v2.HTTPAccessLogEntry) {
+	properties := entry.GetCommonProperties()
+	tags := []tag.Mutator{
+		tag.Insert(UpstreamClusterKey, properties.GetUpstreamCluster()),
+		tag.Insert(RouteNameKey, properties.GetRouteName()),
+		tag.Insert(StatusClassKey, StatusClass(entry.GetResponse().GetResponseCode().GetValue())),
+	}
+
+	utils.MeasureOne(ctx, mUpstreamRequests, tags...)
+
+	if requestDuration, ok := durationMs(properties.GetTimeToLastDownstreamTxByte()); ok {
+		utils.Measure(ctx, mUpstreamRequestDuration, requestDuration, tags...)
+	}
+
+	// requests that were not forwarded upstream have no upstream timings
+	lastUpstreamTx, sent := durationMs(properties.GetTimeToLastUpstreamTxByte())
+	firstUpstreamRx, received := durationMs(properties.GetTimeToFirstUpstreamRxByte())
+	if sent && received {
+		responseTime := firstUpstreamRx - lastUpstreamTx
+		// the upstream may respond before it has received the whole request
+		if responseTime < 0 {
+			responseTime = 0
+		}
+		utils.Measure(ctx, mUpstreamResponseTime, responseTime, tags...)
+	}
+}
+
+// StatusClass returns the class of an http response code, e.g. "2xx", or "none" if no response was sent.
+func StatusClass(responseCode uint32) string {
+	if responseCode < 100 || responseCode >= 600 {
+		return "none"
+	}
+	return fmt.Sprintf("%dxx", responseCode/100)
+}
+
+func durationMs(d *duration.Duration) (int64, bool) {
+	if d == nil {
+		return 0, false
+	}
+	converted, err := ptypes.Duration(d)
+	if err != nil {
+		return 0, false
+	}
+	return int64(converted / time.Millisecond), true
+}
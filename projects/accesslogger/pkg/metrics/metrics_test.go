@@ -0,0 +1,101 @@
+package metrics_test
+
+import (
+	"context"
+	"time"
+
+	envoy_data_accesslog_v2 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
+	envoyals "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	. "github.com/solo-io/gloo/projects/accesslogger/pkg/metrics"
+)
+
+var _ = Describe("Metrics", func() {
+
+	entry := func(cluster, route string, code uint32, upstreamTx, upstreamRx, downstreamTx time.Duration) *envoy_data_accesslog_v2.HTTPAccessLogEntry {
+		return &envoy_data_accesslog_v2.HTTPAccessLogEntry{
+			CommonProperties: &envoy_data_accesslog_v2.AccessLogCommon{
+				UpstreamCluster:            cluster,
+				RouteName:                  route,
+				TimeToLastUpstreamTxByte:   ptypes.DurationProto(upstreamTx),
+				TimeToFirstUpstreamRxByte:  ptypes.DurationProto(upstreamRx),
+				TimeToLastDownstreamTxByte: ptypes.DurationProto(downstreamTx),
+			},
+			Response: &envoy_data_accesslog_v2.HTTPResponseProperties{
+				ResponseCode: &wrappers.UInt32Value{Value: code},
+			},
+		}
+	}
+
+	message := func(entries ...*envoy_data_accesslog_v2.HTTPAccessLogEntry) *envoyals.StreamAccessLogsMessage {
+		return &envoyals.StreamAccessLogsMessage{
+			LogEntries: &envoyals.StreamAccessLogsMessage_HttpLogs{
+				HttpLogs: &envoyals.StreamAccessLogsMessage_HTTPAccessLogEntries{LogEntry: entries},
+			},
+		}
+	}
+
+	rowsFor := func(v *view.View, cluster string) map[string]view.AggregationData {
+		rows, err := view.RetrieveData(v.Name)
+		Expect(err).NotTo(HaveOccurred())
+		data := map[string]view.AggregationData{}
+		for _, row := range rows {
+			tags := map[tag.Key]string{}
+			for _, t := range row.Tags {
+				tags[t.Key] = t.Value
+			}
+			if tags[UpstreamClusterKey] == cluster {
+				data[tags[RouteNameKey]+" "+tags[StatusClassKey]] = row.Data
+			}
+		}
+		return data
+	}
+
+	It("aggregates requests by upstream cluster, route and status class", func() {
+		err := Callback(context.TODO(), message(
+			entry("aggregates-us", "route-a", 200, time.Millisecond, 11*time.Millisecond, 12*time.Millisecond),
+			entry("aggregates-us", "route-a", 204, time.Millisecond, 31*time.Millisecond, 32*time.Millisecond),
+			entry("aggregates-us", "route-a", 503, time.Millisecond, 2*time.Millisecond, 3*time.Millisecond),
+			entry("aggregates-us", "route-b", 200, time.Millisecond, 2*time.Millisecond, 3*time.Millisecond),
+		))
+		Expect(err).NotTo(HaveOccurred())
+
+		requests := rowsFor(UpstreamRequestsView, "aggregates-us")
+		Expect(requests).To(HaveLen(3))
+		Expect(requests["route-a 2xx"].(*view.CountData).Value).To(Equal(int64(2)))
+		Expect(requests["route-a 5xx"].(*view.CountData).Value).To(Equal(int64(1)))
+		Expect(requests["route-b 2xx"].(*view.CountData).Value).To(Equal(int64(1)))
+
+		responseTimes := rowsFor(UpstreamResponseTimeView, "aggregates-us")
+		routeA := responseTimes["route-a 2xx"].(*view.DistributionData)
+		Expect(routeA.Count).To(Equal(int64(2)))
+		Expect(routeA.Mean).To(Equal(float64(20)))
+
+		durations := rowsFor(UpstreamRequestDurationView, "aggregates-us")
+		Expect(durations["route-a 2xx"].(*view.DistributionData).Max).To(Equal(float64(32)))
+	})
+
+	It("does not record upstream response times for requests that were not forwarded", func() {
+		notForwarded := entry("not-forwarded-us", "route", 404, 0, 0, time.Millisecond)
+		notForwarded.CommonProperties.TimeToLastUpstreamTxByte = nil
+		notForwarded.CommonProperties.TimeToFirstUpstreamRxByte = nil
+		Expect(Callback(context.TODO(), message(notForwarded))).NotTo(HaveOccurred())
+
+		Expect(rowsFor(UpstreamRequestsView, "not-forwarded-us")).To(HaveLen(1))
+		Expect(rowsFor(UpstreamRequestDurationView, "not-forwarded-us")).To(HaveLen(1))
+		Expect(rowsFor(UpstreamResponseTimeView, "not-forwarded-us")).To(BeEmpty())
+	})
+
+	It("classifies response codes", func() {
+		Expect(StatusClass(101)).To(Equal("1xx"))
+		Expect(StatusClass(302)).To(Equal("3xx"))
+		Expect(StatusClass(429)).To(Equal("4xx"))
+		Expect(StatusClass(0)).To(Equal("none"))
+	})
+})
@@ -11,6 +11,7 @@ import (
 	_struct "github.com/golang/protobuf/ptypes/struct"
 	"github.com/solo-io/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/accesslogger/pkg/loggingservice"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/metrics"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/healthchecker"
@@ -167,9 +168,20 @@ func Run() {
 		},
 		Ctx: ctx,
 	}
+
+	if clientSettings.UpstreamMetrics {
+		opts.Callbacks = append(opts.Callbacks, metrics.Callback)
+	}
+
+	sinks, err := sinkCallbacks(ctx, clientSettings)
+	if err != nil {
+		panic(err)
+	}
+	opts.Callbacks = append(opts.Callbacks, sinks...)
+
 	service := loggingservice.NewServer(opts)
 
-	err := RunWithSettings(ctx, service, clientSettings)
+	err = RunWithSettings(ctx, service, clientSettings)
 
 	if err != nil {
 		if ctx.Err() == nil {
@@ -1,6 +1,8 @@
 package runner
 
 import (
+	"time"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
@@ -8,6 +10,28 @@ type Settings struct {
 	DebugPort   int    `envconfig:"DEBUG_PORT" default:"9091"`
 	ServerPort  int    `envconfig:"SERVER_PORT" default:"8083"`
 	ServiceName string `envconfig:"SERVICE_NAME" default:"AccessLog"`
+
+	// when true, request counts, status classes and latencies are aggregated per upstream cluster and route,
+	// and exported on the /metrics endpoint of the debug port
+	UpstreamMetrics bool `envconfig:"UPSTREAM_METRICS" default:"true"`
+
+	// access logs are written to each sink that is configured, in addition to the logger.
+	// when set, access logs are written as lines of JSON to this file, which is rotated by size and age.
+	FileSinkPath         string        `envconfig:"FILE_SINK_PATH"`
+	FileSinkMaxSizeBytes int64         `envconfig:"FILE_SINK_MAX_SIZE_BYTES" default:"104857600"`
+	FileSinkMaxAge       time.Duration `envconfig:"FILE_SINK_MAX_AGE" default:"168h"`
+	FileSinkMaxBackups   int           `envconfig:"FILE_SINK_MAX_BACKUPS" default:"5"`
+	// when true, access logs are written as lines of JSON to stdout
+	StdoutSink bool `envconfig:"STDOUT_SINK" default:"false"`
+	// when set, batches of access logs are POSTed to this url as JSON arrays
+	WebhookSinkUrl     string        `envconfig:"WEBHOOK_SINK_URL"`
+	WebhookSinkTimeout time.Duration `envconfig:"WEBHOOK_SINK_TIMEOUT" default:"10s"`
+
+	// batching of the sinks. when a sink falls behind by more than SINK_BUFFER_SIZE records,
+	// new records are dropped for that sink rather than slowing down the access log stream.
+	SinkBatchSize     int           `envconfig:"SINK_BATCH_SIZE" default:"100"`
+	SinkFlushInterval time.Duration `envconfig:"SINK_FLUSH_INTERVAL" default:"1s"`
+	SinkBufferSize    int           `envconfig:"SINK_BUFFER_SIZE" default:"10000"`
 }
 
 func NewSettings() Settings {
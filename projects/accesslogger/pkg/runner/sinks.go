@@ -0,0 +1,41 @@
+package runner
+
+import (
+	"context"
+	"os"
+
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/loggingservice"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
+)
+
+// returns a callback for each sink enabled in the settings
+func sinkCallbacks(ctx context.Context, settings Settings) (loggingservice.AlsCallbackList, error) {
+	batchOpts := sinks.BatchOptions{
+		BatchSize:     settings.SinkBatchSize,
+		FlushInterval: settings.SinkFlushInterval,
+		BufferSize:    settings.SinkBufferSize,
+	}
+
+	var callbacks loggingservice.AlsCallbackList
+	if settings.FileSinkPath != "" {
+		writer, err := sinks.NewRotatingFileWriter(sinks.RotatingFileOptions{
+			Path:         settings.FileSinkPath,
+			MaxSizeBytes: settings.FileSinkMaxSizeBytes,
+			MaxAge:       settings.FileSinkMaxAge,
+			MaxBackups:   settings.FileSinkMaxBackups,
+		})
+		if err != nil {
+			return nil, err
+		}
+		callbacks = append(callbacks, sinks.NewBufferedSink(ctx, "file_sink", writer, batchOpts).Callback)
+	}
+	if settings.StdoutSink {
+		writer := sinks.NewJsonLinesWriter(os.Stdout)
+		callbacks = append(callbacks, sinks.NewBufferedSink(ctx, "stdout_sink", writer, batchOpts).Callback)
+	}
+	if settings.WebhookSinkUrl != "" {
+		writer := sinks.NewWebhookWriter(settings.WebhookSinkUrl, settings.WebhookSinkTimeout)
+		callbacks = append(callbacks, sinks.NewBufferedSink(ctx, "webhook_sink", writer, batchOpts).Callback)
+	}
+	return callbacks, nil
+}
@@ -0,0 +1,138 @@
+package sinks
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	envoyals "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v2"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/loggingservice"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+)
+
+// Writer writes batches of records to a destination.
+type Writer interface {
+	Write(ctx context.Context, records []*Record) error
+	Close() error
+}
+
+type BatchOptions struct {
+	// the maximum number of records passed to the writer at once
+	BatchSize int
+	// the maximum time a record waits before being passed to the writer
+	FlushInterval time.Duration
+	// the maximum number of records waiting to be written. records that arrive while the buffer
+	// is full are dropped, so that a slow writer cannot block the access log stream.
+	BufferSize int
+}
+
+// BufferedSink buffers the records of access log messages and writes them in batches from
+// its own goroutine.
+type BufferedSink struct {
+	name    string
+	writer  Writer
+	opts    BatchOptions
+	records chan *Record
+	done    chan struct{}
+	dropped uint64
+}
+
+// NewBufferedSink starts writing the records added to the sink until the context is cancelled.
+func NewBufferedSink(ctx context.Context, name string, writer Writer, opts BatchOptions) *BufferedSink {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 1
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = time.Second
+	}
+	if opts.BufferSize < 0 {
+		opts.BufferSize = 0
+	}
+	s := &BufferedSink{
+		name:    name,
+		writer:  writer,
+		opts:    opts,
+		records: make(chan *Record, opts.BufferSize),
+		done:    make(chan struct{}),
+	}
+	go s.run(contextutils.WithLogger(ctx, name))
+	return s
+}
+
+var _ loggingservice.AlsCallback = new(BufferedSink).Callback
+
+// Callback adds the records of the message to the buffer without blocking.
+func (s *BufferedSink) Callback(ctx context.Context, message *envoyals.StreamAccessLogsMessage) error {
+	records, err := RecordsFromMessage(message)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		select {
+		case s.records <- record:
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
+	}
+	return nil
+}
+
+// Dropped returns the number of records dropped because the buffer was full.
+func (s *BufferedSink) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Done is closed once the sink has written its remaining records and closed its writer.
+func (s *BufferedSink) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *BufferedSink) run(ctx context.Context) {
+	defer close(s.done)
+	logger := contextutils.LoggerFrom(ctx)
+
+	ticker := time.NewTicker(s.opts.FlushInterval)
+	defer ticker.Stop()
+
+	var reportedDropped uint64
+	batch := make([]*Record, 0, s.opts.BatchSize)
+	flush := func(ctx context.Context) {
+		if dropped := s.Dropped(); dropped > reportedDropped {
+			logger.Warnw("access log buffer is full, dropped records", zap.Uint64("dropped", dropped-reportedDropped))
+			reportedDropped = dropped
+		}
+		if len(batch) == 0 {
+			return
+		}
+		if err := s.writer.Write(ctx, batch); err != nil {
+			logger.Errorw("failed to write access logs", zap.Int("records", len(batch)), zap.Error(err))
+		}
+		batch = make([]*Record, 0, s.opts.BatchSize)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			// write what is already buffered, the stream is gone
+			for len(s.records) > 0 {
+				batch = append(batch, <-s.records)
+				if len(batch) >= s.opts.BatchSize {
+					flush(context.Background())
+				}
+			}
+			flush(context.Background())
+			if err := s.writer.Close(); err != nil {
+				logger.Errorw("failed to close access log writer", zap.Error(err))
+			}
+			return
+		case record := <-s.records:
+			batch = append(batch, record)
+			if len(batch) >= s.opts.BatchSize {
+				flush(ctx)
+			}
+		case <-ticker.C:
+			flush(ctx)
+		}
+	}
+}
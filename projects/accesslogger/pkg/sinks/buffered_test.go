@@ -0,0 +1,135 @@
+package sinks_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	envoy_data_accesslog_v2 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
+	envoyals "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
+)
+
+type recordingWriter struct {
+	mu      sync.Mutex
+	batches [][]*Record
+	block   chan struct{}
+	closed  bool
+}
+
+func (w *recordingWriter) Write(_ context.Context, records []*Record) error {
+	if w.block != nil {
+		<-w.block
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.batches = append(w.batches, records)
+	return nil
+}
+
+func (w *recordingWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	return nil
+}
+
+func (w *recordingWriter) Batches() [][]*Record {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.batches
+}
+
+func httpLogs(paths ...string) *envoyals.StreamAccessLogsMessage {
+	var entries []*envoy_data_accesslog_v2.HTTPAccessLogEntry
+	for _, path := range paths {
+		entries = append(entries, &envoy_data_accesslog_v2.HTTPAccessLogEntry{
+			Request: &envoy_data_accesslog_v2.HTTPRequestProperties{Path: path},
+		})
+	}
+	return &envoyals.StreamAccessLogsMessage{
+		Identifier: &envoyals.StreamAccessLogsMessage_Identifier{LogName: "test"},
+		LogEntries: &envoyals.StreamAccessLogsMessage_HttpLogs{
+			HttpLogs: &envoyals.StreamAccessLogsMessage_HTTPAccessLogEntries{LogEntry: entries},
+		},
+	}
+}
+
+var _ = Describe("BufferedSink", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("converts access log entries to records", func() {
+		records, err := RecordsFromMessage(httpLogs("/a"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].LogName).To(Equal("test"))
+		Expect(string(records[0].Http)).To(MatchJSON(`{"request": {"path": "/a"}}`))
+		Expect(records[0].Tcp).To(BeNil())
+	})
+
+	It("writes full batches", func() {
+		writer := &recordingWriter{}
+		sink := NewBufferedSink(ctx, "test", writer, BatchOptions{BatchSize: 2, FlushInterval: time.Hour, BufferSize: 10})
+
+		Expect(sink.Callback(ctx, httpLogs("/a", "/b", "/c"))).NotTo(HaveOccurred())
+		Eventually(writer.Batches).Should(HaveLen(1))
+		Expect(writer.Batches()[0]).To(HaveLen(2))
+		Consistently(writer.Batches, "100ms").Should(HaveLen(1))
+	})
+
+	It("writes partial batches after the flush interval", func() {
+		writer := &recordingWriter{}
+		sink := NewBufferedSink(ctx, "test", writer, BatchOptions{BatchSize: 10, FlushInterval: 50 * time.Millisecond, BufferSize: 10})
+
+		Expect(sink.Callback(ctx, httpLogs("/a"))).NotTo(HaveOccurred())
+		Eventually(writer.Batches).Should(HaveLen(1))
+		Expect(writer.Batches()[0]).To(HaveLen(1))
+	})
+
+	It("drops records instead of blocking when the writer falls behind", func() {
+		writer := &recordingWriter{block: make(chan struct{})}
+		sink := NewBufferedSink(ctx, "test", writer, BatchOptions{BatchSize: 1, FlushInterval: time.Hour, BufferSize: 2})
+
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			for i := 0; i < 10; i++ {
+				Expect(sink.Callback(ctx, httpLogs("/a"))).NotTo(HaveOccurred())
+			}
+		}()
+		Eventually(done).Should(BeClosed())
+		// one record is held by the blocked writer, two are buffered
+		Expect(sink.Dropped()).To(BeNumerically(">=", 7))
+		close(writer.block)
+	})
+
+	It("writes the buffered records and closes the writer when the context is cancelled", func() {
+		writer := &recordingWriter{}
+		sink := NewBufferedSink(ctx, "test", writer, BatchOptions{BatchSize: 10, FlushInterval: time.Hour, BufferSize: 10})
+
+		Expect(sink.Callback(ctx, httpLogs("/a", "/b"))).NotTo(HaveOccurred())
+		cancel()
+		Eventually(sink.Done()).Should(BeClosed())
+		var written int
+		for _, batch := range writer.Batches() {
+			written += len(batch)
+		}
+		Expect(written).To(Equal(2))
+		Expect(writer.closed).To(BeTrue())
+	})
+})
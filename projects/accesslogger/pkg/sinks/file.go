@@ -0,0 +1,136 @@
+package sinks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const backupTimeFormat = "2006-01-02T15-04-05.000000000"
+
+type RotatingFileOptions struct {
+	// the file that records are written to
+	Path string
+	// the file is rotated before it grows past this size. 0 disables rotation by size.
+	MaxSizeBytes int64
+	// the file is rotated once it has been written to for this long, and rotated files older than this are
+	// removed. 0 disables rotation by age and keeps rotated files regardless of age.
+	MaxAge time.Duration
+	// at most this many rotated files are kept. 0 keeps all rotated files.
+	MaxBackups int
+}
+
+// RotatingFileWriter writes records as lines of JSON to a file. when the file would grow past its
+// maximum size, or has been written to for longer than its maximum age, it is renamed with a timestamp
+// suffix and a new file is started.
+type RotatingFileWriter struct {
+	opts     RotatingFileOptions
+	file     *os.File
+	size     int64
+	openedAt time.Time
+	now      func() time.Time
+}
+
+func NewRotatingFileWriter(opts RotatingFileOptions) (*RotatingFileWriter, error) {
+	w := &RotatingFileWriter{opts: opts, now: time.Now}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *RotatingFileWriter) Write(_ context.Context, records []*Record) error {
+	for _, record := range records {
+		line, err := jsonLine(record)
+		if err != nil {
+			return err
+		}
+		if w.size > 0 && (w.exceedsMaxSize(int64(len(line))) || w.exceedsMaxAge()) {
+			if err := w.rotate(); err != nil {
+				return err
+			}
+		}
+		n, err := w.file.Write(line)
+		w.size += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *RotatingFileWriter) Close() error {
+	return w.file.Close()
+}
+
+func (w *RotatingFileWriter) exceedsMaxSize(lineLength int64) bool {
+	return w.opts.MaxSizeBytes > 0 && w.size+lineLength > w.opts.MaxSizeBytes
+}
+
+func (w *RotatingFileWriter) exceedsMaxAge() bool {
+	return w.opts.MaxAge > 0 && w.now().Sub(w.openedAt) >= w.opts.MaxAge
+}
+
+func (w *RotatingFileWriter) open() error {
+	if err := os.MkdirAll(filepath.Dir(w.opts.Path), 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(w.opts.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	w.file = file
+	w.size = info.Size()
+	w.openedAt = w.now()
+	return nil
+}
+
+func (w *RotatingFileWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(w.opts.Path, w.opts.Path+"."+w.now().UTC().Format(backupTimeFormat)); err != nil {
+		return err
+	}
+	if err := w.open(); err != nil {
+		return err
+	}
+	return w.removeOldBackups()
+}
+
+func (w *RotatingFileWriter) removeOldBackups() error {
+	matches, err := filepath.Glob(w.opts.Path + ".*")
+	if err != nil {
+		return err
+	}
+	var backups []string
+	for _, match := range matches {
+		if _, err := backupTime(w.opts.Path, match); err == nil {
+			backups = append(backups, match)
+		}
+	}
+	// the timestamp suffixes sort chronologically, newest first
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+	for i, backup := range backups {
+		rotatedAt, _ := backupTime(w.opts.Path, backup)
+		expired := w.opts.MaxAge > 0 && w.now().Sub(rotatedAt) > w.opts.MaxAge
+		if expired || (w.opts.MaxBackups > 0 && i >= w.opts.MaxBackups) {
+			if err := os.Remove(backup); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func backupTime(path, backup string) (time.Time, error) {
+	return time.Parse(backupTimeFormat, strings.TrimPrefix(backup, path+"."))
+}
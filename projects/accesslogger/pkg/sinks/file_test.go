@@ -0,0 +1,114 @@
+package sinks_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
+)
+
+var _ = Describe("RotatingFileWriter", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "access-logs")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "access.log")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	record := &Record{LogName: "test"}
+	lineLength := int64(len(`{"log_name":"test"}` + "\n"))
+
+	It("writes records as lines of JSON", func() {
+		writer, err := NewRotatingFileWriter(RotatingFileOptions{Path: path})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(writer.Write(context.TODO(), []*Record{record, record})).NotTo(HaveOccurred())
+		Expect(writer.Close()).NotTo(HaveOccurred())
+
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.Split(strings.TrimSpace(string(contents)), "\n")).To(ConsistOf(`{"log_name":"test"}`, `{"log_name":"test"}`))
+	})
+
+	It("rotates the file before it grows past its maximum size, and keeps the newest backups", func() {
+		writer, err := NewRotatingFileWriter(RotatingFileOptions{
+			Path:         path,
+			MaxSizeBytes: 2 * lineLength,
+			MaxBackups:   2,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		for i := 0; i < 9; i++ {
+			Expect(writer.Write(context.TODO(), []*Record{record})).NotTo(HaveOccurred())
+		}
+		Expect(writer.Close()).NotTo(HaveOccurred())
+
+		info, err := os.Stat(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Size()).To(Equal(lineLength))
+
+		backups, err := filepath.Glob(path + ".*")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backups).To(HaveLen(2))
+		for _, backup := range backups {
+			info, err := os.Stat(backup)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Size()).To(Equal(2 * lineLength))
+		}
+	})
+
+	It("rotates the file once it has been written to for longer than its maximum age, and removes old backups", func() {
+		writer, err := NewRotatingFileWriter(RotatingFileOptions{
+			Path:   path,
+			MaxAge: 200 * time.Millisecond,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(writer.Write(context.TODO(), []*Record{record})).NotTo(HaveOccurred())
+		time.Sleep(250 * time.Millisecond)
+		Expect(writer.Write(context.TODO(), []*Record{record})).NotTo(HaveOccurred())
+
+		firstBackups, err := filepath.Glob(path + ".*")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(firstBackups).To(HaveLen(1))
+
+		time.Sleep(250 * time.Millisecond)
+		Expect(writer.Write(context.TODO(), []*Record{record})).NotTo(HaveOccurred())
+		Expect(writer.Close()).NotTo(HaveOccurred())
+
+		info, err := os.Stat(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Size()).To(Equal(lineLength))
+
+		// the first backup expired when the file was rotated again
+		backups, err := filepath.Glob(path + ".*")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backups).To(HaveLen(1))
+		Expect(backups).NotTo(ContainElement(firstBackups[0]))
+	})
+
+	It("appends to an existing file", func() {
+		Expect(ioutil.WriteFile(path, []byte("existing\n"), 0644)).NotTo(HaveOccurred())
+		writer, err := NewRotatingFileWriter(RotatingFileOptions{Path: path})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(writer.Write(context.TODO(), []*Record{record})).NotTo(HaveOccurred())
+		Expect(writer.Close()).NotTo(HaveOccurred())
+
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(contents)).To(Equal("existing\n" + `{"log_name":"test"}` + "\n"))
+	})
+})
@@ -0,0 +1,59 @@
+package sinks
+
+import (
+	"bytes"
+	"encoding/json"
+
+	envoyals "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v2"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// Record is a single access log entry, as written by the sinks.
+type Record struct {
+	LogName string `json:"log_name,omitempty"`
+	NodeId  string `json:"node_id,omitempty"`
+	// the envoy HTTPAccessLogEntry of an http request, as JSON
+	Http json.RawMessage `json:"http,omitempty"`
+	// the envoy TCPAccessLogEntry of a tcp connection, as JSON
+	Tcp json.RawMessage `json:"tcp,omitempty"`
+}
+
+var marshaler = jsonpb.Marshaler{OrigName: true}
+
+// RecordsFromMessage returns a record for each access log entry in the message.
+func RecordsFromMessage(message *envoyals.StreamAccessLogsMessage) ([]*Record, error) {
+	var records []*Record
+	newRecord := func(entry proto.Message) (*Record, json.RawMessage, error) {
+		var buf bytes.Buffer
+		if err := marshaler.Marshal(&buf, entry); err != nil {
+			return nil, nil, err
+		}
+		return &Record{
+			LogName: message.GetIdentifier().GetLogName(),
+			NodeId:  message.GetIdentifier().GetNode().GetId(),
+		}, buf.Bytes(), nil
+	}
+
+	switch msg := message.GetLogEntries().(type) {
+	case *envoyals.StreamAccessLogsMessage_HttpLogs:
+		for _, entry := range msg.HttpLogs.GetLogEntry() {
+			record, entryJson, err := newRecord(entry)
+			if err != nil {
+				return nil, err
+			}
+			record.Http = entryJson
+			records = append(records, record)
+		}
+	case *envoyals.StreamAccessLogsMessage_TcpLogs:
+		for _, entry := range msg.TcpLogs.GetLogEntry() {
+			record, entryJson, err := newRecord(entry)
+			if err != nil {
+				return nil, err
+			}
+			record.Tcp = entryJson
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,13 @@
+package sinks_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSinks(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Sinks Suite")
+}
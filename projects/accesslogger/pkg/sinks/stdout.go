@@ -0,0 +1,42 @@
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// JsonLinesWriter writes each record as a line of JSON.
+type JsonLinesWriter struct {
+	out io.Writer
+}
+
+func NewJsonLinesWriter(out io.Writer) *JsonLinesWriter {
+	return &JsonLinesWriter{out: out}
+}
+
+func (w *JsonLinesWriter) Write(_ context.Context, records []*Record) error {
+	var lines bytes.Buffer
+	for _, record := range records {
+		line, err := jsonLine(record)
+		if err != nil {
+			return err
+		}
+		lines.Write(line)
+	}
+	_, err := lines.WriteTo(w.out)
+	return err
+}
+
+func (w *JsonLinesWriter) Close() error {
+	return nil
+}
+
+func jsonLine(record *Record) ([]byte, error) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
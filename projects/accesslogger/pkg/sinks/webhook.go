@@ -0,0 +1,60 @@
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/rotisserie/eris"
+)
+
+var (
+	WebhookStatusErr = func(url string, status int) error {
+		return eris.Errorf("access log webhook %v responded with status %v", url, status)
+	}
+)
+
+// WebhookWriter POSTs each batch of records to a url, as a JSON array.
+type WebhookWriter struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookWriter(url string, timeout time.Duration) *WebhookWriter {
+	return &WebhookWriter{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (w *WebhookWriter) Write(ctx context.Context, records []*Record) error {
+	body, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := w.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return WebhookStatusErr(w.url, res.StatusCode)
+	}
+	return nil
+}
+
+func (w *WebhookWriter) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,45 @@
+package sinks_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/accesslogger/pkg/sinks"
+)
+
+var _ = Describe("WebhookWriter", func() {
+	It("posts batches of records as a JSON array", func() {
+		received := make(chan []*Record, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			var records []*Record
+			Expect(json.NewDecoder(r.Body).Decode(&records)).NotTo(HaveOccurred())
+			received <- records
+		}))
+		defer server.Close()
+
+		writer := NewWebhookWriter(server.URL, time.Second)
+		err := writer.Write(context.TODO(), []*Record{{LogName: "a"}, {LogName: "b"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(<-received).To(Equal([]*Record{{LogName: "a"}, {LogName: "b"}}))
+	})
+
+	It("returns an error when the webhook fails", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		writer := NewWebhookWriter(server.URL, time.Second)
+		err := writer.Write(context.TODO(), []*Record{{LogName: "a"}})
+		Expect(err).To(MatchError(WebhookStatusErr(server.URL, http.StatusServiceUnavailable)))
+	})
+})
@@ -217,6 +217,21 @@ type HttpGateway struct {
 	// Restrict the search by providing a list of valid search namespaces here.
 	// Setting '*' will search all namespaces, equivalent to omitting this value.
 	VirtualServiceNamespaces []string `protobuf:"bytes,3,rep,name=virtual_service_namespaces,json=virtualServiceNamespaces,proto3" json:"virtual_service_namespaces,omitempty"`
+	// Reference to a virtual service that handles the requests whose host does not match the domains of any of the
+	// other virtual services of the gateway. Its virtual host is added after all the others, with the `*` domain in
+	// place of its own domains. The virtual service is not required to match `virtualServices` or
+	// `virtualServiceSelector`, but, like the other virtual services, it must have an ssl config if and only if the
+	// gateway is an ssl gateway; otherwise it is ignored and an error is reported on the gateway. If another virtual
+	// service of the gateway claims the `*` domain (or specifies no domains), the conflict is reported on both the
+	// gateway and that virtual service.
+	DefaultVirtualService *core.ResourceRef `protobuf:"bytes,4,opt,name=default_virtual_service,json=defaultVirtualService,proto3" json:"default_virtual_service,omitempty"`
+	// Direct response returned to the requests whose host does not match the domains of any of the virtual services of
+	// the gateway, instead of the default 404 of the proxy. Ignored if `defaultVirtualService` is set, or if a virtual
+	// service of the gateway already claims the `*` domain.
+	UnmatchedHostResponse *v1.DirectResponseAction `protobuf:"bytes,5,opt,name=unmatched_host_response,json=unmatchedHostResponse,proto3" json:"unmatched_host_response,omitempty"`
+	// Direct response returned to the requests whose path does not match any of the routes of the virtual service
+	// that handles their host, instead of the default 404 of the proxy.
+	UnmatchedPathResponse *v1.DirectResponseAction `protobuf:"bytes,6,opt,name=unmatched_path_response,json=unmatchedPathResponse,proto3" json:"unmatched_path_response,omitempty"`
 	// HTTP Gateway configuration
 	Options              *v1.HttpListenerOptions `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
@@ -269,6 +284,27 @@ func (m *HttpGateway) GetVirtualServiceNamespaces() []string {
 	return nil
 }
 
+func (m *HttpGateway) GetDefaultVirtualService() *core.ResourceRef {
+	if m != nil {
+		return m.DefaultVirtualService
+	}
+	return nil
+}
+
+func (m *HttpGateway) GetUnmatchedHostResponse() *v1.DirectResponseAction {
+	if m != nil {
+		return m.UnmatchedHostResponse
+	}
+	return nil
+}
+
+func (m *HttpGateway) GetUnmatchedPathResponse() *v1.DirectResponseAction {
+	if m != nil {
+		return m.UnmatchedPathResponse
+	}
+	return nil
+}
+
 func (m *HttpGateway) GetOptions() *v1.HttpListenerOptions {
 	if m != nil {
 		return m.Options
@@ -336,53 +372,58 @@ func init() {
 }
 
 var fileDescriptor_30f7529f6633771c = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc6, 0x49, 0x80, 0x64, 0x0d, 0x82, 0xae, 0x28, 0x32, 0xe1, 0x2f, 0x44, 0xaa, 0x9a, 0x4b,
-	0x6d, 0x15, 0x0e, 0x45, 0x69, 0xa9, 0x44, 0xa4, 0xaa, 0xf4, 0x8f, 0x22, 0x83, 0x38, 0xf4, 0x12,
-	0x39, 0xce, 0xc6, 0x71, 0x31, 0xd9, 0xd5, 0xee, 0x38, 0x10, 0xa9, 0x27, 0x5e, 0xa1, 0x2f, 0x51,
-	0xf5, 0x09, 0xfa, 0x08, 0x7d, 0x0a, 0x0e, 0x7d, 0x03, 0x2a, 0xf5, 0x5e, 0xed, 0x7a, 0x9d, 0x10,
-	0xd3, 0xa0, 0xde, 0x76, 0xe6, 0x9b, 0xef, 0xf3, 0xcc, 0xec, 0xb7, 0x46, 0x7b, 0x41, 0x08, 0xdd,
-	0xb8, 0x65, 0xfb, 0xf4, 0xdc, 0x11, 0x34, 0xa2, 0x4f, 0x42, 0xea, 0x04, 0x11, 0xa5, 0x0e, 0xe3,
-	0xf4, 0x33, 0xf1, 0x41, 0x38, 0x81, 0x07, 0xe4, 0xc2, 0x1b, 0x38, 0x1e, 0x0b, 0x9d, 0xfe, 0xd3,
-	0x34, 0xb4, 0x19, 0xa7, 0x40, 0xf1, 0x42, 0x1a, 0x4a, 0xae, 0x1d, 0xd2, 0xf2, 0x52, 0x40, 0x03,
-	0xaa, 0x30, 0x47, 0x9e, 0x92, 0xb2, 0x32, 0x26, 0x97, 0x90, 0x24, 0xc9, 0x25, 0xe8, 0xdc, 0x46,
-	0x40, 0x69, 0x10, 0x11, 0x47, 0x45, 0xad, 0xb8, 0xe3, 0x5c, 0x70, 0x8f, 0x31, 0xc2, 0x45, 0x8a,
-	0xab, 0x76, 0xce, 0x42, 0x48, 0xbf, 0x7c, 0x4e, 0xc0, 0x6b, 0x7b, 0xe0, 0x69, 0x7c, 0x2d, 0x8b,
-	0x0b, 0xf0, 0x20, 0x4e, 0xd9, 0x2b, 0x59, 0x94, 0x93, 0xce, 0x24, 0xe1, 0x34, 0xd6, 0xf8, 0xa3,
-	0xcc, 0xfc, 0x32, 0xd2, 0x95, 0x8c, 0xd3, 0x4b, 0x3d, 0x7a, 0xf9, 0xf1, 0xe4, 0x32, 0xca, 0x20,
-	0xa4, 0x3d, 0xdd, 0x4a, 0xf5, 0x7b, 0x01, 0xcd, 0xbe, 0x4e, 0xd6, 0x84, 0x17, 0x51, 0x5e, 0x88,
-	0xc8, 0x32, 0x2a, 0x46, 0xad, 0xe8, 0xca, 0x23, 0xde, 0x42, 0x73, 0xad, 0xb0, 0xd7, 0x6e, 0x7a,
-	0xed, 0x36, 0x27, 0x42, 0x58, 0xf9, 0x8a, 0x51, 0x2b, 0xb9, 0xa6, 0xcc, 0xed, 0x27, 0x29, 0xbc,
-	0x8a, 0x4a, 0xaa, 0x84, 0x51, 0x0e, 0x56, 0xa1, 0x62, 0xd4, 0xe6, 0xdd, 0xa2, 0x4c, 0x1c, 0x51,
-	0x0e, 0xf8, 0x19, 0x9a, 0xd5, 0x9f, 0xb3, 0xa6, 0x2b, 0x46, 0xcd, 0xdc, 0x5e, 0xb7, 0x65, 0x2b,
-	0xe9, 0x85, 0xd8, 0xef, 0x43, 0x01, 0xa4, 0x47, 0xf8, 0xc7, 0xa4, 0xc8, 0x4d, 0xab, 0xf1, 0x3b,
-	0x34, 0x93, 0x6c, 0xcc, 0x9a, 0x51, 0xbc, 0x25, 0xdb, 0xa7, 0x9c, 0x0c, 0x79, 0xc7, 0x0a, 0x6b,
-	0xac, 0xff, 0xf8, 0x53, 0x30, 0x7e, 0x5e, 0x6f, 0x4e, 0xfd, 0xbe, 0xde, 0x7c, 0x00, 0x44, 0x40,
-	0x3b, 0xec, 0x74, 0xea, 0xd5, 0x30, 0xe8, 0x51, 0x4e, 0xaa, 0xae, 0x96, 0xc0, 0xbb, 0xa8, 0x98,
-	0x5e, 0x8f, 0x35, 0xab, 0xe4, 0x96, 0xc7, 0xe5, 0x3e, 0x68, 0xb4, 0x51, 0x90, 0x62, 0xee, 0xb0,
-	0x1a, 0x37, 0xd0, 0x42, 0x2c, 0x48, 0x53, 0x6d, 0xb6, 0xa9, 0x16, 0x66, 0x15, 0x95, 0x40, 0xd9,
-	0x4e, 0x0c, 0x62, 0xa7, 0x06, 0xb1, 0x1b, 0x94, 0x46, 0xa7, 0x5e, 0x14, 0x13, 0x77, 0x3e, 0x16,
-	0xe4, 0x48, 0x32, 0x8e, 0x94, 0x0b, 0xf7, 0xd1, 0x5c, 0x17, 0x80, 0x35, 0xb5, 0x19, 0xad, 0x92,
-	0x12, 0x58, 0xb3, 0x33, 0xe6, 0xb4, 0x0f, 0x00, 0x98, 0xbe, 0x89, 0x83, 0x29, 0xd7, 0xec, 0x8e,
-	0x42, 0xfc, 0x12, 0x99, 0xe0, 0x8f, 0x14, 0x90, 0x52, 0x58, 0xbd, 0xa3, 0x70, 0xe2, 0xdf, 0x12,
-	0x40, 0x30, 0x8c, 0xf0, 0x26, 0x32, 0x93, 0x11, 0x7a, 0xde, 0x39, 0x11, 0xd6, 0x5c, 0x25, 0x5f,
-	0x2b, 0xb9, 0x48, 0xa5, 0x0e, 0x65, 0xa6, 0xbe, 0x7c, 0x75, 0x53, 0x28, 0xa0, 0x5c, 0x70, 0x71,
-	0x75, 0x53, 0x40, 0xb8, 0xa8, 0x85, 0x45, 0x63, 0x1e, 0x99, 0x5a, 0xe3, 0x64, 0xc0, 0x48, 0xf5,
-	0x6b, 0x1e, 0x99, 0xb7, 0xda, 0xc4, 0x6f, 0xd1, 0x62, 0x3f, 0xe4, 0x10, 0x7b, 0x51, 0x53, 0x10,
-	0xde, 0x0f, 0x7d, 0x22, 0x2c, 0xa3, 0x92, 0xaf, 0x99, 0xdb, 0x2b, 0xe3, 0x0b, 0x76, 0x89, 0xa0,
-	0x31, 0xf7, 0x89, 0x4b, 0x3a, 0x7a, 0xc7, 0x0b, 0x9a, 0x78, 0xac, 0x79, 0x98, 0x23, 0x2b, 0xa3,
-	0xd5, 0x14, 0x24, 0x22, 0x3e, 0x50, 0x6e, 0xe5, 0x94, 0xe6, 0xee, 0x7d, 0x2b, 0xb3, 0x4f, 0xc7,
-	0xf4, 0x8e, 0x35, 0xf5, 0x55, 0x0f, 0xf8, 0xc0, 0x5d, 0xee, 0xff, 0x13, 0xc4, 0x2f, 0x50, 0x39,
-	0xfb, 0x4d, 0xb5, 0x21, 0xe6, 0xc9, 0x49, 0xf2, 0x6a, 0x4d, 0xd6, 0x38, 0xf7, 0x70, 0x88, 0xe3,
-	0xe7, 0x23, 0x73, 0x27, 0xa6, 0xd8, 0x1a, 0x37, 0xb7, 0xec, 0x6e, 0x92, 0xc1, 0xcb, 0x6f, 0xd0,
-	0xea, 0x3d, 0x1d, 0xcb, 0xa7, 0x78, 0x46, 0x06, 0xea, 0x29, 0x96, 0x5c, 0x79, 0xc4, 0x4b, 0x68,
-	0xba, 0x2f, 0xed, 0x65, 0xe5, 0x54, 0x2e, 0x09, 0xea, 0xb9, 0x5d, 0xa3, 0xfa, 0x05, 0xa1, 0xd1,
-	0xcd, 0xe3, 0x6d, 0x54, 0x92, 0x5e, 0xe9, 0x52, 0x01, 0xe9, 0x65, 0x3c, 0x1c, 0xef, 0xeb, 0xc4,
-	0x67, 0x07, 0x54, 0x80, 0x5b, 0x84, 0xe4, 0x20, 0x70, 0x3d, 0x3b, 0x49, 0xe5, 0x0e, 0x63, 0xd2,
-	0x20, 0x8d, 0x3d, 0xf9, 0x06, 0xbf, 0xfd, 0xda, 0x30, 0x3e, 0xed, 0xfc, 0xf7, 0xdf, 0x9a, 0x9d,
-	0x05, 0xfa, 0x6f, 0xd4, 0x9a, 0x51, 0x0f, 0x68, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0x17, 0x9a, 0x44, 0xeb, 0x05, 0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xee, 0xc6, 0x4e, 0x62, 0x8f, 0x13, 0x52, 0x46, 0x49, 0xbb, 0x75, 0xda, 0xc6, 0xb5, 0x84,
+	0xf0, 0x0d, 0xbb, 0x22, 0xbd, 0x20, 0x0a, 0x14, 0x29, 0x16, 0x88, 0xf0, 0x57, 0xc2, 0x24, 0xea,
+	0x45, 0x6f, 0x56, 0x93, 0xf5, 0xf1, 0x7a, 0xc9, 0xc6, 0x33, 0x9a, 0x39, 0xeb, 0x24, 0x12, 0x57,
+	0x3c, 0x08, 0x97, 0x88, 0x47, 0xe0, 0x11, 0x78, 0x8a, 0x5e, 0xf0, 0x06, 0x20, 0x71, 0x8f, 0x66,
+	0x76, 0xd6, 0xee, 0x6e, 0x70, 0x84, 0xb8, 0x9b, 0xf3, 0xf3, 0x7d, 0x7b, 0xce, 0x37, 0xe7, 0xcc,
+	0x92, 0x17, 0x49, 0x8a, 0x93, 0xfc, 0x3c, 0x88, 0xc5, 0x65, 0xa8, 0x45, 0x26, 0x3e, 0x48, 0x45,
+	0x98, 0x64, 0x42, 0x84, 0x52, 0x89, 0x1f, 0x20, 0x46, 0x1d, 0x26, 0x1c, 0xe1, 0x8a, 0xdf, 0x84,
+	0x5c, 0xa6, 0xe1, 0xec, 0xc3, 0xd2, 0x0c, 0xa4, 0x12, 0x28, 0xe8, 0x56, 0x69, 0x1a, 0x6c, 0x90,
+	0x8a, 0xee, 0x76, 0x22, 0x12, 0x61, 0x63, 0xa1, 0x39, 0x15, 0x69, 0x5d, 0x0a, 0xd7, 0x58, 0x38,
+	0xe1, 0x1a, 0x9d, 0xef, 0x69, 0x22, 0x44, 0x92, 0x41, 0x68, 0xad, 0xf3, 0x7c, 0x1c, 0x5e, 0x29,
+	0x2e, 0x25, 0x28, 0x5d, 0xc6, 0x6d, 0x39, 0x17, 0x29, 0x96, 0x5f, 0xbe, 0x04, 0xe4, 0x23, 0x8e,
+	0xdc, 0xc5, 0x1f, 0xd7, 0xe3, 0x1a, 0x39, 0xe6, 0x25, 0xfa, 0x51, 0x3d, 0xaa, 0x60, 0xbc, 0x8c,
+	0xb8, 0xb4, 0x5d, 0xfc, 0xbd, 0x5a, 0xff, 0xc6, 0x72, 0x99, 0x52, 0x89, 0x6b, 0xd7, 0x7a, 0xf7,
+	0xfd, 0xe5, 0x69, 0x42, 0x62, 0x2a, 0xa6, 0xae, 0x94, 0xfe, 0x2f, 0x4d, 0xb2, 0xfe, 0x45, 0x21,
+	0x13, 0xbd, 0x4f, 0x1a, 0x5a, 0x67, 0xbe, 0xd7, 0xf3, 0x06, 0x2d, 0x66, 0x8e, 0xf4, 0x19, 0xd9,
+	0x38, 0x4f, 0xa7, 0xa3, 0x88, 0x8f, 0x46, 0x0a, 0xb4, 0xf6, 0x1b, 0x3d, 0x6f, 0xd0, 0x66, 0x1d,
+	0xe3, 0x3b, 0x2a, 0x5c, 0x74, 0x97, 0xb4, 0x6d, 0x8a, 0x14, 0x0a, 0xfd, 0x66, 0xcf, 0x1b, 0x6c,
+	0xb2, 0x96, 0x71, 0x9c, 0x08, 0x85, 0xf4, 0x23, 0xb2, 0xee, 0x3e, 0xe7, 0xaf, 0xf6, 0xbc, 0x41,
+	0x67, 0xff, 0x49, 0x60, 0x4a, 0x29, 0x2f, 0x24, 0xf8, 0x26, 0xd5, 0x08, 0x53, 0x50, 0xdf, 0x15,
+	0x49, 0xac, 0xcc, 0xa6, 0x5f, 0x93, 0xb5, 0x42, 0x31, 0x7f, 0xcd, 0xe2, 0xb6, 0x83, 0x58, 0x28,
+	0x98, 0xe3, 0x4e, 0x6d, 0x6c, 0xf8, 0xe4, 0xb7, 0xbf, 0x9b, 0xde, 0xef, 0x6f, 0xf6, 0xee, 0xfd,
+	0xf5, 0x66, 0xef, 0x5d, 0x04, 0x8d, 0xa3, 0x74, 0x3c, 0x3e, 0xec, 0xa7, 0xc9, 0x54, 0x28, 0xe8,
+	0x33, 0x47, 0x41, 0x0f, 0x48, 0xab, 0xbc, 0x1e, 0x7f, 0xdd, 0xd2, 0x3d, 0xa8, 0xd2, 0x7d, 0xeb,
+	0xa2, 0xc3, 0xa6, 0x21, 0x63, 0xf3, 0x6c, 0x3a, 0x24, 0x5b, 0xb9, 0x86, 0xc8, 0x2a, 0x1b, 0x59,
+	0xc1, 0xfc, 0x96, 0x25, 0xe8, 0x06, 0xc5, 0x80, 0x04, 0xe5, 0x80, 0x04, 0x43, 0x21, 0xb2, 0x57,
+	0x3c, 0xcb, 0x81, 0x6d, 0xe6, 0x1a, 0x4e, 0x0c, 0xe2, 0xc4, 0x4e, 0xe1, 0x11, 0xd9, 0x98, 0x20,
+	0xca, 0xc8, 0x0d, 0xa3, 0xdf, 0xb6, 0x04, 0x8f, 0x83, 0xda, 0x70, 0x06, 0xc7, 0x88, 0xd2, 0xdd,
+	0xc4, 0xf1, 0x3d, 0xd6, 0x99, 0x2c, 0x4c, 0xfa, 0x29, 0xe9, 0x60, 0xbc, 0x60, 0x20, 0x96, 0x61,
+	0xf7, 0x16, 0xc3, 0x59, 0xfc, 0x16, 0x01, 0xc1, 0xb9, 0x45, 0xf7, 0x48, 0xa7, 0x68, 0x61, 0xca,
+	0x2f, 0x41, 0xfb, 0x1b, 0xbd, 0xc6, 0xa0, 0xcd, 0x88, 0x75, 0xbd, 0x34, 0x9e, 0x43, 0xfa, 0xd3,
+	0x9f, 0xcd, 0x77, 0xc8, 0x4a, 0x72, 0x45, 0x5b, 0x8e, 0x54, 0x0f, 0x37, 0x49, 0xc7, 0xe1, 0xcf,
+	0x6e, 0x24, 0xf4, 0x7f, 0x5e, 0x25, 0x9d, 0xb7, 0x4a, 0xa4, 0x5f, 0x91, 0xfb, 0xb3, 0x54, 0x61,
+	0xce, 0xb3, 0x48, 0x83, 0x9a, 0xa5, 0x31, 0x68, 0xdf, 0xeb, 0x35, 0x06, 0x9d, 0xfd, 0x47, 0x55,
+	0x71, 0x19, 0x68, 0x91, 0xab, 0x18, 0x18, 0x8c, 0x9d, 0xbe, 0x5b, 0x0e, 0x78, 0xea, 0x70, 0x54,
+	0x11, 0xbf, 0xc6, 0x15, 0x69, 0xc8, 0x20, 0x46, 0xa1, 0xfc, 0x15, 0xcb, 0x79, 0x70, 0x97, 0x5c,
+	0xc1, 0xab, 0x0a, 0xdf, 0xa9, 0x83, 0x7e, 0x3e, 0x45, 0x75, 0xc3, 0x1e, 0xcc, 0xfe, 0x35, 0x48,
+	0x3f, 0x21, 0xdd, 0xfa, 0x37, 0xad, 0x3a, 0x92, 0x9b, 0x4e, 0x1a, 0x56, 0x22, 0xbf, 0x8a, 0x7d,
+	0x39, 0x8f, 0xd3, 0xef, 0xc9, 0xc3, 0x11, 0x8c, 0x79, 0x9e, 0x61, 0x54, 0x63, 0xb1, 0x3b, 0x70,
+	0x97, 0x08, 0x6c, 0xc7, 0x21, 0xab, 0x55, 0xd3, 0xd7, 0xe4, 0x61, 0x3e, 0xbd, 0xe4, 0x18, 0x4f,
+	0x60, 0x14, 0x4d, 0x84, 0xc6, 0x48, 0x81, 0x96, 0x62, 0xaa, 0xc1, 0xed, 0x4e, 0xbf, 0xba, 0x3b,
+	0x9f, 0xa5, 0x0a, 0x62, 0x64, 0x2e, 0xe7, 0x28, 0x36, 0x8b, 0xc3, 0x76, 0xe6, 0x14, 0xc7, 0x42,
+	0xcf, 0x83, 0x55, 0x6e, 0xc9, 0x71, 0xb2, 0xe0, 0x5e, 0xfb, 0x1f, 0xdc, 0x27, 0x1c, 0x27, 0x73,
+	0xee, 0x8f, 0x17, 0x3b, 0x5e, 0xec, 0xc6, 0xb3, 0x2a, 0x97, 0xb9, 0xa8, 0x65, 0x7b, 0xde, 0xfd,
+	0x92, 0xec, 0xde, 0x71, 0x79, 0xe6, 0x45, 0xba, 0x80, 0x1b, 0xfb, 0x22, 0xb5, 0x99, 0x39, 0xd2,
+	0x6d, 0xb2, 0x3a, 0x33, 0x5b, 0xe6, 0xaf, 0x58, 0x5f, 0x61, 0x1c, 0xae, 0x1c, 0x78, 0xfd, 0x1f,
+	0x09, 0x59, 0x2c, 0x00, 0xdd, 0x27, 0x6d, 0xb3, 0x32, 0x46, 0xc7, 0x72, 0x2e, 0x77, 0xaa, 0x75,
+	0x9d, 0xc5, 0xd2, 0x6a, 0xd4, 0xc2, 0xe2, 0xa0, 0xe9, 0x61, 0xbd, 0x93, 0xde, 0x2d, 0xc4, 0xb2,
+	0x46, 0x86, 0x2f, 0xcc, 0x53, 0xf4, 0xeb, 0x1f, 0x4f, 0xbd, 0xd7, 0xcf, 0xff, 0xf3, 0x4f, 0x4b,
+	0x5e, 0x24, 0xee, 0x51, 0x3e, 0x5f, 0xb3, 0xef, 0xc8, 0xf3, 0x7f, 0x06, 0x00, 0x5c, 0x7d, 0x1f,
+	0x63, 0xf2, 0x06, 0x00, 0x00,
 }
 
 func (this *Gateway) Equal(that interface{}) bool {
@@ -538,6 +579,15 @@ func (this *HttpGateway) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.DefaultVirtualService.Equal(that1.DefaultVirtualService) {
+		return false
+	}
+	if !this.UnmatchedHostResponse.Equal(that1.UnmatchedHostResponse) {
+		return false
+	}
+	if !this.UnmatchedPathResponse.Equal(that1.UnmatchedPathResponse) {
+		return false
+	}
 	if !this.Options.Equal(that1.Options) {
 		return false
 	}
@@ -34,6 +34,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // either in a parent VirtualService or another RouteTable.
 //
 // Routes specified in a RouteTable must have their paths start with the prefix provided in the parent's matcher.
+// If the parent's matcher also specifies header, query, or methods matchers, the routes of the RouteTable will only
+// match requests that satisfy them as well.
 //
 // For example, the following configuration:
 //
@@ -90,7 +92,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // ```
 //
 // * A root-level **VirtualService** which delegates routing to to the `a-routes` and `b-routes` **RouteTables**.
-// * Routes with `delegateActions` can only use a `prefix` matcher.
+// * Routes with `delegateActions` can use `prefix`, `exact` or `regex` path matchers, which the routes of the
+//   delegated route tables must refine.
 //
 // ```yaml
 // apiVersion: gateway.solo.io/v1
@@ -259,32 +262,31 @@ func init() {
 }
 
 var fileDescriptor_4d1ea5a66e7f9a13 = []byte{
-	// 385 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0xee, 0xd2, 0x40,
-	0x14, 0xc6, 0x2d, 0x36, 0xd5, 0xcc, 0xdf, 0x68, 0x6c, 0x08, 0x69, 0x50, 0x81, 0xb0, 0x62, 0xe3,
-	0x4c, 0x6c, 0x37, 0x86, 0xc4, 0x85, 0xec, 0x8c, 0x71, 0x53, 0x8d, 0x0b, 0x37, 0x64, 0x5a, 0x86,
-	0x61, 0xa4, 0xf0, 0x9a, 0x99, 0x57, 0xc0, 0x2d, 0x67, 0xf0, 0x10, 0x1e, 0xc1, 0x23, 0x78, 0x0a,
-	0x16, 0xde, 0x00, 0x13, 0xf7, 0xa6, 0xd3, 0x29, 0x51, 0x12, 0x8d, 0xbb, 0xce, 0x7c, 0xdf, 0xfb,
-	0x5e, 0xbf, 0x5f, 0x86, 0xbc, 0x94, 0x0a, 0x57, 0x55, 0x46, 0x73, 0xd8, 0x30, 0x03, 0x05, 0x3c,
-	0x55, 0xc0, 0x64, 0x01, 0xc0, 0x4a, 0x0d, 0x1f, 0x45, 0x8e, 0x86, 0x49, 0x8e, 0x62, 0xcf, 0x3f,
-	0x31, 0x5e, 0x2a, 0xb6, 0x7b, 0xc6, 0x34, 0x54, 0x28, 0xe6, 0xc8, 0xb3, 0x42, 0xd0, 0x52, 0x03,
-	0x42, 0xf8, 0xc0, 0x39, 0x68, 0x3d, 0x4f, 0x15, 0xf4, 0xbb, 0x12, 0x24, 0x58, 0x8d, 0xd5, 0x5f,
-	0x8d, 0xad, 0x1f, 0x8a, 0x03, 0x36, 0x97, 0xe2, 0x80, 0xee, 0x6e, 0x20, 0x01, 0x64, 0x21, 0x98,
-	0x3d, 0x65, 0xd5, 0x92, 0xed, 0x35, 0x2f, 0x4b, 0xa1, 0x4d, 0xab, 0xdb, 0x5f, 0x5a, 0x2b, 0x6c,
-	0xb7, 0x6f, 0x04, 0xf2, 0x05, 0x47, 0xee, 0xf4, 0xc7, 0xd7, 0xba, 0x41, 0x8e, 0xd5, 0x5f, 0xa7,
-	0xdb, 0xb3, 0xd3, 0xe3, 0x7f, 0x16, 0xdd, 0x29, 0x8d, 0x15, 0x2f, 0xe6, 0x46, 0xe8, 0x9d, 0xca,
-	0x5d, 0xd9, 0xf1, 0xe7, 0x0e, 0x21, 0x69, 0x8d, 0xe0, 0x5d, 0x4d, 0x20, 0xa4, 0x24, 0xb0, 0x40,
-	0x4c, 0xe4, 0x8d, 0x6e, 0x4f, 0x6e, 0xe2, 0x1e, 0xbd, 0x82, 0x41, 0xad, 0x39, 0x75, 0xae, 0x30,
-	0x21, 0xc1, 0x5e, 0x28, 0xb9, 0xc2, 0xa8, 0x33, 0xf2, 0x26, 0x37, 0xf1, 0x23, 0xda, 0x10, 0xa0,
-	0x2d, 0x01, 0xfa, 0x6a, 0x8b, 0x49, 0xfc, 0x9e, 0x17, 0x95, 0x48, 0x9d, 0x35, 0x7c, 0x4d, 0x82,
-	0xa6, 0x57, 0x14, 0xd8, 0xa1, 0x2e, 0xcd, 0x41, 0x8b, 0xcb, 0x86, 0xb7, 0x56, 0x9b, 0x3d, 0xf9,
-	0xfa, 0xd3, 0xf7, 0xbe, 0x9d, 0x86, 0xb7, 0x7e, 0x9c, 0x86, 0x0f, 0x51, 0x18, 0x5c, 0xa8, 0xe5,
-	0x72, 0x3a, 0x56, 0x72, 0x0b, 0x5a, 0x8c, 0x53, 0x17, 0x11, 0x3e, 0x27, 0x77, 0x5b, 0x88, 0xd1,
-	0x1d, 0x1b, 0xd7, 0xfb, 0x33, 0xee, 0x8d, 0x53, 0x67, 0x7e, 0x1d, 0x96, 0x5e, 0xdc, 0xd3, 0xfe,
-	0xf1, 0xec, 0xfb, 0xa4, 0xa3, 0xf1, 0x78, 0xf6, 0xef, 0x87, 0xf7, 0x7e, 0x7b, 0x08, 0x66, 0xf6,
-	0xa2, 0x5e, 0xfe, 0xe5, 0xfb, 0xc0, 0xfb, 0x90, 0xfc, 0xf7, 0x83, 0x2a, 0xd7, 0xd2, 0xb1, 0xce,
-	0x02, 0x5b, 0x3f, 0xf9, 0x15, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x37, 0xc7, 0x35, 0x8e, 0x02, 0x00,
-	0x00,
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0xea, 0xd3, 0x40,
+	0x14, 0xc6, 0x4d, 0x2d, 0x51, 0xa6, 0x82, 0x38, 0x94, 0x12, 0xaa, 0xb6, 0xa5, 0xab, 0x6e, 0x9c,
+	0xc1, 0x64, 0x23, 0x05, 0x17, 0x76, 0x27, 0xe2, 0x26, 0x8a, 0x0b, 0x37, 0x65, 0x92, 0x4e, 0xa7,
+	0x63, 0xd3, 0xbe, 0x30, 0xf3, 0xd2, 0xd6, 0xad, 0x27, 0xf0, 0x18, 0x1e, 0xc1, 0x23, 0x78, 0x8a,
+	0x2e, 0xbc, 0x81, 0x82, 0x7b, 0xc9, 0x64, 0x52, 0xb4, 0xa0, 0xfc, 0x77, 0x99, 0xf9, 0xbe, 0xf7,
+	0xbd, 0x7c, 0x3f, 0x86, 0xbc, 0x50, 0x1a, 0x37, 0x55, 0xc6, 0x72, 0xd8, 0x71, 0x0b, 0x05, 0x3c,
+	0xd1, 0xc0, 0x55, 0x01, 0xc0, 0x4b, 0x03, 0x1f, 0x64, 0x8e, 0x96, 0x2b, 0x81, 0xf2, 0x28, 0x3e,
+	0x72, 0x51, 0x6a, 0x7e, 0x78, 0xca, 0x0d, 0x54, 0x28, 0x97, 0x28, 0xb2, 0x42, 0xb2, 0xd2, 0x00,
+	0x02, 0xbd, 0xef, 0x1d, 0xac, 0x9e, 0x67, 0x1a, 0x86, 0x7d, 0x05, 0x0a, 0x9c, 0xc6, 0xeb, 0xaf,
+	0xc6, 0x36, 0xa4, 0xf2, 0x84, 0xcd, 0xa5, 0x3c, 0xa1, 0xbf, 0x1b, 0x29, 0x00, 0x55, 0x48, 0xee,
+	0x4e, 0x59, 0xb5, 0xe6, 0x47, 0x23, 0xca, 0x52, 0x1a, 0xdb, 0xea, 0xee, 0x97, 0xb6, 0x1a, 0xdb,
+	0xed, 0x3b, 0x89, 0x62, 0x25, 0x50, 0x78, 0xfd, 0xd1, 0xb5, 0x6e, 0x51, 0x60, 0xf5, 0xcf, 0xe9,
+	0xf6, 0xec, 0xf5, 0xf8, 0xbf, 0x45, 0x0f, 0xda, 0x60, 0x25, 0x8a, 0xa5, 0x95, 0xe6, 0xa0, 0x73,
+	0x5f, 0x76, 0xfa, 0xb9, 0x43, 0x48, 0x5a, 0x23, 0x78, 0x5b, 0x13, 0xa0, 0x8c, 0x84, 0x0e, 0x88,
+	0x8d, 0x82, 0xc9, 0xed, 0x59, 0x2f, 0x1e, 0xb0, 0x2b, 0x18, 0xcc, 0x99, 0x53, 0xef, 0xa2, 0x09,
+	0x09, 0x8f, 0x52, 0xab, 0x0d, 0x46, 0x9d, 0x49, 0x30, 0xeb, 0xc5, 0x0f, 0x59, 0x43, 0x80, 0xb5,
+	0x04, 0xd8, 0xcb, 0x3d, 0x26, 0xf1, 0x3b, 0x51, 0x54, 0x32, 0xf5, 0x56, 0xfa, 0x8a, 0x84, 0x4d,
+	0xaf, 0x28, 0x74, 0x43, 0x7d, 0x96, 0x83, 0x91, 0x97, 0x0d, 0x6f, 0x9c, 0xb6, 0x78, 0xfc, 0xf5,
+	0x57, 0x37, 0xf8, 0x76, 0x1e, 0xdf, 0xfa, 0x79, 0x1e, 0x3f, 0x40, 0x69, 0x71, 0xa5, 0xd7, 0xeb,
+	0xf9, 0x54, 0xab, 0x3d, 0x18, 0x39, 0x4d, 0x7d, 0x04, 0x7d, 0x46, 0xee, 0xb6, 0x10, 0xa3, 0x3b,
+	0x2e, 0x6e, 0xf0, 0x77, 0xdc, 0x6b, 0xaf, 0x2e, 0xba, 0x75, 0x58, 0x7a, 0x71, 0xcf, 0x07, 0x9f,
+	0x7e, 0x74, 0x29, 0xe9, 0x18, 0xa4, 0xf7, 0xfe, 0x78, 0x04, 0x76, 0xf1, 0xbc, 0x5e, 0xfc, 0xe5,
+	0xfb, 0x28, 0x78, 0x9f, 0xdc, 0xf8, 0x31, 0x95, 0x5b, 0xe5, 0x39, 0x67, 0xa1, 0xab, 0x9e, 0xfc,
+	0x1e, 0x00, 0x8e, 0xef, 0x51, 0xc2, 0x8a, 0x02, 0x00, 0x00,
 }
 
 func (this *RouteTable) Equal(that interface{}) bool {
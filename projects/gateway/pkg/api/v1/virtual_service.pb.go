@@ -27,6 +27,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Determines how the routes of the delegated Route Tables inherit the `options` of the delegating route.
+type DelegateAction_OptionsInheritance int32
+
+const (
+	// The options of the delegating route act as defaults: they apply to the routes of the delegated Route Tables,
+	// unless these set the same option themselves.
+	DelegateAction_PARENT_AS_DEFAULT DelegateAction_OptionsInheritance = 0
+	// The options of the delegating route override the same options set by the routes of the delegated Route
+	// Tables. Options that the delegating route does not set are still taken from the delegated routes.
+	DelegateAction_PARENT_OVERRIDES DelegateAction_OptionsInheritance = 1
+	// The routes of the delegated Route Tables do not inherit any option of the delegating route.
+	DelegateAction_NO_INHERITANCE DelegateAction_OptionsInheritance = 2
+)
+
+var DelegateAction_OptionsInheritance_name = map[int32]string{
+	0: "PARENT_AS_DEFAULT",
+	1: "PARENT_OVERRIDES",
+	2: "NO_INHERITANCE",
+}
+
+var DelegateAction_OptionsInheritance_value = map[string]int32{
+	"PARENT_AS_DEFAULT": 0,
+	"PARENT_OVERRIDES":  1,
+	"NO_INHERITANCE":    2,
+}
+
+func (x DelegateAction_OptionsInheritance) String() string {
+	return proto.EnumName(DelegateAction_OptionsInheritance_name, int32(x))
+}
+
+func (DelegateAction_OptionsInheritance) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_93fa9472926a2049, []int{5, 0}
+}
+
 //
 //
 // The **VirtualService** is the root routing object for the Gloo Gateway.
@@ -116,9 +150,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // **Delegated Routes** are routes that use the `delegateAction` routing action. Delegated Routes obey the following
 // constraints:
 //
-// - delegate routes must use `prefix` path matchers
-// - delegated routes cannot specify header, query, or methods portion of the normal route matcher.
-// - `routeOptions` configuration will be inherited from parent routes, but can be overridden by the child
+// - delegate routes can use `prefix`, `exact` or `regex` path matchers. The path matchers of the delegated routes must
+//   refine the one of the delegate route: they must begin with its prefix, use the same exact path, or only match paths
+//   that its regex matches (see the `matchers` field of the route)
+// - delegate routes can specify header, query, and methods matchers. The routes of the delegated route tables only
+//   match requests that also satisfy these conditions.
+// - `routeOptions` configuration will be inherited from parent routes, but can be overridden by the child, unless
+//   the `optionsInheritance` of the parent's `delegateAction` says otherwise
 //
 type VirtualService struct {
 	// The VirtualHost contains the
@@ -226,10 +264,14 @@ type VirtualHost struct {
 	Routes []*Route `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
 	// Virtual host options contain additional configuration to be applied to all traffic served by the Virtual Host.
 	// Some configuration here can be overridden by Route Options.
-	Options              *v1.VirtualHostOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Options *v1.VirtualHostOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// References to [VirtualHostOption]({{< ref "/reference/api/github.com/solo-io/gloo/projects/gateway/api/v1/virtual_host_option.proto.sk.md" >}})
+	// resources, whose options are merged into the `options` of this virtual host. The `options` set on the virtual
+	// host take precedence over the ones of the referenced resources.
+	OptionsConfigRefs    *DelegateOptionsRefs `protobuf:"bytes,5,opt,name=options_config_refs,json=optionsConfigRefs,proto3" json:"options_config_refs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *VirtualHost) Reset()         { *m = VirtualHost{} }
@@ -277,6 +319,13 @@ func (m *VirtualHost) GetOptions() *v1.VirtualHostOptions {
 	return nil
 }
 
+func (m *VirtualHost) GetOptionsConfigRefs() *DelegateOptionsRefs {
+	if m != nil {
+		return m.OptionsConfigRefs
+	}
+	return nil
+}
+
 //
 // A route specifies how to match a request and what action to take when the request is matched.
 //
@@ -290,7 +339,18 @@ func (m *VirtualHost) GetOptions() *v1.VirtualHostOptions {
 type Route struct {
 	// Matchers contain parameters for matching requests (i.e., based on HTTP path, headers, etc.)
 	// If empty, the route will match all requests (i.e, a single "/" path prefix matcher)
-	// For delegated routes, the matcher must contain only a `prefix` path matcher and no other config
+	// For delegated routes, the matcher can contain a `prefix`, `exact` or `regex` path matcher. The path matchers of the
+	// routes of the delegated route tables must refine the one of the delegated route:
+	// - under a `prefix`, paths and the literal prefixes of regexes must begin with that prefix
+	// - under an `exact` path, routes must use the same exact path
+	// - under a `regex`, exact paths must match the regex, and regexes must either begin with it, up to its trailing
+	//   `.*` (e.g. `/v[0-9]+/orders` under `/v[0-9]+/.*`), or, if the regex is a literal prefix followed by `.*`, begin
+	//   with that literal prefix (e.g. `/api/v[0-9]+` under `/api/.*`). Prefixes must begin with that literal prefix as
+	//   well.
+	// The matcher of delegated routes can also contain header, query, and methods matchers. These conditions are added to
+	// the matchers of every route of the delegated route tables. Conflicting conditions (e.g. a delegated route that
+	// requires a different value of the same header, or none of the same methods) are reported as errors on the route
+	// table.
 	Matchers []*matchers.Matcher `protobuf:"bytes,1,rep,name=matchers,proto3" json:"matchers,omitempty"`
 	// The Route Action Defines what action the proxy should take when a request matches the route.
 	//
@@ -305,10 +365,15 @@ type Route struct {
 	// RouteOption behavior will be inherited by delegated routes which do not specify their own `options`
 	Options *v1.RouteOptions `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
 	// The name provides a convenience for users to be able to refer to a route by name.
-	Name                 string   `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	// References to [RouteOption]({{< ref "/reference/api/github.com/solo-io/gloo/projects/gateway/api/v1/route_option.proto.sk.md" >}})
+	// resources, whose options are merged into the `options` of this route. The `options` set on the route take
+	// precedence over the ones of the referenced resources, which take precedence over the options inherited from
+	// a parent route.
+	OptionsConfigRefs    *DelegateOptionsRefs `protobuf:"bytes,8,opt,name=options_config_refs,json=optionsConfigRefs,proto3" json:"options_config_refs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *Route) Reset()         { *m = Route{} }
@@ -414,6 +479,13 @@ func (m *Route) GetName() string {
 	return ""
 }
 
+func (m *Route) GetOptionsConfigRefs() *DelegateOptionsRefs {
+	if m != nil {
+		return m.OptionsConfigRefs
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Route) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -424,6 +496,108 @@ func (*Route) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// References to option resources (`RouteOption` or `VirtualHostOption`), by resource reference, by selector, or both.
+// The options of the resources referenced by `delegate_options` take precedence over the ones of the resources matched
+// by the `selector`.
+type DelegateOptionsRefs struct {
+	// The option resources to apply, in order of precedence.
+	DelegateOptions []core.ResourceRef `protobuf:"bytes,1,rep,name=delegate_options,json=delegateOptions,proto3" json:"delegate_options"`
+	// Apply the option resources that match the given selector, in order of namespace and name.
+	Selector             *OptionsSelector `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DelegateOptionsRefs) Reset()         { *m = DelegateOptionsRefs{} }
+func (m *DelegateOptionsRefs) String() string { return proto.CompactTextString(m) }
+func (*DelegateOptionsRefs) ProtoMessage()    {}
+func (*DelegateOptionsRefs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93fa9472926a2049, []int{3}
+}
+func (m *DelegateOptionsRefs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegateOptionsRefs.Unmarshal(m, b)
+}
+func (m *DelegateOptionsRefs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegateOptionsRefs.Marshal(b, m, deterministic)
+}
+func (m *DelegateOptionsRefs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateOptionsRefs.Merge(m, src)
+}
+func (m *DelegateOptionsRefs) XXX_Size() int {
+	return xxx_messageInfo_DelegateOptionsRefs.Size(m)
+}
+func (m *DelegateOptionsRefs) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateOptionsRefs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateOptionsRefs proto.InternalMessageInfo
+
+func (m *DelegateOptionsRefs) GetDelegateOptions() []core.ResourceRef {
+	if m != nil {
+		return m.DelegateOptions
+	}
+	return nil
+}
+
+func (m *DelegateOptionsRefs) GetSelector() *OptionsSelector {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
+// Select option resources by namespace, labels, or both.
+type OptionsSelector struct {
+	// Select option resources in these namespaces. If omitted, Gloo will only select resources in the same namespace
+	// as the resource (Virtual Service or Route Table) that owns this selector. The reserved value "*" can be used to
+	// select resources in all namespaces watched by Gloo.
+	Namespaces []string `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Select option resources whose labels match the ones specified here.
+	Labels               map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *OptionsSelector) Reset()         { *m = OptionsSelector{} }
+func (m *OptionsSelector) String() string { return proto.CompactTextString(m) }
+func (*OptionsSelector) ProtoMessage()    {}
+func (*OptionsSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93fa9472926a2049, []int{4}
+}
+func (m *OptionsSelector) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OptionsSelector.Unmarshal(m, b)
+}
+func (m *OptionsSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OptionsSelector.Marshal(b, m, deterministic)
+}
+func (m *OptionsSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionsSelector.Merge(m, src)
+}
+func (m *OptionsSelector) XXX_Size() int {
+	return xxx_messageInfo_OptionsSelector.Size(m)
+}
+func (m *OptionsSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionsSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionsSelector proto.InternalMessageInfo
+
+func (m *OptionsSelector) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *OptionsSelector) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 // DelegateActions are used to delegate routing decisions to Route Tables.
 type DelegateAction struct {
 	// The name of the Route Table to delegate to.
@@ -437,17 +611,20 @@ type DelegateAction struct {
 	// Types that are valid to be assigned to DelegationType:
 	//	*DelegateAction_Ref
 	//	*DelegateAction_Selector
-	DelegationType       isDelegateAction_DelegationType `protobuf_oneof:"delegation_type"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+	DelegationType isDelegateAction_DelegationType `protobuf_oneof:"delegation_type"`
+	// How the routes of the delegated Route Tables inherit the `options` of this route. Every level of delegation
+	// applies its own mode, to the options that the delegating route ends up with. Defaults to `PARENT_AS_DEFAULT`.
+	OptionsInheritance   DelegateAction_OptionsInheritance `protobuf:"varint,5,opt,name=options_inheritance,json=optionsInheritance,proto3,enum=gateway.solo.io.DelegateAction_OptionsInheritance" json:"options_inheritance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
 func (m *DelegateAction) Reset()         { *m = DelegateAction{} }
 func (m *DelegateAction) String() string { return proto.CompactTextString(m) }
 func (*DelegateAction) ProtoMessage()    {}
 func (*DelegateAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{3}
+	return fileDescriptor_93fa9472926a2049, []int{5}
 }
 func (m *DelegateAction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelegateAction.Unmarshal(m, b)
@@ -519,6 +696,13 @@ func (m *DelegateAction) GetSelector() *RouteTableSelector {
 	return nil
 }
 
+func (m *DelegateAction) GetOptionsInheritance() DelegateAction_OptionsInheritance {
+	if m != nil {
+		return m.OptionsInheritance
+	}
+	return DelegateAction_PARENT_AS_DEFAULT
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*DelegateAction) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -544,7 +728,7 @@ func (m *RouteTableSelector) Reset()         { *m = RouteTableSelector{} }
 func (m *RouteTableSelector) String() string { return proto.CompactTextString(m) }
 func (*RouteTableSelector) ProtoMessage()    {}
 func (*RouteTableSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93fa9472926a2049, []int{4}
+	return fileDescriptor_93fa9472926a2049, []int{6}
 }
 func (m *RouteTableSelector) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteTableSelector.Unmarshal(m, b)
@@ -579,9 +763,13 @@ func (m *RouteTableSelector) GetLabels() map[string]string {
 }
 
 func init() {
+	proto.RegisterEnum("gateway.solo.io.DelegateAction_OptionsInheritance", DelegateAction_OptionsInheritance_name, DelegateAction_OptionsInheritance_value)
 	proto.RegisterType((*VirtualService)(nil), "gateway.solo.io.VirtualService")
 	proto.RegisterType((*VirtualHost)(nil), "gateway.solo.io.VirtualHost")
 	proto.RegisterType((*Route)(nil), "gateway.solo.io.Route")
+	proto.RegisterType((*DelegateOptionsRefs)(nil), "gateway.solo.io.DelegateOptionsRefs")
+	proto.RegisterType((*OptionsSelector)(nil), "gateway.solo.io.OptionsSelector")
+	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.OptionsSelector.LabelsEntry")
 	proto.RegisterType((*DelegateAction)(nil), "gateway.solo.io.DelegateAction")
 	proto.RegisterType((*RouteTableSelector)(nil), "gateway.solo.io.RouteTableSelector")
 	proto.RegisterMapType((map[string]string)(nil), "gateway.solo.io.RouteTableSelector.LabelsEntry")
@@ -592,59 +780,71 @@ func init() {
 }
 
 var fileDescriptor_93fa9472926a2049 = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
-	0x14, 0x8f, 0x2c, 0xc5, 0xb1, 0x9f, 0x83, 0xa4, 0x25, 0x8c, 0x4c, 0x31, 0x3a, 0xc7, 0x50, 0x30,
-	0x34, 0x97, 0x4a, 0x58, 0x3b, 0x14, 0x9d, 0x81, 0xad, 0xa8, 0xd7, 0xa2, 0xc6, 0xb6, 0x6e, 0x00,
-	0x33, 0xec, 0xd0, 0x8b, 0x41, 0x4b, 0xb4, 0xa2, 0x45, 0x16, 0x05, 0x92, 0xf6, 0xe2, 0x6b, 0xbf,
-	0xc2, 0xbe, 0xc4, 0x3e, 0x42, 0x3f, 0xc2, 0x2e, 0xc3, 0xce, 0xbb, 0xf4, 0xb0, 0x6f, 0xd0, 0x01,
-	0xbb, 0x0f, 0x22, 0x29, 0xd9, 0x72, 0x63, 0xa0, 0x27, 0x93, 0xef, 0xf7, 0x87, 0x4f, 0xef, 0x3d,
-	0xd2, 0xf0, 0x22, 0x4e, 0xe4, 0xd5, 0x62, 0xea, 0x87, 0x6c, 0x1e, 0x08, 0x96, 0xb2, 0x07, 0x09,
-	0x0b, 0xe2, 0x94, 0xb1, 0x20, 0xe7, 0xec, 0x17, 0x1a, 0x4a, 0x11, 0xc4, 0x44, 0xd2, 0x5f, 0xc9,
-	0x2a, 0x20, 0x79, 0x12, 0x2c, 0x3f, 0x0f, 0x96, 0x09, 0x97, 0x0b, 0x92, 0x4e, 0x04, 0xe5, 0xcb,
-	0x24, 0xa4, 0x7e, 0xce, 0x99, 0x64, 0xe8, 0xd8, 0xb0, 0xfc, 0xc2, 0xc3, 0x4f, 0x58, 0xaf, 0x1b,
-	0xb3, 0x98, 0x29, 0x2c, 0x28, 0x56, 0x9a, 0xd6, 0x43, 0xf4, 0x46, 0xea, 0x20, 0xbd, 0x91, 0x26,
-	0xd6, 0x57, 0xc7, 0x5e, 0x27, 0xb2, 0x3c, 0x61, 0x4e, 0x25, 0x89, 0x88, 0x24, 0x06, 0xbf, 0xb7,
-	0x8d, 0x0b, 0x49, 0xe4, 0x42, 0x18, 0xf4, 0x74, 0x1b, 0xe5, 0x74, 0xb6, 0xcb, 0xb8, 0xdc, 0x1b,
-	0xfc, 0x7c, 0xeb, 0x3b, 0x8b, 0x5d, 0xc9, 0x14, 0xa9, 0x21, 0x7d, 0xb6, 0x9b, 0x94, 0x73, 0x76,
-	0xb3, 0x32, 0xb4, 0xfb, 0xbb, 0x69, 0x2c, 0x97, 0x09, 0xcb, 0xca, 0x7c, 0x1f, 0xef, 0x26, 0x86,
-	0x8c, 0xd3, 0x60, 0x4e, 0x64, 0x78, 0x45, 0xb9, 0xa8, 0x16, 0x5a, 0xe7, 0xfd, 0xdd, 0x80, 0xa3,
-	0x9f, 0x75, 0xe9, 0x2f, 0x75, 0xe5, 0xd1, 0x53, 0x38, 0x2c, 0x9b, 0x71, 0xc5, 0x84, 0x74, 0xad,
-	0x81, 0x75, 0xd1, 0x79, 0x78, 0xcf, 0xdf, 0x6a, 0x85, 0x6f, 0x64, 0x63, 0x26, 0x24, 0xee, 0x2c,
-	0xd7, 0x1b, 0xf4, 0x18, 0x40, 0x88, 0x74, 0x12, 0xb2, 0x6c, 0x96, 0xc4, 0x6e, 0x43, 0xc9, 0x3f,
-	0xf1, 0x8b, 0x94, 0x2a, 0xed, 0xa5, 0x48, 0xbf, 0x51, 0x30, 0x6e, 0x8b, 0x72, 0x89, 0xee, 0xc3,
-	0x61, 0x94, 0x88, 0x3c, 0x25, 0xab, 0x49, 0x46, 0xe6, 0xd4, 0xb5, 0x07, 0xd6, 0x45, 0x7b, 0xe4,
-	0xbc, 0xfd, 0xcf, 0xb1, 0x70, 0xc7, 0x20, 0x3f, 0x90, 0x39, 0x45, 0xdf, 0x41, 0x53, 0x37, 0xcb,
-	0x6d, 0x2a, 0xf3, 0xae, 0x5f, 0x7c, 0xe3, 0xda, 0x5c, 0x61, 0xa3, 0x4f, 0x0b, 0xe1, 0x1f, 0xef,
-	0xce, 0xf6, 0xfe, 0x7d, 0x77, 0x76, 0x57, 0x52, 0x21, 0xa3, 0x64, 0x36, 0x1b, 0x7a, 0x49, 0x9c,
-	0x31, 0x4e, 0x3d, 0x6c, 0x2c, 0xd0, 0x13, 0x68, 0x95, 0x93, 0xe1, 0x1e, 0x28, 0xbb, 0x93, 0xba,
-	0xdd, 0x2b, 0x83, 0x8e, 0x9c, 0xc2, 0x0c, 0x57, 0xec, 0x61, 0xff, 0xcd, 0x7b, 0xc7, 0x81, 0xc6,
-	0x52, 0xbc, 0x79, 0xef, 0x20, 0x74, 0x67, 0x6b, 0x82, 0x85, 0xf7, 0x9b, 0x05, 0x9d, 0x8d, 0x22,
-	0x21, 0x17, 0x0e, 0x22, 0x36, 0x27, 0x49, 0x26, 0xdc, 0xc6, 0xc0, 0xbe, 0x68, 0xe3, 0x72, 0x8b,
-	0x7c, 0x68, 0x72, 0xb6, 0x90, 0x54, 0xb8, 0xf6, 0xc0, 0x56, 0x19, 0x6c, 0x17, 0x1b, 0x17, 0x30,
-	0x36, 0x2c, 0x34, 0x84, 0x03, 0xd3, 0x7e, 0xd7, 0x51, 0x29, 0x0f, 0xea, 0xe5, 0xdd, 0x38, 0xf5,
-	0x47, 0xcd, 0xc3, 0xa5, 0xc0, 0xfb, 0xd3, 0x86, 0x7d, 0xe5, 0x86, 0x9e, 0x42, 0xab, 0x9c, 0x06,
-	0xd7, 0x52, 0xe7, 0x9e, 0xfb, 0xd5, 0x78, 0xa8, 0x12, 0xd4, 0x4c, 0x5f, 0x69, 0x08, 0x57, 0x22,
-	0xf4, 0x35, 0x1c, 0xaa, 0x84, 0x26, 0x24, 0x2c, 0xbc, 0x4d, 0xab, 0x4f, 0xeb, 0x32, 0x75, 0xd6,
-	0x33, 0x45, 0x18, 0xef, 0xe1, 0x0e, 0x5f, 0x6f, 0xd1, 0x4b, 0x38, 0xe6, 0x34, 0x4a, 0x38, 0x0d,
-	0x65, 0x69, 0x61, 0x97, 0xc3, 0x56, 0xb3, 0x30, 0xa4, 0xca, 0xe5, 0x88, 0xd7, 0x22, 0xe8, 0x35,
-	0x9c, 0x18, 0x1b, 0x4e, 0x45, 0xce, 0x32, 0x51, 0xa5, 0xa4, 0xcb, 0xe3, 0xd5, 0xfd, 0x9e, 0x2b,
-	0x2e, 0x36, 0xd4, 0xca, 0xb5, 0x1b, 0xdd, 0x12, 0x47, 0xdf, 0xc2, 0x71, 0x44, 0x53, 0x5a, 0x34,
-	0xa4, 0x34, 0xdd, 0x57, 0xa6, 0x67, 0x1f, 0x34, 0xe9, 0xb9, 0xe1, 0xad, 0xf3, 0x8c, 0x6a, 0x11,
-	0xf4, 0xc5, 0xba, 0x6f, 0x7a, 0x72, 0x7b, 0xb7, 0xd4, 0x6a, 0xbb, 0x63, 0x08, 0x81, 0xa3, 0xee,
-	0x43, 0x31, 0x9d, 0x6d, 0xac, 0xd6, 0xa3, 0x16, 0x34, 0x75, 0x32, 0xde, 0x5f, 0x16, 0x1c, 0xd5,
-	0x0f, 0x46, 0x27, 0x46, 0x60, 0xa9, 0x0b, 0xd4, 0x70, 0x2d, 0x2d, 0x42, 0x03, 0x68, 0x17, 0xbf,
-	0x22, 0x27, 0x21, 0x55, 0xcd, 0xd2, 0xe0, 0x3a, 0x88, 0x1e, 0x80, 0xcd, 0xe9, 0xcc, 0x74, 0xe1,
-	0xb4, 0x7e, 0x0f, 0x30, 0x15, 0x6c, 0xc1, 0x43, 0x8a, 0xe9, 0x6c, 0xbc, 0x87, 0x0b, 0x1e, 0x7a,
-	0x06, 0x2d, 0x41, 0x53, 0x1a, 0x4a, 0xc6, 0x4d, 0xa5, 0xcf, 0x6f, 0x9f, 0xdc, 0x9f, 0xc8, 0x34,
-	0xa5, 0x97, 0x86, 0x3a, 0xde, 0xc3, 0x95, 0x6c, 0x74, 0xb7, 0x2a, 0x6f, 0xc2, 0xb2, 0x89, 0x5c,
-	0xe5, 0xd4, 0x7b, 0x6b, 0x01, 0xfa, 0x50, 0x85, 0xfa, 0x00, 0x55, 0xa2, 0x7a, 0x60, 0xdb, 0x78,
-	0x23, 0x82, 0x5e, 0x42, 0x33, 0x25, 0x53, 0x9a, 0xea, 0xdb, 0xd5, 0x79, 0x18, 0x7c, 0x44, 0x2a,
-	0xfe, 0xf7, 0x4a, 0xf1, 0x22, 0x93, 0x7c, 0x85, 0x8d, 0xbc, 0xf7, 0x25, 0x74, 0x36, 0xc2, 0xe8,
-	0x0e, 0xd8, 0xd7, 0x74, 0xa5, 0x8b, 0x89, 0x8b, 0x25, 0xea, 0xc2, 0xfe, 0x92, 0xa4, 0x0b, 0x53,
-	0x43, 0xac, 0x37, 0xc3, 0xc6, 0x13, 0x6b, 0xf4, 0x55, 0xf1, 0xe6, 0xfc, 0xfe, 0x4f, 0xdf, 0x7a,
-	0xfd, 0xe8, 0xa3, 0xff, 0x00, 0xf3, 0xeb, 0xd8, 0x3c, 0xd5, 0xd3, 0xa6, 0x7a, 0x94, 0x1f, 0xfd,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0x21, 0xb5, 0xee, 0xc2, 0x3e, 0x07, 0x00, 0x00,
+	// 1016 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1a, 0x47,
+	0x14, 0xf7, 0x02, 0xc6, 0xe6, 0x61, 0xd9, 0x78, 0x42, 0xdd, 0x35, 0x4a, 0x6d, 0xb4, 0x6e, 0x15,
+	0x1f, 0x9a, 0x5d, 0xd5, 0xa9, 0xa2, 0xd4, 0x6a, 0x1b, 0x41, 0xa0, 0xc1, 0x69, 0x82, 0xab, 0x81,
+	0xe4, 0x90, 0x0b, 0x5a, 0x2f, 0x03, 0xde, 0x7a, 0xd9, 0x59, 0xcd, 0x0c, 0xd4, 0x5c, 0x7b, 0xed,
+	0x87, 0xe8, 0xa1, 0x97, 0x9e, 0x7b, 0xca, 0x47, 0xe8, 0x17, 0xe8, 0x35, 0x87, 0xde, 0x7a, 0x6c,
+	0xa5, 0xde, 0xab, 0x9d, 0x9d, 0x5d, 0x58, 0x30, 0x72, 0xa4, 0xe6, 0x36, 0xf3, 0xde, 0xef, 0xfd,
+	0xe6, 0xfd, 0xf9, 0x3d, 0x58, 0x68, 0x0e, 0x5d, 0x71, 0x39, 0xbe, 0x30, 0x1d, 0x3a, 0xb2, 0x38,
+	0xf5, 0xe8, 0x7d, 0x97, 0x5a, 0x43, 0x8f, 0x52, 0x2b, 0x60, 0xf4, 0x7b, 0xe2, 0x08, 0x6e, 0x0d,
+	0x6d, 0x41, 0x7e, 0xb0, 0xa7, 0x96, 0x1d, 0xb8, 0xd6, 0xe4, 0x33, 0x6b, 0xe2, 0x32, 0x31, 0xb6,
+	0xbd, 0x1e, 0x27, 0x6c, 0xe2, 0x3a, 0xc4, 0x0c, 0x18, 0x15, 0x14, 0xed, 0x28, 0x94, 0x19, 0x72,
+	0x98, 0x2e, 0xad, 0x94, 0x87, 0x74, 0x48, 0xa5, 0xcf, 0x0a, 0x4f, 0x11, 0xac, 0x82, 0xc8, 0xb5,
+	0x88, 0x8c, 0xe4, 0x5a, 0x28, 0xdb, 0x81, 0x7c, 0xf6, 0xca, 0x15, 0xf1, 0x0b, 0x23, 0x22, 0xec,
+	0xbe, 0x2d, 0x6c, 0xe5, 0xbf, 0xbb, 0xe8, 0xe7, 0xc2, 0x16, 0x63, 0xae, 0xbc, 0xfb, 0x8b, 0x5e,
+	0x46, 0x06, 0xab, 0x88, 0xe3, 0xbb, 0xf2, 0x1f, 0x2d, 0xd4, 0x19, 0xde, 0x62, 0x24, 0xf7, 0x14,
+	0xe8, 0x93, 0xd5, 0xa0, 0x80, 0xd1, 0xeb, 0xa9, 0x82, 0xdd, 0x5b, 0x0d, 0xa3, 0x81, 0x70, 0xa9,
+	0x1f, 0xe7, 0xfb, 0x70, 0x35, 0xd0, 0xa1, 0x8c, 0x58, 0x23, 0x5b, 0x38, 0x97, 0x84, 0xf1, 0xe4,
+	0x10, 0xc5, 0x19, 0x7f, 0x64, 0x60, 0xfb, 0x55, 0xd4, 0xfa, 0x4e, 0xd4, 0x79, 0xf4, 0x18, 0xb6,
+	0xe2, 0x61, 0x5c, 0x52, 0x2e, 0x74, 0xad, 0xaa, 0x1d, 0x17, 0x4f, 0xee, 0x9a, 0x0b, 0xa3, 0x30,
+	0x55, 0x58, 0x8b, 0x72, 0x81, 0x8b, 0x93, 0xd9, 0x05, 0x3d, 0x04, 0xe0, 0xdc, 0xeb, 0x39, 0xd4,
+	0x1f, 0xb8, 0x43, 0x3d, 0x23, 0xc3, 0x3f, 0x34, 0xc3, 0x94, 0x92, 0xd8, 0x0e, 0xf7, 0x9e, 0x48,
+	0x37, 0x2e, 0xf0, 0xf8, 0x88, 0xee, 0xc1, 0x56, 0xdf, 0xe5, 0x81, 0x67, 0x4f, 0x7b, 0xbe, 0x3d,
+	0x22, 0x7a, 0xb6, 0xaa, 0x1d, 0x17, 0xea, 0xb9, 0x37, 0xff, 0xe6, 0x34, 0x5c, 0x54, 0x9e, 0xb6,
+	0x3d, 0x22, 0xe8, 0x5b, 0xc8, 0x47, 0xc3, 0xd2, 0xf3, 0x92, 0xbc, 0x6c, 0x86, 0x35, 0xce, 0xc8,
+	0xa5, 0xaf, 0xfe, 0x51, 0x18, 0xf8, 0xfb, 0xdb, 0xc3, 0xb5, 0x7f, 0xde, 0x1e, 0xee, 0x0a, 0xc2,
+	0x45, 0xdf, 0x1d, 0x0c, 0x4e, 0x0d, 0x77, 0xe8, 0x53, 0x46, 0x0c, 0xac, 0x28, 0xd0, 0x23, 0xd8,
+	0x8c, 0x95, 0xa1, 0x6f, 0x48, 0xba, 0xbd, 0x34, 0xdd, 0x0b, 0xe5, 0xad, 0xe7, 0x42, 0x32, 0x9c,
+	0xa0, 0x4f, 0x2b, 0x3f, 0xfe, 0x9d, 0xdb, 0x83, 0xcc, 0x84, 0xa3, 0xd2, 0x82, 0x7a, 0xb9, 0xf1,
+	0x97, 0x06, 0xc5, 0xb9, 0x06, 0x21, 0x1d, 0x36, 0xfa, 0x74, 0x64, 0xbb, 0x3e, 0xd7, 0x33, 0xd5,
+	0xec, 0x71, 0x01, 0xc7, 0x57, 0x64, 0x42, 0x9e, 0xd1, 0xb1, 0x20, 0x5c, 0xcf, 0x56, 0xb3, 0xf2,
+	0xf5, 0xc5, 0x46, 0xe3, 0xd0, 0x8d, 0x15, 0x0a, 0x9d, 0xc2, 0x86, 0x1a, 0xbd, 0x9e, 0x93, 0xe9,
+	0x56, 0xd3, 0xad, 0x9d, 0x7b, 0xf5, 0x3c, 0xc2, 0xe1, 0x38, 0x00, 0x75, 0xe1, 0x8e, 0x3a, 0xaa,
+	0xe9, 0xf4, 0x18, 0x19, 0x70, 0x7d, 0x5d, 0xf2, 0x7c, 0xbc, 0xf4, 0x70, 0x83, 0x78, 0x24, 0xb4,
+	0xc5, 0x3c, 0x64, 0xc0, 0xf1, 0xae, 0x22, 0x50, 0xe3, 0x23, 0x03, 0x6e, 0xfc, 0x92, 0x83, 0x75,
+	0x99, 0x23, 0x7a, 0x0c, 0x9b, 0xb1, 0xbe, 0x74, 0x4d, 0x56, 0x73, 0x64, 0xc6, 0x86, 0xa8, 0xa9,
+	0xa9, 0x54, 0x5f, 0x44, 0x2e, 0x9c, 0x04, 0xa1, 0xaf, 0x61, 0x4b, 0x96, 0xd9, 0xb3, 0x9d, 0xf0,
+	0x15, 0x25, 0x9e, 0xfd, 0x74, 0x98, 0x7c, 0xab, 0x26, 0x01, 0xad, 0x35, 0x5c, 0x64, 0xb3, 0x2b,
+	0x7a, 0x0a, 0x3b, 0x8c, 0xf4, 0x5d, 0x46, 0x1c, 0x11, 0x53, 0x64, 0x63, 0xf9, 0xa6, 0x28, 0x14,
+	0x28, 0x61, 0xd9, 0x66, 0x29, 0x0b, 0x7a, 0x0d, 0x7b, 0x8a, 0x86, 0x11, 0x1e, 0x50, 0x9f, 0x27,
+	0x29, 0x45, 0x4d, 0x37, 0xd2, 0x7c, 0x0d, 0x89, 0xc5, 0x0a, 0x9a, 0xb0, 0x96, 0xfb, 0x37, 0xd8,
+	0xd1, 0x33, 0xd8, 0xe9, 0xab, 0xce, 0xc6, 0xa4, 0xd1, 0x04, 0x0e, 0x57, 0x4e, 0x60, 0x96, 0x67,
+	0x3f, 0x65, 0x41, 0x9f, 0xcf, 0xd4, 0x10, 0xed, 0x42, 0xe5, 0x86, 0x5e, 0x2d, 0xe9, 0x00, 0x41,
+	0x4e, 0x6e, 0x58, 0xa8, 0xf7, 0x02, 0x96, 0xe7, 0x55, 0xda, 0xd8, 0xfc, 0x5f, 0xda, 0xa8, 0x6f,
+	0x42, 0x3e, 0x2a, 0xd1, 0xf8, 0x59, 0x83, 0x3b, 0x37, 0x04, 0xa1, 0x67, 0x50, 0x4a, 0xba, 0x11,
+	0x97, 0x12, 0x69, 0x67, 0x3f, 0xbd, 0x87, 0x98, 0x70, 0x3a, 0x66, 0x0e, 0xc1, 0x64, 0xa0, 0x56,
+	0x71, 0xa7, 0x9f, 0xe6, 0x43, 0x5f, 0xc2, 0x26, 0x27, 0x1e, 0x71, 0x04, 0x65, 0x4a, 0x3a, 0xd5,
+	0xa5, 0xc4, 0x15, 0xb6, 0xa3, 0x70, 0x38, 0x89, 0x30, 0x7e, 0xd3, 0x60, 0x67, 0xc1, 0x8b, 0x0e,
+	0x00, 0xc2, 0xee, 0xf0, 0xc0, 0x76, 0x48, 0x94, 0x57, 0x01, 0xcf, 0x59, 0x50, 0x03, 0xf2, 0x9e,
+	0x7d, 0x41, 0xbc, 0x68, 0xad, 0x8b, 0x27, 0x9f, 0xde, 0xf6, 0x9e, 0xf9, 0x5c, 0xc2, 0x9b, 0xbe,
+	0x60, 0x53, 0xac, 0x62, 0x2b, 0x5f, 0x40, 0x71, 0xce, 0x8c, 0x4a, 0x90, 0xbd, 0x22, 0x53, 0xf9,
+	0xc3, 0x5b, 0xc0, 0xe1, 0x11, 0x95, 0x61, 0x7d, 0x62, 0x7b, 0x63, 0x22, 0xab, 0x2a, 0xe0, 0xe8,
+	0x72, 0x9a, 0x79, 0xa4, 0x19, 0x3f, 0x65, 0x61, 0x3b, 0xad, 0x12, 0xb4, 0xa7, 0xa6, 0x2b, 0xe3,
+	0xeb, 0x19, 0x5d, 0x53, 0x13, 0xae, 0x42, 0x21, 0xc9, 0x5c, 0xcf, 0x24, 0xce, 0x99, 0x11, 0xdd,
+	0x87, 0x2c, 0x23, 0x03, 0xb5, 0x32, 0xab, 0xdb, 0xdf, 0x5a, 0xc3, 0x21, 0x0e, 0xd5, 0xe6, 0xda,
+	0x1d, 0xad, 0xc5, 0xd1, 0xcd, 0x3f, 0x5e, 0x5d, 0xfb, 0xc2, 0x23, 0x71, 0x07, 0x5a, 0x6b, 0xb3,
+	0x9e, 0x23, 0x67, 0xa6, 0x3a, 0xd7, 0xbf, 0x24, 0xcc, 0x15, 0xb6, 0xef, 0x10, 0xb9, 0x0f, 0xdb,
+	0x27, 0x27, 0xb7, 0xec, 0x43, 0xdc, 0xdb, 0xb3, 0x59, 0x24, 0x46, 0x74, 0xc9, 0x66, 0xbc, 0x04,
+	0xb4, 0x8c, 0x44, 0x1f, 0xc0, 0xee, 0x77, 0x35, 0xdc, 0x6c, 0x77, 0x7b, 0xb5, 0x4e, 0xaf, 0xd1,
+	0xfc, 0xa6, 0xf6, 0xf2, 0x79, 0xb7, 0xb4, 0x86, 0xca, 0x50, 0x52, 0xe6, 0xf3, 0x57, 0x4d, 0x8c,
+	0xcf, 0x1a, 0xcd, 0x4e, 0x49, 0x43, 0x08, 0xb6, 0xdb, 0xe7, 0xbd, 0xb3, 0x76, 0xab, 0x89, 0xcf,
+	0xba, 0xb5, 0xf6, 0x93, 0x66, 0x29, 0x53, 0xdf, 0x4d, 0xf6, 0xd8, 0xa5, 0x7e, 0x4f, 0x4c, 0x03,
+	0x62, 0xbc, 0xd1, 0x00, 0x2d, 0x57, 0x7c, 0xab, 0x8a, 0x9e, 0x2e, 0xa8, 0xc8, 0x7a, 0x87, 0x36,
+	0xbe, 0x67, 0x21, 0xd5, 0xbf, 0x0a, 0xff, 0x2e, 0x7f, 0xfd, 0xf3, 0x40, 0x7b, 0xfd, 0xe0, 0x9d,
+	0xbf, 0xdd, 0x82, 0xab, 0xa1, 0xfa, 0xca, 0xb8, 0xc8, 0xcb, 0xef, 0x89, 0x07, 0xff, 0x0d, 0x00,
+	0x2c, 0xa5, 0x90, 0x80, 0xf9, 0x09, 0x00, 0x00,
 }
 
 func (this *VirtualService) Equal(that interface{}) bool {
@@ -724,6 +924,9 @@ func (this *VirtualHost) Equal(that interface{}) bool {
 	if !this.Options.Equal(that1.Options) {
 		return false
 	}
+	if !this.OptionsConfigRefs.Equal(that1.OptionsConfigRefs) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -771,6 +974,9 @@ func (this *Route) Equal(that interface{}) bool {
 	if this.Name != that1.Name {
 		return false
 	}
+	if !this.OptionsConfigRefs.Equal(that1.OptionsConfigRefs) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -872,6 +1078,81 @@ func (this *Route_DelegateAction) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegateOptionsRefs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegateOptionsRefs)
+	if !ok {
+		that2, ok := that.(DelegateOptionsRefs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.DelegateOptions) != len(that1.DelegateOptions) {
+		return false
+	}
+	for i := range this.DelegateOptions {
+		if !this.DelegateOptions[i].Equal(&that1.DelegateOptions[i]) {
+			return false
+		}
+	}
+	if !this.Selector.Equal(that1.Selector) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *OptionsSelector) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OptionsSelector)
+	if !ok {
+		that2, ok := that.(OptionsSelector)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Namespaces) != len(that1.Namespaces) {
+		return false
+	}
+	for i := range this.Namespaces {
+		if this.Namespaces[i] != that1.Namespaces[i] {
+			return false
+		}
+	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
 func (this *DelegateAction) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -906,6 +1187,9 @@ func (this *DelegateAction) Equal(that interface{}) bool {
 	} else if !this.DelegationType.Equal(that1.DelegationType) {
 		return false
 	}
+	if this.OptionsInheritance != that1.OptionsInheritance {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -108,7 +108,7 @@ func getListenerLevelErrors(listenerReport *validation.ListenerReport) []error {
 func getVirtualHostLevelErrorsAndWarnings(vhReport *validation.VirtualHostReport) ([]error, []string) {
 	var (
 		virtualHostErrs     = validationutils.GetVirtualHostErr(vhReport)
-		virtualHostWarnings []string
+		virtualHostWarnings = validationutils.GetVirtualHostWarning(vhReport)
 	)
 
 	for _, routeReport := range vhReport.GetRouteReports() {
@@ -2,6 +2,7 @@ package translator
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/gogo/protobuf/types"
@@ -24,19 +25,36 @@ const (
 )
 
 var (
-	NoActionErr         = errors.New("invalid route: route must specify an action")
-	MatcherCountErr     = errors.New("invalid route: routes with delegate actions must omit or specify a single matcher")
-	MissingPrefixErr    = errors.New("invalid route: routes with delegate actions must use a prefix matcher")
-	InvalidPrefixErr    = errors.New("invalid route: route table matchers must begin with the prefix of their parent route's matcher")
-	HasHeaderMatcherErr = errors.New("invalid route: routes with delegate actions cannot use header matchers")
-	HasMethodMatcherErr = errors.New("invalid route: routes with delegate actions cannot use method matchers")
-	HasQueryMatcherErr  = errors.New("invalid route: routes with delegate actions cannot use query matchers")
-	DelegationCycleErr  = func(cycleInfo string) error {
+	NoActionErr        = errors.New("invalid route: route must specify an action")
+	MatcherCountErr    = errors.New("invalid route: routes with delegate actions must omit or specify a single matcher")
+	MissingPathErr     = errors.New("invalid route: routes with delegate actions must not use an empty path matcher")
+	InvalidPrefixErr   = errors.New("invalid route: route table matchers must begin with the prefix of their parent route's matcher")
+	InvalidExactErr    = errors.New("invalid route: route table matchers must use the exact path of their parent route's matcher")
+	InvalidRegexErr    = errors.New("invalid route: route table matchers must refine the regex of their parent route's matcher")
+	DelegationCycleErr = func(cycleInfo string) error {
 		return errors.Errorf("invalid route: delegation cycle detected: %s", cycleInfo)
 	}
+	ConflictingHeaderMatcherErr = func(name string) error {
+		return errors.Errorf("invalid route: the conditions on header %v conflict with the ones of the parent route", name)
+	}
+	ConflictingQueryParameterMatcherErr = func(name string) error {
+		return errors.Errorf("invalid route: the conditions on query parameter %v conflict with the ones of the parent route", name)
+	}
+	ConflictingMethodMatcherErr = func(methods, parentMethods []string) error {
+		return errors.Errorf("invalid route: methods %v do not include any of the methods %v of the parent route", methods, parentMethods)
+	}
 	InvalidRouteTableForDelegateErr = func(delegatePrefix, pathString string) error {
 		return errors.Wrapf(InvalidPrefixErr, "required prefix: %v, path: %v", delegatePrefix, pathString)
 	}
+	InvalidExactRouteTableForDelegateErr = func(delegatePath, pathString string) error {
+		return errors.Wrapf(InvalidExactErr, "required path: %v, path: %v", delegatePath, pathString)
+	}
+	InvalidRegexRouteTableForDelegateErr = func(delegateRegex, pathString string) error {
+		return errors.Wrapf(InvalidRegexErr, "required regex: %v, path: %v", delegateRegex, pathString)
+	}
+	InvalidDelegateRegexErr = func(regex string, err error) error {
+		return errors.Wrapf(err, "invalid route: failed to parse the regex %v of the delegate route", regex)
+	}
 	TopLevelVirtualResourceErr = func(rtRef core.Metadata, err error) error {
 		return errors.Wrapf(err, "on sub route table %v.%v", rtRef.Name, rtRef.Namespace)
 	}
@@ -47,10 +65,11 @@ type RouteConverter interface {
 	ConvertVirtualService(virtualService *gatewayv1.VirtualService) ([]*gloov1.Route, error)
 }
 
-func NewRouteConverter(selector RouteTableSelector, indexer RouteTableIndexer, reports reporter.ResourceReports) RouteConverter {
+func NewRouteConverter(selector RouteTableSelector, optionsSelector OptionsSelector, indexer RouteTableIndexer, reports reporter.ResourceReports) RouteConverter {
 	return &routeVisitor{
 		reports:            reports,
 		routeTableSelector: selector,
+		optionsSelector:    optionsSelector,
 		routeTableIndexer:  indexer,
 	}
 }
@@ -87,16 +106,24 @@ type routeVisitor struct {
 	reports reporter.ResourceReports
 	// Used to select route tables for delegated routes.
 	routeTableSelector RouteTableSelector
+	// Used to select the route options referenced by routes.
+	optionsSelector OptionsSelector
 	// Used to sort route tables when multiple ones are matched by a selector.
 	routeTableIndexer RouteTableIndexer
 }
 
 // Helper object used to store information about previously visited routes.
 type routeInfo struct {
-	// The path prefix for the route.
-	prefix string
+	// The path matcher of the route, which the matchers of delegated routes must refine.
+	path *matchersv1.Matcher
+	// The header, query parameter and method conditions of the route, which delegated routes must satisfy as well.
+	headers         []*matchersv1.HeaderMatcher
+	queryParameters []*matchersv1.QueryParameterMatcher
+	methods         []string
 	// The options on the route.
 	options *gloov1.RouteOptions
+	// Determines how delegated routes inherit the options of the route.
+	optionsInheritance gatewayv1.DelegateAction_OptionsInheritance
 	// Used to build the name of the route as we traverse the tree.
 	name string
 	// Is true if any route on the current route tree branch is explicitly named by the user.
@@ -123,6 +150,12 @@ func (rv *routeVisitor) visit(resource resourceWithRoutes, parentRoute *routeInf
 		name, routeHasName := routeName(resource.InputResource(), routeClone, parentRoute)
 		routeClone.Name = name
 
+		// Merge the options of the route option resources referenced by the route
+		if err := rv.mergeRouteOptionRefs(routeClone, resource, topLevelVirtualService); err != nil {
+			rv.reports.AddError(resource.InputResource(), err)
+			continue
+		}
+
 		// If the parent route is not nil, this route has been delegated to and we need to perform additional operations
 		if parentRoute != nil {
 			var err error
@@ -139,10 +172,14 @@ func (rv *routeVisitor) visit(resource resourceWithRoutes, parentRoute *routeInf
 		case *gatewayv1.Route_DelegateAction:
 
 			// Validate the matcher of the delegate route
-			prefix, err := getDelegateRoutePrefix(routeClone)
+			path, err := getDelegateRoutePath(routeClone)
 			if err != nil {
 				return nil, err
 			}
+			var delegateMatcher *matchersv1.Matcher
+			if len(routeClone.GetMatchers()) == 1 {
+				delegateMatcher = routeClone.GetMatchers()[0]
+			}
 
 			// Determine the route tables to delegate to
 			routeTables, err := rv.routeTableSelector.SelectRouteTables(action.DelegateAction, resource.InputResource().GetMetadata().Namespace)
@@ -178,10 +215,14 @@ func (rv *routeVisitor) visit(resource resourceWithRoutes, parentRoute *routeInf
 
 					// Collect information about this route that are relevant when visiting the delegated route table
 					currentRouteInfo := &routeInfo{
-						prefix:  prefix,
-						options: routeClone.Options,
-						name:    name,
-						hasName: routeHasName,
+						path:               path,
+						headers:            delegateMatcher.GetHeaders(),
+						queryParameters:    delegateMatcher.GetQueryParameters(),
+						methods:            delegateMatcher.GetMethods(),
+						options:            routeClone.Options,
+						optionsInheritance: action.DelegateAction.GetOptionsInheritance(),
+						name:               name,
+						hasName:            routeHasName,
 					}
 
 					// Make a copy of the existing set of visited route tables and pass that into the recursive call.
@@ -246,6 +287,34 @@ func (rv *routeVisitor) visit(resource resourceWithRoutes, parentRoute *routeInf
 	return routes, nil
 }
 
+// Merges the options of the RouteOptions referenced by the route into its options, in order of precedence.
+// The options set on the route itself take precedence over the ones of the referenced resources.
+func (rv *routeVisitor) mergeRouteOptionRefs(route *gatewayv1.Route, resource resourceWithRoutes, topLevelVirtualService *gatewayv1.VirtualService) error {
+	if route.GetOptionsConfigRefs() == nil {
+		return nil
+	}
+
+	routeOptions, warnings := rv.optionsSelector.SelectRouteOptions(route.GetOptionsConfigRefs(), resource.InputResource().GetMetadata().Namespace)
+	for _, warning := range warnings {
+		rv.reports.AddWarning(resource.InputResource(), warning.Error())
+		if resource.InputResource() != topLevelVirtualService { // surface error
+			rv.reports.AddWarning(topLevelVirtualService,
+				TopLevelVirtualResourceErr(resource.InputResource().GetMetadata(), warning).Error())
+		}
+	}
+
+	for _, routeOption := range routeOptions {
+		merged, err := mergeRoutePlugins(route.GetOptions(), routeOption.GetOptions())
+		if err != nil {
+			// Should never happen
+			return errors.Wrapf(err, "internal error: merging options of route option %v.%v",
+				routeOption.GetMetadata().Namespace, routeOption.GetMetadata().Name)
+		}
+		route.Options = merged
+	}
+	return nil
+}
+
 // Returns the name of the route and a flag that is true if either the route or the parent route are explicitly named.
 // Route names have the following format: "vs:myvirtualservice_route:myfirstroute_rt:myroutetable_route:<unnamed>"
 func routeName(resource resources.InputResource, route *gatewayv1.Route, parentRouteInfo *routeInfo) (string, bool) {
@@ -326,44 +395,49 @@ func checkForCycles(toVisit *gatewayv1.RouteTable, visited gatewayv1.RouteTableL
 	return nil
 }
 
-func getDelegateRoutePrefix(route *gatewayv1.Route) (string, error) {
+// Returns a matcher holding the path specifier of the delegate route.
+func getDelegateRoutePath(route *gatewayv1.Route) (*matchersv1.Matcher, error) {
 	switch len(route.GetMatchers()) {
 	case 0:
-		return defaults.DefaultMatcher().GetPrefix(), nil
+		return defaults.DefaultMatcher(), nil
 	case 1:
 		matcher := route.GetMatchers()[0]
-		var prefix string
-		if len(matcher.GetHeaders()) > 0 {
-			return prefix, HasHeaderMatcherErr
-		}
-		if len(matcher.GetMethods()) > 0 {
-			return prefix, HasMethodMatcherErr
-		}
-		if len(matcher.GetQueryParameters()) > 0 {
-			return prefix, HasQueryMatcherErr
-		}
 		if matcher.GetPathSpecifier() == nil {
-			return defaults.DefaultMatcher().GetPrefix(), nil // no path specifier provided, default to '/' prefix matcher
+			return defaults.DefaultMatcher(), nil // no path specifier provided, default to '/' prefix matcher
+		}
+		if glooutils.PathAsString(matcher) == "" {
+			return nil, MissingPathErr
 		}
-		prefix = matcher.GetPrefix()
-		if prefix == "" {
-			return prefix, MissingPrefixErr
+		if regex := matcher.GetRegex(); regex != "" {
+			if _, err := regexp.Compile(regex); err != nil {
+				return nil, InvalidDelegateRegexErr(regex, err)
+			}
 		}
-		return prefix, nil
+		return &matchersv1.Matcher{PathSpecifier: matcher.GetPathSpecifier()}, nil
 	default:
-		return "", MatcherCountErr
+		return nil, MatcherCountErr
 	}
 }
 
 func validateAndMergeParentRoute(child *gatewayv1.Route, parent *routeInfo) (*gatewayv1.Route, error) {
 
-	// Verify that the matchers are compatible with the parent prefix
-	if err := isRouteTableValidForDelegatePrefix(parent.prefix, child); err != nil {
+	// A route without matchers would match every path, so restrict it to the parent path
+	if len(child.GetMatchers()) == 0 {
+		child.Matchers = []*matchersv1.Matcher{proto.Clone(parent.path).(*matchersv1.Matcher)}
+	}
+
+	// Verify that the matchers are compatible with the parent path
+	if err := isRouteTableValidForDelegatePath(parent.path, child); err != nil {
+		return nil, err
+	}
+
+	// Add the conditions of the parent route to the matchers
+	if err := mergeParentConditions(child, parent); err != nil {
 		return nil, err
 	}
 
 	// Merge plugins from parent routes
-	merged, err := mergeRoutePlugins(child.GetOptions(), parent.options)
+	merged, err := inheritParentOptions(child.GetOptions(), parent)
 	if err != nil {
 		// Should never happen
 		return nil, errors.Wrapf(err, "internal error: merging route plugins from parent to delegated route")
@@ -374,16 +448,136 @@ func validateAndMergeParentRoute(child *gatewayv1.Route, parent *routeInfo) (*ga
 	return child, nil
 }
 
-func isRouteTableValidForDelegatePrefix(delegatePrefix string, route *gatewayv1.Route) error {
-	for _, match := range route.Matchers {
-		// ensure all sub-routes in the delegated route table match the parent prefix
-		if pathString := glooutils.PathAsString(match); !strings.HasPrefix(pathString, delegatePrefix) {
-			return InvalidRouteTableForDelegateErr(delegatePrefix, pathString)
+// Returns the options of the delegated route, merged with the ones of its parent according to the inheritance mode
+// of the parent route.
+func inheritParentOptions(childOptions *gloov1.RouteOptions, parent *routeInfo) (*gloov1.RouteOptions, error) {
+	switch parent.optionsInheritance {
+	case gatewayv1.DelegateAction_NO_INHERITANCE:
+		return childOptions, nil
+	case gatewayv1.DelegateAction_PARENT_OVERRIDES:
+		// Clone the parent options, as they are shared by all the delegated routes
+		var parentOptions *gloov1.RouteOptions
+		if parent.options != nil {
+			parentOptions = proto.Clone(parent.options).(*gloov1.RouteOptions)
+		}
+		return mergeRoutePlugins(parentOptions, childOptions)
+	default:
+		return mergeRoutePlugins(childOptions, parent.options)
+	}
+}
+
+// ANDs the header, query parameter and method conditions of the parent route into every matcher of the child route.
+func mergeParentConditions(child *gatewayv1.Route, parent *routeInfo) error {
+	if len(parent.headers) == 0 && len(parent.queryParameters) == 0 && len(parent.methods) == 0 {
+		return nil
+	}
+
+	for _, matcher := range child.Matchers {
+		headers, err := mergeHeaderMatchers(matcher.GetHeaders(), parent.headers)
+		if err != nil {
+			return err
+		}
+		queryParameters, err := mergeQueryParameterMatchers(matcher.GetQueryParameters(), parent.queryParameters)
+		if err != nil {
+			return err
+		}
+		methods, err := mergeMethods(matcher.GetMethods(), parent.methods)
+		if err != nil {
+			return err
 		}
+		matcher.Headers = headers
+		matcher.QueryParameters = queryParameters
+		matcher.Methods = methods
 	}
 	return nil
 }
 
+func mergeHeaderMatchers(child, parent []*matchersv1.HeaderMatcher) ([]*matchersv1.HeaderMatcher, error) {
+	var merged []*matchersv1.HeaderMatcher
+	merged = append(merged, child...)
+	for _, parentHeader := range parent {
+		isDuplicate := false
+		for _, childHeader := range child {
+			if headerMatchersConflict(childHeader, parentHeader) {
+				return nil, ConflictingHeaderMatcherErr(parentHeader.GetName())
+			}
+			isDuplicate = isDuplicate || childHeader.Equal(parentHeader)
+		}
+		if !isDuplicate {
+			merged = append(merged, parentHeader)
+		}
+	}
+	return merged, nil
+}
+
+// Returns true if no request can satisfy both header matchers.
+func headerMatchersConflict(a, b *matchersv1.HeaderMatcher) bool {
+	// header names are case-insensitive
+	if !strings.EqualFold(a.GetName(), b.GetName()) {
+		return false
+	}
+
+	// an inverted matcher without a value requires the header to be absent
+	isAbsent := func(m *matchersv1.HeaderMatcher) bool { return m.GetInvertMatch() && m.GetValue() == "" }
+	isExact := func(m *matchersv1.HeaderMatcher) bool { return m.GetValue() != "" && !m.GetRegex() }
+
+	switch {
+	case isAbsent(a) && !b.GetInvertMatch(), isAbsent(b) && !a.GetInvertMatch():
+		return true
+	case isExact(a) && isExact(b):
+		if a.GetInvertMatch() == b.GetInvertMatch() {
+			return !a.GetInvertMatch() && a.GetValue() != b.GetValue()
+		}
+		return a.GetValue() == b.GetValue()
+	}
+	return false
+}
+
+func mergeQueryParameterMatchers(child, parent []*matchersv1.QueryParameterMatcher) ([]*matchersv1.QueryParameterMatcher, error) {
+	isExact := func(m *matchersv1.QueryParameterMatcher) bool { return m.GetValue() != "" && !m.GetRegex() }
+
+	var merged []*matchersv1.QueryParameterMatcher
+	merged = append(merged, child...)
+	for _, parentParam := range parent {
+		isDuplicate := false
+		for _, childParam := range child {
+			if childParam.GetName() == parentParam.GetName() && isExact(childParam) && isExact(parentParam) &&
+				childParam.GetValue() != parentParam.GetValue() {
+				return nil, ConflictingQueryParameterMatcherErr(parentParam.GetName())
+			}
+			isDuplicate = isDuplicate || childParam.Equal(parentParam)
+		}
+		if !isDuplicate {
+			merged = append(merged, parentParam)
+		}
+	}
+	return merged, nil
+}
+
+// Returns the methods that are allowed by both the child and the parent route. An empty list allows all methods.
+func mergeMethods(child, parent []string) ([]string, error) {
+	if len(parent) == 0 {
+		return child, nil
+	}
+	if len(child) == 0 {
+		return append([]string{}, parent...), nil
+	}
+
+	var merged []string
+	for _, method := range child {
+		for _, parentMethod := range parent {
+			if strings.EqualFold(method, parentMethod) {
+				merged = append(merged, method)
+				break
+			}
+		}
+	}
+	if len(merged) == 0 {
+		return nil, ConflictingMethodMatcherErr(child, parent)
+	}
+	return merged, nil
+}
+
 // Handles new and deprecated format for referencing a route table
 // TODO: remove this function when we remove the deprecated fields from the API
 func getRouteTableRef(delegate *gatewayv1.DelegateAction) *core.ResourceRef {
@@ -1,6 +1,8 @@
 package translator_test
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -10,6 +12,7 @@ import (
 	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/go-utils/testutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
@@ -19,7 +22,7 @@ var _ = Describe("Route converter", func() {
 
 	DescribeTable("should reject bad config on a delegate route",
 		func(route *v1.Route, expectedErr error) {
-			rv := translator.NewRouteConverter(nil, nil, reporter.ResourceReports{})
+			rv := translator.NewRouteConverter(nil, nil, nil, reporter.ResourceReports{})
 			_, err := rv.ConvertVirtualService(
 				&v1.VirtualService{
 					VirtualHost: &v1.VirtualHost{
@@ -30,11 +33,11 @@ var _ = Describe("Route converter", func() {
 			Expect(err).To(Equal(expectedErr))
 		},
 
-		Entry("route has a regex matcher",
+		Entry("route has an empty regex matcher",
 			&v1.Route{
 				Matchers: []*matchers.Matcher{{
 					PathSpecifier: &matchers.Matcher_Regex{
-						Regex: "/any",
+						Regex: "",
 					},
 				}},
 				Action: &v1.Route_DelegateAction{
@@ -47,57 +50,15 @@ var _ = Describe("Route converter", func() {
 					},
 				},
 			},
-			translator.MissingPrefixErr,
+			translator.MissingPathErr,
 		),
 
-		Entry("route has an exact matcher",
+		Entry("route has an empty exact matcher",
 			&v1.Route{
 				Matchers: []*matchers.Matcher{{
 					PathSpecifier: &matchers.Matcher_Exact{
-						Exact: "/any",
-					},
-				}},
-				Action: &v1.Route_DelegateAction{
-					DelegateAction: &v1.DelegateAction{
-						DelegationType: &v1.DelegateAction_Ref{
-							Ref: &core.ResourceRef{
-								Name: "any",
-							},
-						},
-					},
-				},
-			},
-			translator.MissingPrefixErr,
-		),
-
-		Entry("route has header matchers",
-			&v1.Route{
-				Matchers: []*matchers.Matcher{{
-					PathSpecifier: &matchers.Matcher_Prefix{
-						Prefix: "/any",
-					},
-					Headers: []*matchers.HeaderMatcher{{}},
-				}},
-				Action: &v1.Route_DelegateAction{
-					DelegateAction: &v1.DelegateAction{
-						DelegationType: &v1.DelegateAction_Ref{
-							Ref: &core.ResourceRef{
-								Name: "any",
-							},
-						},
-					},
-				},
-			},
-			translator.HasHeaderMatcherErr,
-		),
-
-		Entry("route has method matchers",
-			&v1.Route{
-				Matchers: []*matchers.Matcher{{
-					PathSpecifier: &matchers.Matcher_Prefix{
-						Prefix: "/any",
+						Exact: "",
 					},
-					Methods: []string{"any"},
 				}},
 				Action: &v1.Route_DelegateAction{
 					DelegateAction: &v1.DelegateAction{
@@ -109,28 +70,7 @@ var _ = Describe("Route converter", func() {
 					},
 				},
 			},
-			translator.HasMethodMatcherErr,
-		),
-
-		Entry("route has query matchers",
-			&v1.Route{
-				Matchers: []*matchers.Matcher{{
-					PathSpecifier: &matchers.Matcher_Prefix{
-						Prefix: "/any",
-					},
-					QueryParameters: []*matchers.QueryParameterMatcher{{}},
-				}},
-				Action: &v1.Route_DelegateAction{
-					DelegateAction: &v1.DelegateAction{
-						DelegationType: &v1.DelegateAction_Ref{
-							Ref: &core.ResourceRef{
-								Name: "any",
-							},
-						},
-					},
-				},
-			},
-			translator.HasQueryMatcherErr,
+			translator.MissingPathErr,
 		),
 
 		Entry("route has multiple path prefix matchers",
@@ -195,6 +135,7 @@ var _ = Describe("Route converter", func() {
 
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
+				translator.NewOptionsSelector(nil, nil),
 				translator.NewRouteTableIndexer(),
 				rpt,
 			)
@@ -233,6 +174,7 @@ var _ = Describe("Route converter", func() {
 
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{}),
+				translator.NewOptionsSelector(nil, nil),
 				translator.NewRouteTableIndexer(),
 				rpt,
 			)
@@ -277,6 +219,7 @@ var _ = Describe("Route converter", func() {
 
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{}),
+				translator.NewOptionsSelector(nil, nil),
 				translator.NewRouteTableIndexer(),
 				rpt,
 			)
@@ -330,6 +273,7 @@ var _ = Describe("Route converter", func() {
 
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
+				translator.NewOptionsSelector(nil, nil),
 				translator.NewRouteTableIndexer(),
 				rpt,
 			)
@@ -385,6 +329,7 @@ var _ = Describe("Route converter", func() {
 
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
+				translator.NewOptionsSelector(nil, nil),
 				translator.NewRouteTableIndexer(),
 				rpt,
 			)
@@ -446,6 +391,7 @@ var _ = Describe("Route converter", func() {
 
 			rv := translator.NewRouteConverter(
 				translator.NewRouteTableSelector(v1.RouteTableList{&rt}),
+				translator.NewOptionsSelector(nil, nil),
 				translator.NewRouteTableIndexer(),
 				rpt,
 			)
@@ -503,6 +449,7 @@ var _ = Describe("Route converter", func() {
 			reports = reporter.ResourceReports{}
 			visitor = translator.NewRouteConverter(
 				translator.NewRouteTableSelector(allRouteTables),
+				translator.NewOptionsSelector(nil, nil),
 				translator.NewRouteTableIndexer(),
 				reports,
 			)
@@ -782,6 +729,418 @@ var _ = Describe("Route converter", func() {
 			})
 		})
 	})
+	Describe("delegate route conditions", func() {
+
+		var (
+			reports reporter.ResourceReports
+			visitor translator.RouteConverter
+		)
+
+		delegateRoute := func(matcher *matchers.Matcher, routeTable string) *v1.Route {
+			return &v1.Route{
+				Matchers: []*matchers.Matcher{matcher},
+				Action: &v1.Route_DelegateAction{
+					DelegateAction: &v1.DelegateAction{
+						DelegationType: &v1.DelegateAction_Ref{
+							Ref: &core.ResourceRef{Name: routeTable, Namespace: "ns-1"},
+						},
+					},
+				},
+			}
+		}
+
+		leafRoute := func(matcher *matchers.Matcher) *v1.Route {
+			return &v1.Route{
+				Matchers: []*matchers.Matcher{matcher},
+				Action: &v1.Route_DirectResponseAction{
+					DirectResponseAction: &gloov1.DirectResponseAction{Status: 200},
+				},
+			}
+		}
+
+		routeTable := func(name string, routes ...*v1.Route) *v1.RouteTable {
+			return &v1.RouteTable{
+				Metadata: core.Metadata{Name: name, Namespace: "ns-1"},
+				Routes:   routes,
+			}
+		}
+
+		tenantMatcher := func(tenant string) *matchers.Matcher {
+			return &matchers.Matcher{
+				PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo"},
+				Headers:       []*matchers.HeaderMatcher{{Name: "x-tenant", Value: tenant}},
+				Methods:       []string{"GET", "POST"},
+			}
+		}
+
+		convert := func(routeTables ...*v1.RouteTable) ([]*gloov1.Route, error) {
+			reports = reporter.ResourceReports{}
+			visitor = translator.NewRouteConverter(
+				translator.NewRouteTableSelector(routeTables),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewRouteTableIndexer(),
+				reports,
+			)
+			return visitor.ConvertVirtualService(&v1.VirtualService{
+				Metadata: core.Metadata{Name: "vs-1", Namespace: "ns-1"},
+				VirtualHost: &v1.VirtualHost{
+					Routes: []*v1.Route{
+						delegateRoute(tenantMatcher("a"), "rt-a"),
+						delegateRoute(tenantMatcher("b"), "rt-b"),
+					},
+				},
+			})
+		}
+
+		It("delegates to different route tables under the same prefix", func() {
+			converted, err := convert(
+				routeTable("rt-a", leafRoute(&matchers.Matcher{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo/a"},
+					Methods:       []string{"GET"},
+				})),
+				routeTable("rt-b", leafRoute(&matchers.Matcher{
+					PathSpecifier:   &matchers.Matcher_Exact{Exact: "/foo/b"},
+					QueryParameters: []*matchers.QueryParameterMatcher{{Name: "q", Value: "b"}},
+				})),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+			Expect(converted).To(HaveLen(2))
+			Expect(converted[0].Matchers).To(Equal([]*matchers.Matcher{{
+				PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo/a"},
+				Headers:       []*matchers.HeaderMatcher{{Name: "x-tenant", Value: "a"}},
+				Methods:       []string{"GET"},
+			}}))
+			Expect(converted[1].Matchers).To(Equal([]*matchers.Matcher{{
+				PathSpecifier:   &matchers.Matcher_Exact{Exact: "/foo/b"},
+				Headers:         []*matchers.HeaderMatcher{{Name: "x-tenant", Value: "b"}},
+				QueryParameters: []*matchers.QueryParameterMatcher{{Name: "q", Value: "b"}},
+				Methods:         []string{"GET", "POST"},
+			}}))
+		})
+
+		It("restricts delegated routes without matchers to the delegate path", func() {
+			converted, err := convert(
+				routeTable("rt-a", &v1.Route{
+					Action: &v1.Route_DirectResponseAction{
+						DirectResponseAction: &gloov1.DirectResponseAction{Status: 200},
+					},
+				}),
+				routeTable("rt-b"),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+			Expect(converted).To(HaveLen(1))
+			Expect(converted[0].Matchers).To(Equal([]*matchers.Matcher{{
+				PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo"},
+				Headers:       []*matchers.HeaderMatcher{{Name: "x-tenant", Value: "a"}},
+				Methods:       []string{"GET", "POST"},
+			}}))
+		})
+
+		It("accumulates the conditions of every level of delegation", func() {
+			converted, err := convert(
+				routeTable("rt-a", delegateRoute(&matchers.Matcher{
+					PathSpecifier:   &matchers.Matcher_Prefix{Prefix: "/foo/a"},
+					QueryParameters: []*matchers.QueryParameterMatcher{{Name: "q", Value: "a"}},
+				}, "rt-leaf")),
+				routeTable("rt-b"),
+				routeTable("rt-leaf", leafRoute(&matchers.Matcher{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo/a/leaf"},
+					Headers:       []*matchers.HeaderMatcher{{Name: "x-tenant", Value: "a"}, {Name: "x-leaf"}},
+				})),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(converted).To(HaveLen(1))
+			Expect(converted[0].Matchers).To(Equal([]*matchers.Matcher{{
+				PathSpecifier:   &matchers.Matcher_Prefix{Prefix: "/foo/a/leaf"},
+				Headers:         []*matchers.HeaderMatcher{{Name: "x-tenant", Value: "a"}, {Name: "x-leaf"}},
+				QueryParameters: []*matchers.QueryParameterMatcher{{Name: "q", Value: "a"}},
+				Methods:         []string{"GET", "POST"},
+			}}))
+		})
+
+		DescribeTable("reports conflicting conditions on the route table",
+			func(matcher *matchers.Matcher, expectedErr error) {
+				rtA := routeTable("rt-a", leafRoute(matcher))
+				converted, err := convert(rtA, routeTable("rt-b"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(BeEmpty())
+				Expect(reports[rtA].Errors).To(MatchError(ContainSubstring(expectedErr.Error())))
+			},
+			Entry("different header value",
+				&matchers.Matcher{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo/a"},
+					Headers:       []*matchers.HeaderMatcher{{Name: "X-Tenant", Value: "b"}},
+				},
+				translator.ConflictingHeaderMatcherErr("x-tenant"),
+			),
+			Entry("absent header",
+				&matchers.Matcher{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo/a"},
+					Headers:       []*matchers.HeaderMatcher{{Name: "x-tenant", InvertMatch: true}},
+				},
+				translator.ConflictingHeaderMatcherErr("x-tenant"),
+			),
+			Entry("no common method",
+				&matchers.Matcher{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo/a"},
+					Methods:       []string{"DELETE"},
+				},
+				translator.ConflictingMethodMatcherErr([]string{"DELETE"}, []string{"GET", "POST"}),
+			),
+		)
+
+		It("reports conflicting query parameters on the route table", func() {
+			rtA := routeTable("rt-a", delegateRoute(&matchers.Matcher{
+				PathSpecifier:   &matchers.Matcher_Prefix{Prefix: "/foo/a"},
+				QueryParameters: []*matchers.QueryParameterMatcher{{Name: "q", Value: "a"}},
+			}, "rt-leaf"))
+			rtLeaf := routeTable("rt-leaf", leafRoute(&matchers.Matcher{
+				PathSpecifier:   &matchers.Matcher_Prefix{Prefix: "/foo/a/leaf"},
+				QueryParameters: []*matchers.QueryParameterMatcher{{Name: "q", Value: "b"}},
+			}))
+			converted, err := convert(rtA, routeTable("rt-b"), rtLeaf)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(converted).To(BeEmpty())
+			Expect(reports[rtLeaf].Errors).To(MatchError(ContainSubstring(translator.ConflictingQueryParameterMatcherErr("q").Error())))
+		})
+	})
+	Describe("delegate path matchers", func() {
+
+		prefix := func(path string) *matchers.Matcher {
+			return &matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: path}}
+		}
+		exact := func(path string) *matchers.Matcher {
+			return &matchers.Matcher{PathSpecifier: &matchers.Matcher_Exact{Exact: path}}
+		}
+		regex := func(path string) *matchers.Matcher {
+			return &matchers.Matcher{PathSpecifier: &matchers.Matcher_Regex{Regex: path}}
+		}
+
+		convert := func(delegateMatcher, childMatcher *matchers.Matcher) ([]*gloov1.Route, *v1.RouteTable, reporter.ResourceReports, error) {
+			rt := &v1.RouteTable{
+				Metadata: core.Metadata{Name: "rt", Namespace: "ns-1"},
+				Routes: []*v1.Route{{
+					Action: &v1.Route_DirectResponseAction{
+						DirectResponseAction: &gloov1.DirectResponseAction{Status: 200},
+					},
+				}},
+			}
+			if childMatcher != nil {
+				rt.Routes[0].Matchers = []*matchers.Matcher{childMatcher}
+			}
+			vs := &v1.VirtualService{
+				Metadata: core.Metadata{Name: "vs-1", Namespace: "ns-1"},
+				VirtualHost: &v1.VirtualHost{
+					Routes: []*v1.Route{{
+						Matchers: []*matchers.Matcher{delegateMatcher},
+						Action: &v1.Route_DelegateAction{
+							DelegateAction: &v1.DelegateAction{
+								DelegationType: &v1.DelegateAction_Ref{
+									Ref: &core.ResourceRef{Name: "rt", Namespace: "ns-1"},
+								},
+							},
+						},
+					}},
+				},
+			}
+
+			reports := reporter.ResourceReports{}
+			rv := translator.NewRouteConverter(
+				translator.NewRouteTableSelector(v1.RouteTableList{rt}),
+				translator.NewOptionsSelector(nil, nil),
+				translator.NewRouteTableIndexer(),
+				reports,
+			)
+			converted, err := rv.ConvertVirtualService(vs)
+			return converted, rt, reports, err
+		}
+
+		DescribeTable("accepts route table matchers that refine the delegate path",
+			func(delegateMatcher, childMatcher *matchers.Matcher) {
+				converted, rt, reports, err := convert(delegateMatcher, childMatcher)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reports[rt].Errors).NotTo(HaveOccurred())
+				Expect(converted).To(HaveLen(1))
+				Expect(converted[0].Matchers).To(Equal([]*matchers.Matcher{childMatcher}))
+			},
+			Entry("regex under a prefix", prefix("/foo"), regex("/foo/[0-9]+")),
+			Entry("the same exact path", exact("/foo"), exact("/foo")),
+			Entry("the same regex", regex("/v[0-9]+/orders"), regex("/v[0-9]+/orders")),
+			Entry("exact path matching the regex", regex("/v[0-9]+/orders"), exact("/v2/orders")),
+			Entry("regex anchored at the regex", regex("/v[0-9]+/.*"), regex("/v[0-9]+/orders")),
+			Entry("regex sharing the literal prefix", regex("/api/.*"), regex("/api/v[0-9]+/orders")),
+			Entry("prefix sharing the literal prefix", regex("/api/.*"), prefix("/api/orders")),
+			Entry("anything under a wildcard", regex(".*"), prefix("/orders")),
+		)
+
+		DescribeTable("restricts route table routes without matchers to the delegate path",
+			func(delegateMatcher *matchers.Matcher) {
+				converted, rt, reports, err := convert(delegateMatcher, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reports[rt].Errors).NotTo(HaveOccurred())
+				Expect(converted).To(HaveLen(1))
+				Expect(converted[0].Matchers).To(Equal([]*matchers.Matcher{delegateMatcher}))
+			},
+			Entry("prefix", prefix("/foo")),
+			Entry("exact path", exact("/foo")),
+			Entry("regex", regex("/v[0-9]+/.*")),
+		)
+
+		DescribeTable("rejects route table matchers that do not refine the delegate path",
+			func(delegateMatcher, childMatcher *matchers.Matcher, expectedErr error) {
+				converted, rt, reports, err := convert(delegateMatcher, childMatcher)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(BeEmpty())
+				Expect(reports[rt].Errors).To(MatchError(ContainSubstring(expectedErr.Error())))
+			},
+			Entry("regex escaping the prefix", prefix("/foo"), regex("/foo|/bar"),
+				translator.InvalidRouteTableForDelegateErr("/foo", "/foo|/bar")),
+			Entry("another exact path", exact("/foo"), exact("/foo/bar"),
+				translator.InvalidExactRouteTableForDelegateErr("/foo", "/foo/bar")),
+			Entry("prefix under an exact path", exact("/foo"), prefix("/foo"),
+				translator.InvalidExactRouteTableForDelegateErr("/foo", "/foo")),
+			Entry("exact path not matching the regex", regex("/v[0-9]+/orders"), exact("/vx/orders"),
+				translator.InvalidRegexRouteTableForDelegateErr("/v[0-9]+/orders", "/vx/orders")),
+			Entry("regex extending a regex without wildcard", regex("/v[0-9]+/orders"), regex("/v[0-9]+/orders/[0-9]+"),
+				translator.InvalidRegexRouteTableForDelegateErr("/v[0-9]+/orders", "/v[0-9]+/orders/[0-9]+")),
+			Entry("regex with a top level alternative", regex("/v[0-9]+/.*"), regex("/v[0-9]+/orders|/admin"),
+				translator.InvalidRegexRouteTableForDelegateErr("/v[0-9]+/.*", "/v[0-9]+/orders|/admin")),
+			Entry("regex repeating the end of the regex", regex("/v[0-9].*"), regex("/v[0-9]?"),
+				translator.InvalidRegexRouteTableForDelegateErr("/v[0-9].*", "/v[0-9]?")),
+			Entry("regex with another literal prefix", regex("/api/.*"), regex("/admin/.*"),
+				translator.InvalidRegexRouteTableForDelegateErr("/api/.*", "/admin/.*")),
+			Entry("prefix under a regex", regex("/v[0-9]+/.*"), prefix("/v1/orders"),
+				translator.InvalidRegexRouteTableForDelegateErr("/v[0-9]+/.*", "/v1/orders")),
+		)
+
+		It("returns an error if the delegate regex is invalid", func() {
+			_, _, _, err := convert(regex("/v[0-9"), regex("/v[0-9"))
+			Expect(err).To(MatchError(ContainSubstring("failed to parse the regex /v[0-9 of the delegate route")))
+		})
+	})
+	Describe("options inheritance", func() {
+
+		var (
+			vsTimeout = time.Minute
+		)
+
+		delegateRoute := func(prefix, routeTable string, options *gloov1.RouteOptions, inheritance v1.DelegateAction_OptionsInheritance) *v1.Route {
+			return &v1.Route{
+				Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: prefix},
+				}},
+				Options: options,
+				Action: &v1.Route_DelegateAction{
+					DelegateAction: &v1.DelegateAction{
+						DelegationType: &v1.DelegateAction_Ref{
+							Ref: &core.ResourceRef{Name: routeTable, Namespace: "ns-1"},
+						},
+						OptionsInheritance: inheritance,
+					},
+				},
+			}
+		}
+
+		vsOptions := func() *gloov1.RouteOptions {
+			return &gloov1.RouteOptions{
+				PrefixRewrite: &types.StringValue{Value: "vs"},
+				Timeout:       &vsTimeout,
+			}
+		}
+
+		DescribeTable("applies the inheritance mode of every level of a delegation chain",
+			func(vsInheritance, rtInheritance v1.DelegateAction_OptionsInheritance, expected *gloov1.RouteOptions) {
+				vs := &v1.VirtualService{
+					Metadata: core.Metadata{Name: "vs-1", Namespace: "ns-1"},
+					VirtualHost: &v1.VirtualHost{
+						Routes: []*v1.Route{delegateRoute("/foo", "rt-1", vsOptions(), vsInheritance)},
+					},
+				}
+				rt1 := &v1.RouteTable{
+					Metadata: core.Metadata{Name: "rt-1", Namespace: "ns-1"},
+					Routes: []*v1.Route{delegateRoute("/foo/bar", "rt-2", &gloov1.RouteOptions{
+						PrefixRewrite: &types.StringValue{Value: "rt-1"},
+						Retries:       &retries.RetryPolicy{RetryOn: "rt-1"},
+					}, rtInheritance)},
+				}
+				rt2 := &v1.RouteTable{
+					Metadata: core.Metadata{Name: "rt-2", Namespace: "ns-1"},
+					Routes: []*v1.Route{{
+						Matchers: []*matchers.Matcher{{
+							PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/foo/bar/baz"},
+						}},
+						Options: &gloov1.RouteOptions{
+							PrefixRewrite: &types.StringValue{Value: "leaf"},
+						},
+						Action: &v1.Route_DirectResponseAction{
+							DirectResponseAction: &gloov1.DirectResponseAction{Status: 200},
+						},
+					}},
+				}
+
+				rv := translator.NewRouteConverter(
+					translator.NewRouteTableSelector(v1.RouteTableList{rt1, rt2}),
+					translator.NewOptionsSelector(nil, nil),
+					translator.NewRouteTableIndexer(),
+					reporter.ResourceReports{},
+				)
+				converted, err := rv.ConvertVirtualService(vs)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(converted).To(HaveLen(1))
+				Expect(converted[0].Options).To(Equal(expected))
+
+				// the options of the delegating route are left untouched
+				Expect(vs.VirtualHost.Routes[0].Options).To(Equal(vsOptions()))
+			},
+			Entry("parent options are defaults at every level",
+				v1.DelegateAction_PARENT_AS_DEFAULT, v1.DelegateAction_PARENT_AS_DEFAULT,
+				&gloov1.RouteOptions{
+					PrefixRewrite: &types.StringValue{Value: "leaf"},
+					Timeout:       &vsTimeout,
+					Retries:       &retries.RetryPolicy{RetryOn: "rt-1"},
+				},
+			),
+			Entry("virtual service options override the ones of the first route table",
+				v1.DelegateAction_PARENT_OVERRIDES, v1.DelegateAction_PARENT_AS_DEFAULT,
+				&gloov1.RouteOptions{
+					PrefixRewrite: &types.StringValue{Value: "leaf"},
+					Timeout:       &vsTimeout,
+					Retries:       &retries.RetryPolicy{RetryOn: "rt-1"},
+				},
+			),
+			Entry("first route table options override the ones of the leaf",
+				v1.DelegateAction_PARENT_AS_DEFAULT, v1.DelegateAction_PARENT_OVERRIDES,
+				&gloov1.RouteOptions{
+					PrefixRewrite: &types.StringValue{Value: "rt-1"},
+					Timeout:       &vsTimeout,
+					Retries:       &retries.RetryPolicy{RetryOn: "rt-1"},
+				},
+			),
+			Entry("parent options override at every level",
+				v1.DelegateAction_PARENT_OVERRIDES, v1.DelegateAction_PARENT_OVERRIDES,
+				&gloov1.RouteOptions{
+					PrefixRewrite: &types.StringValue{Value: "vs"},
+					Timeout:       &vsTimeout,
+					Retries:       &retries.RetryPolicy{RetryOn: "rt-1"},
+				},
+			),
+			Entry("virtual service options are not inherited",
+				v1.DelegateAction_NO_INHERITANCE, v1.DelegateAction_PARENT_AS_DEFAULT,
+				&gloov1.RouteOptions{
+					PrefixRewrite: &types.StringValue{Value: "leaf"},
+					Retries:       &retries.RetryPolicy{RetryOn: "rt-1"},
+				},
+			),
+			Entry("no options are inherited by the leaf",
+				v1.DelegateAction_PARENT_OVERRIDES, v1.DelegateAction_NO_INHERITANCE,
+				&gloov1.RouteOptions{
+					PrefixRewrite: &types.StringValue{Value: "leaf"},
+				},
+			),
+		)
+	})
 })
 
 func getFirstPrefixMatcher(route *gloov1.Route) string {
@@ -0,0 +1,153 @@
+package translator
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	matchersv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+// Returns an error if any of the matchers of the delegated route could match a path that the path matcher of the
+// parent route does not match.
+//
+// Envoy matches regexes against the whole path, so a regex can only be refined by:
+// - an exact path that the regex matches,
+// - a regex that begins with the parent regex, up to its trailing `.*` (e.g. `/v[0-9]+/orders` for `/v[0-9]+/.*`),
+// - a prefix or regex that begins with the literal prefix of the parent regex, if the parent regex only consists
+//   of that literal prefix followed by `.*` (e.g. `/api/v[0-9]+` for `/api/.*`).
+func isRouteTableValidForDelegatePath(delegatePath *matchersv1.Matcher, route *gatewayv1.Route) error {
+	for _, match := range route.GetMatchers() {
+		pathString := glooutils.PathAsString(match)
+		switch delegatePath.GetPathSpecifier().(type) {
+		case *matchersv1.Matcher_Exact:
+			if match.GetExact() != delegatePath.GetExact() {
+				return InvalidExactRouteTableForDelegateErr(delegatePath.GetExact(), pathString)
+			}
+		case *matchersv1.Matcher_Regex:
+			if !pathRefinesRegex(match, delegatePath.GetRegex()) {
+				return InvalidRegexRouteTableForDelegateErr(delegatePath.GetRegex(), pathString)
+			}
+		default:
+			// regexes must begin with the parent prefix outside of any special characters
+			if regex := match.GetRegex(); regex != "" {
+				if literalPrefix, ok := regexLiteralPrefix(regex); ok {
+					pathString = literalPrefix
+				}
+			}
+			if !strings.HasPrefix(pathString, delegatePath.GetPrefix()) {
+				return InvalidRouteTableForDelegateErr(delegatePath.GetPrefix(), glooutils.PathAsString(match))
+			}
+		}
+	}
+	return nil
+}
+
+func pathRefinesRegex(match *matchersv1.Matcher, parentRegex string) bool {
+	parentLiteral, parentIsLiteralWildcard := literalWildcardPrefix(parentRegex)
+
+	switch path := match.GetPathSpecifier().(type) {
+	case *matchersv1.Matcher_Exact:
+		parent, err := regexp.Compile("^(?:" + parentRegex + ")$")
+		return err == nil && parent.MatchString(path.Exact)
+	case *matchersv1.Matcher_Regex:
+		if path.Regex == parentRegex {
+			return true
+		}
+		literalPrefix, ok := regexLiteralPrefix(path.Regex)
+		if !ok {
+			return false
+		}
+		// shared literal prefix
+		if parentIsLiteralWildcard && strings.HasPrefix(literalPrefix, parentLiteral) {
+			return true
+		}
+		// anchoring
+		return isAnchoredAtRegex(path.Regex, parentRegex)
+	default:
+		return parentIsLiteralWildcard && strings.HasPrefix(match.GetPrefix(), parentLiteral)
+	}
+}
+
+// Returns true if the regex is the concatenation of the parent regex, without its trailing `.*`, and of a suffix.
+func isAnchoredAtRegex(regex, parentRegex string) bool {
+	stem := strings.TrimSuffix(parentRegex, ".*")
+	if stem == parentRegex || strings.HasSuffix(stem, `\`) || !strings.HasPrefix(regex, stem) {
+		return false
+	}
+
+	parent, err := syntax.Parse(parentRegex, syntax.Perl)
+	if err != nil || !endsWithWildcard(parent) || hasTopLevelAlternation(parentRegex) || hasTopLevelAlternation(regex) {
+		return false
+	}
+
+	// a suffix starting with a repetition operator would apply to the last element of the parent regex
+	suffix := strings.TrimPrefix(regex, stem)
+	return suffix == "" || !strings.ContainsAny(suffix[:1], "*+?{")
+}
+
+// Returns true if the regex contains a `|` outside of any group or character class.
+func hasTopLevelAlternation(regex string) bool {
+	var depth int
+	var inClass bool
+	for i := 0; i < len(regex); i++ {
+		switch c := regex[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			inClass = c != ']'
+		case c == '[':
+			inClass = true
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == '|' && depth == 0:
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the literal prefix of the regex if the regex is nothing but that prefix followed by `.*`.
+func literalWildcardPrefix(regex string) (string, bool) {
+	re, err := syntax.Parse(regex, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+	re = re.Simplify()
+	if isWildcard(re) {
+		return "", true
+	}
+	if re.Op != syntax.OpConcat || len(re.Sub) != 2 || !endsWithWildcard(re) {
+		return "", false
+	}
+	literal := re.Sub[0]
+	if literal.Op != syntax.OpLiteral || literal.Flags&syntax.FoldCase != 0 {
+		return "", false
+	}
+	return string(literal.Rune), true
+}
+
+func endsWithWildcard(re *syntax.Regexp) bool {
+	if re.Op != syntax.OpConcat || len(re.Sub) == 0 {
+		return false
+	}
+	return isWildcard(re.Sub[len(re.Sub)-1])
+}
+
+func isWildcard(re *syntax.Regexp) bool {
+	return re.Op == syntax.OpStar && (re.Sub[0].Op == syntax.OpAnyChar || re.Sub[0].Op == syntax.OpAnyCharNotNL)
+}
+
+// Returns the literal string that every match of the regex begins with.
+func regexLiteralPrefix(regex string) (string, bool) {
+	re, err := regexp.Compile(regex)
+	if err != nil {
+		return "", false
+	}
+	literalPrefix, _ := re.LiteralPrefix()
+	return literalPrefix, true
+}
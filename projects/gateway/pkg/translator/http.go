@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/solo-io/go-utils/hashutils"
 
 	errors "github.com/rotisserie/eris"
@@ -11,8 +12,11 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	matchersv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 )
 
@@ -39,6 +43,29 @@ var (
 		return errors.Errorf("domain conflict: the following domains are present in more than one of the "+
 			"virtual services associated with this gateway: %v", loggedDomains)
 	}
+	DefaultVirtualServiceMissingWarning = func(ref core.ResourceRef) error {
+		return errors.Errorf("default virtual service %v.%v missing", ref.Namespace, ref.Name)
+	}
+	DomainClaimedByDefaultVirtualServiceErr = func(defaultVs core.ResourceRef) error {
+		return errors.Errorf("domain conflict: the '*' domain of this virtual service (or its lack of domains) "+
+			"conflicts with the default virtual service [%s] of a Gateway it belongs to", defaultVs.Key())
+	}
+	GatewayHasConflictingDefaultVirtualServiceErr = func(conflictingVsNames []string) error {
+		return errors.Errorf("domain conflict: the following virtual services associated with this gateway claim "+
+			"the '*' domain of its default virtual service: %v", conflictingVsNames)
+	}
+	DefaultVirtualServiceSslMismatchErr = func(ref core.ResourceRef, gatewaySsl bool) error {
+		if gatewaySsl {
+			return errors.Errorf("default virtual service [%s] ignored: it has no ssl config, but this gateway only "+
+				"serves virtual services with an ssl config", ref.Key())
+		}
+		return errors.Errorf("default virtual service [%s] ignored: it has an ssl config, but this gateway only "+
+			"serves virtual services without one", ref.Key())
+	}
+	UnmatchedHostResponseIgnoredWarning = func(conflictingVsNames []string) error {
+		return errors.Errorf("the unmatched host response of this gateway is ignored, as the following virtual "+
+			"services associated with it already claim the '*' domain: %v", conflictingVsNames)
+	}
 )
 
 type HttpTranslator struct{}
@@ -56,8 +83,14 @@ func (t *HttpTranslator) GenerateListeners(ctx context.Context, snap *v1.ApiSnap
 		}
 
 		virtualServices := getVirtualServicesForGateway(gateway, snap.VirtualServices)
+		defaultVirtualService := getDefaultVirtualService(gateway, snap.VirtualServices, reports)
+		if defaultVirtualService != nil {
+			// the default virtual service gets its own virtual host, after all the others
+			virtualServices = removeVirtualService(virtualServices, defaultVirtualService)
+		}
 		validateVirtualServiceDomains(gateway, virtualServices, reports)
-		listener := desiredListenerForHttp(gateway, virtualServices, snap.RouteTables, reports)
+		validateWildcardDomain(gateway, defaultVirtualService, virtualServices, reports)
+		listener := desiredListenerForHttp(gateway, virtualServices, defaultVirtualService, snap, reports)
 		result = append(result, listener)
 	}
 	return result
@@ -108,6 +141,82 @@ func validateVirtualServiceDomains(gateway *v1.Gateway, virtualServices v1.Virtu
 	}
 }
 
+// Errors will be added to the report object.
+func validateWildcardDomain(gateway *v1.Gateway, defaultVirtualService *v1.VirtualService, virtualServices v1.VirtualServiceList, reports reporter.ResourceReports) {
+	conflictingVirtualServices := virtualServicesWithWildcardDomain(virtualServices)
+	if len(conflictingVirtualServices) == 0 {
+		return
+	}
+
+	var conflictingVsNames []string
+	for _, vs := range conflictingVirtualServices {
+		conflictingVsNames = append(conflictingVsNames, vs.Metadata.Ref().Key())
+	}
+
+	if defaultVirtualService != nil {
+		for _, vs := range conflictingVirtualServices {
+			reports.AddError(vs, DomainClaimedByDefaultVirtualServiceErr(defaultVirtualService.Metadata.Ref()))
+		}
+		reports.AddError(gateway, GatewayHasConflictingDefaultVirtualServiceErr(conflictingVsNames))
+	} else if gateway.GetHttpGateway().GetUnmatchedHostResponse() != nil {
+		reports.AddWarning(gateway, UnmatchedHostResponseIgnoredWarning(conflictingVsNames).Error())
+	}
+}
+
+// Returns the virtual services whose virtual host matches any domain.
+func virtualServicesWithWildcardDomain(virtualServices v1.VirtualServiceList) v1.VirtualServiceList {
+	var result v1.VirtualServiceList
+	for _, vs := range virtualServices {
+		if vs.VirtualHost == nil {
+			continue
+		}
+		// virtual hosts without domains default to '*'
+		domains := vs.VirtualHost.Domains
+		if len(domains) == 0 {
+			result = append(result, vs)
+			continue
+		}
+		for _, domain := range domains {
+			if domain == "*" || domain == "" {
+				result = append(result, vs)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// Returns the virtual service referenced by the gateway as its default, if any.
+func getDefaultVirtualService(gateway *v1.Gateway, virtualServices v1.VirtualServiceList, reports reporter.ResourceReports) *v1.VirtualService {
+	ref := gateway.GetHttpGateway().GetDefaultVirtualService()
+	if ref == nil {
+		return nil
+	}
+	vs, err := virtualServices.Find(ref.Strings())
+	if err != nil {
+		// missing refs should only result in a warning
+		// this allows resources to be applied asynchronously
+		reports.AddWarning(gateway, DefaultVirtualServiceMissingWarning(*ref).Error())
+		return nil
+	}
+	// an ssl config would turn a plain gateway into an ssl one, and ssl gateways only serve virtual services with one
+	if hasSsl(vs) != gateway.Ssl {
+		reports.AddError(gateway, DefaultVirtualServiceSslMismatchErr(*ref, gateway.Ssl))
+		return nil
+	}
+	return vs
+}
+
+func removeVirtualService(virtualServices v1.VirtualServiceList, toRemove *v1.VirtualService) v1.VirtualServiceList {
+	var result v1.VirtualServiceList
+	for _, vs := range virtualServices {
+		if vs != toRemove {
+			result = append(result, vs)
+		}
+	}
+	return result
+}
+
 func getVirtualServicesForGateway(gateway *v1.Gateway, virtualServices v1.VirtualServiceList) v1.VirtualServiceList {
 
 	var virtualServicesForGateway v1.VirtualServiceList
@@ -130,6 +239,11 @@ func GatewayContainsVirtualService(gateway *v1.Gateway, virtualService *v1.Virtu
 		return false
 	}
 
+	// the default virtual service belongs to the gateway regardless of the other selection rules
+	if ref := httpGateway.GetDefaultVirtualService(); ref != nil && *ref == virtualService.Metadata.Ref() {
+		return true
+	}
+
 	if len(httpGateway.VirtualServiceSelector) > 0 {
 		// select virtual services by the label selector
 		selector := labels.SelectorFromSet(httpGateway.VirtualServiceSelector)
@@ -179,7 +293,7 @@ func hasSsl(vs *v1.VirtualService) bool {
 	return vs.SslConfig != nil
 }
 
-func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.VirtualServiceList, tables v1.RouteTableList, reports reporter.ResourceReports) *gloov1.Listener {
+func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.VirtualServiceList, defaultVirtualService *v1.VirtualService, snap *v1.ApiSnapshot, reports reporter.ResourceReports) *gloov1.Listener {
 	var (
 		virtualHosts []*gloov1.VirtualHost
 		sslConfigs   []*gloov1.SslConfig
@@ -189,7 +303,7 @@ func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.Vi
 		if virtualService.VirtualHost == nil {
 			virtualService.VirtualHost = &v1.VirtualHost{}
 		}
-		vh, err := virtualServiceToVirtualHost(virtualService, tables, reports)
+		vh, err := virtualServiceToVirtualHost(virtualService, snap, reports)
 		if err != nil {
 			reports.AddError(virtualService, err)
 			continue
@@ -200,6 +314,43 @@ func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.Vi
 		}
 	}
 
+	// The default virtual service handles the requests for any host that the other virtual services do not match
+	if defaultVirtualService != nil {
+		if defaultVirtualService.VirtualHost == nil {
+			defaultVirtualService.VirtualHost = &v1.VirtualHost{}
+		}
+		vh, err := virtualServiceToVirtualHost(defaultVirtualService, snap, reports)
+		if err != nil {
+			reports.AddError(defaultVirtualService, err)
+		} else {
+			vh.Domains = []string{"*"}
+			virtualHosts = append(virtualHosts, vh)
+			if defaultVirtualService.SslConfig != nil {
+				sslConfigs = append(sslConfigs, defaultVirtualService.SslConfig)
+			}
+		}
+	}
+
+	if unmatchedPathResponse := gateway.GetHttpGateway().GetUnmatchedPathResponse(); unmatchedPathResponse != nil {
+		for _, vh := range virtualHosts {
+			vh.Routes = append(vh.Routes, directResponseRoute(unmatchedPathResponse))
+		}
+	}
+
+	if unmatchedHostResponse := gateway.GetHttpGateway().GetUnmatchedHostResponse(); unmatchedHostResponse != nil &&
+		defaultVirtualService == nil && len(virtualServicesWithWildcardDomain(virtualServicesForGateway)) == 0 {
+		vh := &gloov1.VirtualHost{
+			Name:    UnmatchedHostVirtualHostName(gateway),
+			Domains: []string{"*"},
+			Routes:  []*gloov1.Route{directResponseRoute(unmatchedHostResponse)},
+		}
+		if err := appendSource(vh, gateway); err != nil {
+			// should never happen
+			reports.AddError(gateway, err)
+		}
+		virtualHosts = append(virtualHosts, vh)
+	}
+
 	var httpPlugins *gloov1.HttpListenerOptions
 	if httpGateway := gateway.GetHttpGateway(); httpGateway != nil {
 		httpPlugins = httpGateway.Options
@@ -221,18 +372,24 @@ func desiredListenerForHttp(gateway *v1.Gateway, virtualServicesForGateway v1.Vi
 	return listener
 }
 
-func virtualServiceToVirtualHost(vs *v1.VirtualService, tables v1.RouteTableList, reports reporter.ResourceReports) (*gloov1.VirtualHost, error) {
-	converter := NewRouteConverter(NewRouteTableSelector(tables), NewRouteTableIndexer(), reports)
+func virtualServiceToVirtualHost(vs *v1.VirtualService, snap *v1.ApiSnapshot, reports reporter.ResourceReports) (*gloov1.VirtualHost, error) {
+	optionsSelector := NewOptionsSelector(snap.VirtualHostOptions, snap.RouteOptions)
+	converter := NewRouteConverter(NewRouteTableSelector(snap.RouteTables), optionsSelector, NewRouteTableIndexer(), reports)
 	routes, err := converter.ConvertVirtualService(vs)
 	if err != nil {
 		return nil, err
 	}
 
+	options, err := virtualHostOptions(vs, optionsSelector, reports)
+	if err != nil {
+		return nil, err
+	}
+
 	vh := &gloov1.VirtualHost{
 		Name:    VirtualHostName(vs),
 		Domains: vs.VirtualHost.Domains,
 		Routes:  routes,
-		Options: vs.VirtualHost.Options,
+		Options: options,
 	}
 
 	if err := appendSource(vh, vs); err != nil {
@@ -243,6 +400,50 @@ func virtualServiceToVirtualHost(vs *v1.VirtualService, tables v1.RouteTableList
 	return vh, nil
 }
 
+// Returns the options of the virtual host of the virtual service, merged with the ones of the VirtualHostOptions it
+// references. The options set on the virtual host itself take precedence over the ones of the referenced resources.
+func virtualHostOptions(vs *v1.VirtualService, optionsSelector OptionsSelector, reports reporter.ResourceReports) (*gloov1.VirtualHostOptions, error) {
+	refs := vs.GetVirtualHost().GetOptionsConfigRefs()
+	if refs == nil {
+		return vs.GetVirtualHost().GetOptions(), nil
+	}
+
+	virtualHostOptionList, warnings := optionsSelector.SelectVirtualHostOptions(refs, vs.GetMetadata().Namespace)
+	for _, warning := range warnings {
+		reports.AddWarning(vs, warning.Error())
+	}
+
+	// Clone the options to avoid mutating the virtual service
+	var options *gloov1.VirtualHostOptions
+	if vs.GetVirtualHost().GetOptions() != nil {
+		options = proto.Clone(vs.GetVirtualHost().GetOptions()).(*gloov1.VirtualHostOptions)
+	}
+	for _, virtualHostOption := range virtualHostOptionList {
+		merged, err := mergeVirtualHostOptions(options, virtualHostOption.GetOptions())
+		if err != nil {
+			// Should never happen
+			return nil, errors.Wrapf(err, "internal error: merging options of virtual host option %v.%v",
+				virtualHostOption.GetMetadata().Namespace, virtualHostOption.GetMetadata().Name)
+		}
+		options = merged
+	}
+	return options, nil
+}
+
+// Returns a route that matches any path and responds with the given direct response.
+func directResponseRoute(response *gloov1.DirectResponseAction) *gloov1.Route {
+	return &gloov1.Route{
+		Matchers: []*matchersv1.Matcher{defaults.DefaultMatcher()},
+		Action: &gloov1.Route_DirectResponseAction{
+			DirectResponseAction: proto.Clone(response).(*gloov1.DirectResponseAction),
+		},
+	}
+}
+
 func VirtualHostName(vs *v1.VirtualService) string {
 	return fmt.Sprintf("%v.%v", vs.Metadata.Namespace, vs.Metadata.Name)
 }
+
+func UnmatchedHostVirtualHostName(gateway *v1.Gateway) string {
+	return fmt.Sprintf("%v.%v-unmatched-host", gateway.Metadata.Namespace, gateway.Metadata.Name)
+}
@@ -12,18 +12,34 @@ func mergeRoutePlugins(dst, src *v1.RouteOptions) (*v1.RouteOptions, error) {
 		return dst, nil
 	}
 	if dst != nil {
-		dstValue, srcValue := reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem()
-
-		for i := 0; i < dstValue.NumField(); i++ {
-			dstField, srcField := dstValue.Field(i), srcValue.Field(i)
-			shallowMerge(dstField, srcField, false)
-		}
-
+		mergeFields(dst, src)
 		return dst, nil
 	}
 	return proto.Clone(src).(*v1.RouteOptions), nil
 }
 
+func mergeVirtualHostOptions(dst, src *v1.VirtualHostOptions) (*v1.VirtualHostOptions, error) {
+	if src == nil {
+		return dst, nil
+	}
+	if dst != nil {
+		mergeFields(dst, src)
+		return dst, nil
+	}
+	return proto.Clone(src).(*v1.VirtualHostOptions), nil
+}
+
+// sets each field of dst to the value of the same field of src, if the former is zero-valued.
+// dst and src must be pointers to structs of the same type.
+func mergeFields(dst, src interface{}) {
+	dstValue, srcValue := reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem()
+
+	for i := 0; i < dstValue.NumField(); i++ {
+		dstField, srcField := dstValue.Field(i), srcValue.Field(i)
+		shallowMerge(dstField, srcField, false)
+	}
+}
+
 // sets src to dst, if src is non-zero and dest is zero-valued or overwrite=true.
 func shallowMerge(dst, src reflect.Value, overwrite bool) {
 	if !src.IsValid() {
@@ -54,4 +54,31 @@ var _ = Describe("MergeRoutePlugins", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(actual).To(Equal(expected))
 	})
+	It("merges top-level virtual host options fields", func() {
+		dst := &v1.VirtualHostOptions{
+			Retries: &retries.RetryPolicy{RetryOn: "preserve-me"},
+		}
+		src := &v1.VirtualHostOptions{
+			Retries: &retries.RetryPolicy{RetryOn: "5XX"},
+			RatelimitBasic: &ratelimit.IngressRateLimit{
+				AuthorizedLimits: &ratelimit.RateLimit{
+					Unit:            1,
+					RequestsPerUnit: 2,
+				},
+			},
+		}
+		expected := &v1.VirtualHostOptions{
+			Retries: &retries.RetryPolicy{RetryOn: "preserve-me"},
+			RatelimitBasic: &ratelimit.IngressRateLimit{
+				AuthorizedLimits: &ratelimit.RateLimit{
+					Unit:            1,
+					RequestsPerUnit: 2,
+				},
+			},
+		}
+
+		actual, err := mergeVirtualHostOptions(dst, src)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(actual).To(Equal(expected))
+	})
 })
@@ -0,0 +1,128 @@
+package translator
+
+import (
+	errors "github.com/rotisserie/eris"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var (
+	RouteOptionMissingWarning = func(ref core.ResourceRef) error {
+		return errors.Errorf("route option %v.%v missing", ref.Namespace, ref.Name)
+	}
+	VirtualHostOptionMissingWarning = func(ref core.ResourceRef) error {
+		return errors.Errorf("virtual host option %v.%v missing", ref.Namespace, ref.Name)
+	}
+	NoMatchingRouteOptionsWarning       = errors.New("no route option matches the given selector")
+	NoMatchingVirtualHostOptionsWarning = errors.New("no virtual host option matches the given selector")
+)
+
+// Selects the RouteOption and VirtualHostOption resources that routes and virtual hosts reference.
+// The selected resources are returned in order of precedence: first the ones referenced by `delegateOptions`,
+// in order, then the ones matched by the selector, sorted by namespace and name.
+// Every missing reference, and a selector that matches no resource, results in a warning; the resources that
+// could be found are still returned.
+type OptionsSelector interface {
+	SelectRouteOptions(refs *gatewayv1.DelegateOptionsRefs, ownerNamespace string) (gatewayv1.RouteOptionList, []error)
+	SelectVirtualHostOptions(refs *gatewayv1.DelegateOptionsRefs, ownerNamespace string) (gatewayv1.VirtualHostOptionList, []error)
+}
+
+func NewOptionsSelector(allVirtualHostOptions gatewayv1.VirtualHostOptionList, allRouteOptions gatewayv1.RouteOptionList) OptionsSelector {
+	return &optionsSelector{
+		virtualHostOptions: allVirtualHostOptions,
+		routeOptions:       allRouteOptions,
+	}
+}
+
+type optionsSelector struct {
+	virtualHostOptions gatewayv1.VirtualHostOptionList
+	routeOptions       gatewayv1.RouteOptionList
+}
+
+func (s *optionsSelector) SelectRouteOptions(refs *gatewayv1.DelegateOptionsRefs, ownerNamespace string) (gatewayv1.RouteOptionList, []error) {
+	if refs == nil {
+		return nil, nil
+	}
+
+	var (
+		selected gatewayv1.RouteOptionList
+		warnings []error
+	)
+	isSelected := map[core.ResourceRef]bool{}
+	for _, ref := range refs.GetDelegateOptions() {
+		// missing refs should only result in a warning
+		// this allows resources to be applied asynchronously
+		routeOption, err := s.routeOptions.Find(ref.Strings())
+		if err != nil {
+			warnings = append(warnings, RouteOptionMissingWarning(ref))
+			continue
+		}
+		if !isSelected[ref] {
+			isSelected[ref] = true
+			selected = append(selected, routeOption)
+		}
+	}
+
+	if optionsSelector := refs.GetSelector(); optionsSelector != nil {
+		var matched bool
+		for _, routeOption := range append(gatewayv1.RouteOptionList{}, s.routeOptions...).Sort() {
+			if !metadataMatchesSelector(routeOption.GetMetadata(), optionsSelector.GetNamespaces(), optionsSelector.GetLabels(), ownerNamespace) {
+				continue
+			}
+			matched = true
+			if ref := routeOption.GetMetadata().Ref(); !isSelected[ref] {
+				isSelected[ref] = true
+				selected = append(selected, routeOption)
+			}
+		}
+		if !matched {
+			warnings = append(warnings, NoMatchingRouteOptionsWarning)
+		}
+	}
+
+	return selected, warnings
+}
+
+func (s *optionsSelector) SelectVirtualHostOptions(refs *gatewayv1.DelegateOptionsRefs, ownerNamespace string) (gatewayv1.VirtualHostOptionList, []error) {
+	if refs == nil {
+		return nil, nil
+	}
+
+	var (
+		selected gatewayv1.VirtualHostOptionList
+		warnings []error
+	)
+	isSelected := map[core.ResourceRef]bool{}
+	for _, ref := range refs.GetDelegateOptions() {
+		// missing refs should only result in a warning
+		// this allows resources to be applied asynchronously
+		virtualHostOption, err := s.virtualHostOptions.Find(ref.Strings())
+		if err != nil {
+			warnings = append(warnings, VirtualHostOptionMissingWarning(ref))
+			continue
+		}
+		if !isSelected[ref] {
+			isSelected[ref] = true
+			selected = append(selected, virtualHostOption)
+		}
+	}
+
+	if optionsSelector := refs.GetSelector(); optionsSelector != nil {
+		var matched bool
+		for _, virtualHostOption := range append(gatewayv1.VirtualHostOptionList{}, s.virtualHostOptions...).Sort() {
+			if !metadataMatchesSelector(virtualHostOption.GetMetadata(), optionsSelector.GetNamespaces(), optionsSelector.GetLabels(), ownerNamespace) {
+				continue
+			}
+			matched = true
+			if ref := virtualHostOption.GetMetadata().Ref(); !isSelected[ref] {
+				isSelected[ref] = true
+				selected = append(selected, virtualHostOption)
+			}
+		}
+		if !matched {
+			warnings = append(warnings, NoMatchingVirtualHostOptionsWarning)
+		}
+	}
+
+	return selected, warnings
+}
@@ -0,0 +1,103 @@
+package translator_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("OptionsSelector", func() {
+
+	var (
+		labelSet = map[string]string{"foo": "bar"}
+
+		routeOption = func(name, namespace string, labels map[string]string) *v1.RouteOption {
+			return &v1.RouteOption{Metadata: core.Metadata{Name: name, Namespace: namespace, Labels: labels}}
+		}
+
+		a    = routeOption("a", "ns-1", labelSet)
+		b    = routeOption("b", "ns-1", labelSet)
+		c    = routeOption("c", "ns-2", labelSet)
+		d    = routeOption("d", "ns-1", nil)
+		opts = v1.RouteOptionList{d, c, b, a}
+
+		selector translator.OptionsSelector
+	)
+
+	BeforeEach(func() {
+		selector = translator.NewOptionsSelector(nil, opts)
+	})
+
+	It("returns nothing if there are no refs", func() {
+		selected, warnings := selector.SelectRouteOptions(nil, "ns-1")
+		Expect(selected).To(BeEmpty())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("returns the referenced resources first, followed by the ones matching the selector", func() {
+		selected, warnings := selector.SelectRouteOptions(&v1.DelegateOptionsRefs{
+			DelegateOptions: []core.ResourceRef{d.Metadata.Ref(), b.Metadata.Ref()},
+			Selector:        &v1.OptionsSelector{Labels: labelSet},
+		}, "ns-1")
+		Expect(warnings).To(BeEmpty())
+		Expect(selected).To(Equal(v1.RouteOptionList{d, b, a}))
+
+		// the list of resources is left untouched
+		Expect(opts).To(Equal(v1.RouteOptionList{d, c, b, a}))
+	})
+
+	It("selects the resources in the given namespaces", func() {
+		selected, warnings := selector.SelectRouteOptions(&v1.DelegateOptionsRefs{
+			Selector: &v1.OptionsSelector{Namespaces: []string{"*"}, Labels: labelSet},
+		}, "ns-1")
+		Expect(warnings).To(BeEmpty())
+		Expect(selected).To(Equal(v1.RouteOptionList{a, b, c}))
+
+		selected, warnings = selector.SelectRouteOptions(&v1.DelegateOptionsRefs{
+			Selector: &v1.OptionsSelector{Namespaces: []string{"ns-2"}},
+		}, "ns-1")
+		Expect(warnings).To(BeEmpty())
+		Expect(selected).To(Equal(v1.RouteOptionList{c}))
+	})
+
+	It("warns about every missing ref", func() {
+		missing := core.ResourceRef{Name: "missing", Namespace: "ns-1"}
+		selected, warnings := selector.SelectRouteOptions(&v1.DelegateOptionsRefs{
+			DelegateOptions: []core.ResourceRef{missing, a.Metadata.Ref()},
+		}, "ns-1")
+		Expect(selected).To(Equal(v1.RouteOptionList{a}))
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0]).To(MatchError(translator.RouteOptionMissingWarning(missing).Error()))
+	})
+
+	It("warns about a selector that matches no resources", func() {
+		selected, warnings := selector.SelectRouteOptions(&v1.DelegateOptionsRefs{
+			DelegateOptions: []core.ResourceRef{a.Metadata.Ref()},
+			Selector:        &v1.OptionsSelector{Labels: map[string]string{"foo": "baz"}},
+		}, "ns-1")
+		Expect(selected).To(Equal(v1.RouteOptionList{a}))
+		Expect(warnings).To(ConsistOf(translator.NoMatchingRouteOptionsWarning))
+
+		vhSelected, warnings := selector.SelectVirtualHostOptions(&v1.DelegateOptionsRefs{
+			Selector: &v1.OptionsSelector{Labels: labelSet},
+		}, "ns-1")
+		Expect(vhSelected).To(BeEmpty())
+		Expect(warnings).To(ConsistOf(translator.NoMatchingVirtualHostOptionsWarning))
+	})
+
+	It("selects virtual host options", func() {
+		vhOption := &v1.VirtualHostOption{Metadata: core.Metadata{Name: "vh", Namespace: "ns-1", Labels: labelSet}}
+		selector = translator.NewOptionsSelector(v1.VirtualHostOptionList{vhOption}, nil)
+
+		missing := core.ResourceRef{Name: "missing", Namespace: "ns-1"}
+		selected, warnings := selector.SelectVirtualHostOptions(&v1.DelegateOptionsRefs{
+			DelegateOptions: []core.ResourceRef{missing},
+			Selector:        &v1.OptionsSelector{Labels: labelSet},
+		}, "ns-1")
+		Expect(selected).To(Equal(v1.VirtualHostOptionList{vhOption}))
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0]).To(MatchError(translator.VirtualHostOptionMissingWarning(missing).Error()))
+	})
+})
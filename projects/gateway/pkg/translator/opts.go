@@ -11,6 +11,8 @@ type Opts struct {
 	Gateways                      factory.ResourceClientFactory
 	VirtualServices               factory.ResourceClientFactory
 	RouteTables                   factory.ResourceClientFactory
+	VirtualHostOptions            factory.ResourceClientFactory
+	RouteOptions                  factory.ResourceClientFactory
 	Proxies                       factory.ResourceClientFactory
 	WatchOpts                     clients.WatchOpts
 	ValidationServerAddress       string
@@ -7,8 +7,8 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 )
 
-// Reserved value for route table namespace selection.
-// If a selector contains this value in its 'namespace' field, we match route tables from any namespace
+// Reserved value for route table and option namespace selection.
+// If a selector contains this value in its 'namespace' field, we match resources from any namespace
 const allNamespaceRouteTableSelector = "*"
 
 var (
@@ -62,61 +62,36 @@ func (s *selector) SelectRouteTables(action *gatewayv1.DelegateAction, parentNam
 // Returns the subset of `routeTables` that matches the given `selector`.
 // Search will be restricted to the `ownerNamespace` if the selector does not specify any namespaces.
 func RouteTablesForSelector(routeTables gatewayv1.RouteTableList, selector *gatewayv1.RouteTableSelector, ownerNamespace string) gatewayv1.RouteTableList {
-	type nsSelectorType int
-	const (
-		// Match route tables in the owner namespace
-		owner nsSelectorType = iota
-		// Match route tables in all namespaces watched by Gloo
-		all
-		// Match route tables in the specified namespaces
-		list
-	)
-
-	nsSelector := owner
-	if len(selector.Namespaces) > 0 {
-		nsSelector = list
-	}
-	for _, ns := range selector.Namespaces {
-		if ns == allNamespaceRouteTableSelector {
-			nsSelector = all
+	var matchingRouteTables gatewayv1.RouteTableList
+	for _, candidate := range routeTables {
+		if metadataMatchesSelector(candidate.Metadata, selector.Namespaces, selector.Labels, ownerNamespace) {
+			matchingRouteTables = append(matchingRouteTables, candidate)
 		}
 	}
 
-	var labelSelector labels.Selector
-	if len(selector.Labels) > 0 {
-		labelSelector = labels.SelectorFromSet(selector.Labels)
-	}
-
-	var matchingRouteTables gatewayv1.RouteTableList
-	for _, candidate := range routeTables {
+	return matchingRouteTables
+}
 
-		// Check whether labels match
-		if labelSelector != nil {
-			rtLabels := labels.Set(candidate.Metadata.Labels)
-			if !labelSelector.Matches(rtLabels) {
-				continue
-			}
-		}
+// Returns true if the resource with the given metadata matches a selector with the given namespaces and labels.
+// Resources will only match if they live in the `ownerNamespace` when the selector does not specify any namespaces.
+func metadataMatchesSelector(metadata core.Metadata, selectorNamespaces []string, selectorLabels map[string]string, ownerNamespace string) bool {
 
-		// Check whether namespace matches
-		nsMatches := false
-		switch nsSelector {
-		case all:
-			nsMatches = true
-		case owner:
-			nsMatches = candidate.Metadata.Namespace == ownerNamespace
-		case list:
-			for _, ns := range selector.Namespaces {
-				if ns == candidate.Metadata.Namespace {
-					nsMatches = true
-				}
-			}
+	// Check whether labels match
+	if len(selectorLabels) > 0 {
+		labelSelector := labels.SelectorFromSet(selectorLabels)
+		if !labelSelector.Matches(labels.Set(metadata.Labels)) {
+			return false
 		}
+	}
 
-		if nsMatches {
-			matchingRouteTables = append(matchingRouteTables, candidate)
+	// Check whether namespace matches
+	if len(selectorNamespaces) == 0 {
+		return metadata.Namespace == ownerNamespace
+	}
+	for _, ns := range selectorNamespaces {
+		if ns == allNamespaceRouteTableSelector || ns == metadata.Namespace {
+			return true
 		}
 	}
-
-	return matchingRouteTables
+	return false
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/als"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
@@ -52,6 +53,8 @@ func (t *translator) Translate(ctx context.Context, proxyName, namespace string,
 	reports.Accept(snap.Gateways.AsInputResources()...)
 	reports.Accept(snap.VirtualServices.AsInputResources()...)
 	reports.Accept(snap.RouteTables.AsInputResources()...)
+	reports.Accept(snap.VirtualHostOptions.AsInputResources()...)
+	reports.Accept(snap.RouteOptions.AsInputResources()...)
 	if len(filteredGateways) == 0 {
 		snapHash := hashutils.MustHash(snap)
 		logger.Infof("%v had no gateways", snapHash)
@@ -96,6 +99,10 @@ func validateGateways(gateways v1.GatewayList, virtualServices v1.VirtualService
 		bindAddress := fmt.Sprintf("%s:%d", gw.BindAddress, gw.BindPort)
 		bindAddresses[bindAddress] = append(bindAddresses[bindAddress], gw)
 
+		if err := als.ValidateAccessLoggingService(gw.GetOptions().GetAccessLoggingService()); err != nil {
+			reports.AddError(gw, err)
+		}
+
 		if httpGw := gw.GetHttpGateway(); httpGw != nil {
 			for _, vs := range httpGw.VirtualServices {
 				if _, err := virtualServices.Find(vs.Strings()); err != nil {
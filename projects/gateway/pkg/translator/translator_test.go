@@ -10,6 +10,9 @@ import (
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
+	alsplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/als"
 
 	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
@@ -187,6 +190,30 @@ var _ = Describe("Translator", func() {
 			Expect(proxy.Listeners).To(HaveLen(2))
 		})
 
+		It("should error on gateways with invalid access log formats", func() {
+			snap.Gateways[0].Options = &gloov1.ListenerOptions{
+				AccessLoggingService: &als.AccessLoggingService{
+					AccessLog: []*als.AccessLog{{
+						OutputDestination: &als.AccessLog_FileSink{
+							FileSink: &als.FileSink{
+								Path: "/dev/stdout",
+								OutputFormat: &als.FileSink_JsonFields{
+									JsonFields: &als.JsonFields{
+										Fields: map[string]string{"status": "%STATUS_CODE%"},
+									},
+								},
+							},
+						},
+					}},
+				},
+			}
+
+			_, errs := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			err := errs.ValidateStrict()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(alsplugin.UnknownCommandOperatorErr("STATUS_CODE").Error()))
+		})
+
 		It("should error on two gateways with the same port in the same namespace", func() {
 			dupeGateway := v1.Gateway{
 				Metadata: core.Metadata{Namespace: ns, Name: "name2"},
@@ -593,6 +620,150 @@ var _ = Describe("Translator", func() {
 					Expect(errs.Error()).To(ContainSubstring(NoVirtualHostErr(snap.VirtualServices[0]).Error()))
 				})
 			})
+
+			Context("default virtual service and unmatched responses", func() {
+
+				var (
+					httpGateway *v1.HttpGateway
+					response    = &gloov1.DirectResponseAction{Status: 404, Body: "branded not found"}
+				)
+
+				BeforeEach(func() {
+					httpGateway = snap.Gateways[0].GetHttpGateway()
+					httpGateway.VirtualServices = []core.ResourceRef{snap.VirtualServices[0].Metadata.Ref()}
+				})
+
+				listenerFor := func(proxy *gloov1.Proxy) *gloov1.HttpListener {
+					Expect(proxy.Listeners).To(HaveLen(1))
+					return proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+				}
+
+				It("appends the default virtual service as a '*' virtual host after the others", func() {
+					ref := snap.VirtualServices[2].Metadata.Ref()
+					httpGateway.DefaultVirtualService = &ref
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+					listener := listenerFor(proxy)
+					Expect(listener.VirtualHosts).To(HaveLen(2))
+					Expect(listener.VirtualHosts[0].Name).To(Equal(VirtualHostName(snap.VirtualServices[0])))
+					Expect(listener.VirtualHosts[1].Name).To(Equal(VirtualHostName(snap.VirtualServices[2])))
+					Expect(listener.VirtualHosts[1].Domains).To(Equal([]string{"*"}))
+
+					// the virtual service is left untouched
+					Expect(snap.VirtualServices[2].VirtualHost.Domains).To(Equal([]string{"d3.com"}))
+				})
+
+				It("adds the default virtual service only once if the gateway selects it", func() {
+					httpGateway.VirtualServices = nil
+					ref := snap.VirtualServices[0].Metadata.Ref()
+					httpGateway.DefaultVirtualService = &ref
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+					listener := listenerFor(proxy)
+					Expect(listener.VirtualHosts).To(HaveLen(3))
+					Expect(listener.VirtualHosts[2].Name).To(Equal(VirtualHostName(snap.VirtualServices[0])))
+					Expect(listener.VirtualHosts[2].Domains).To(Equal([]string{"*"}))
+				})
+
+				It("reports the virtual services that also claim the '*' domain", func() {
+					ref := snap.VirtualServices[2].Metadata.Ref()
+					httpGateway.DefaultVirtualService = &ref
+					snap.VirtualServices[0].VirtualHost.Domains = []string{"*"}
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+
+					listener := listenerFor(proxy)
+					Expect(listener.VirtualHosts).To(HaveLen(2))
+					Expect(listener.VirtualHosts[1].Name).To(Equal(VirtualHostName(snap.VirtualServices[2])))
+					Expect(listener.VirtualHosts[1].Domains).To(Equal([]string{"*"}))
+
+					Expect(reports[snap.VirtualServices[0]].Errors).To(MatchError(ContainSubstring(
+						DomainClaimedByDefaultVirtualServiceErr(ref).Error())))
+					Expect(reports[snap.Gateways[0]].Errors).To(MatchError(ContainSubstring(
+						GatewayHasConflictingDefaultVirtualServiceErr([]string{snap.VirtualServices[0].Metadata.Ref().Key()}).Error())))
+				})
+
+				It("reports and ignores a default virtual service whose ssl config does not match the gateway", func() {
+					ref := snap.VirtualServices[2].Metadata.Ref()
+					httpGateway.DefaultVirtualService = &ref
+					snap.VirtualServices[2].SslConfig = &gloov1.SslConfig{
+						SslSecrets: &gloov1.SslConfig_SecretRef{
+							SecretRef: &core.ResourceRef{Namespace: ns, Name: "tls"},
+						},
+					}
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports[snap.Gateways[0]].Errors).To(MatchError(ContainSubstring(
+						DefaultVirtualServiceSslMismatchErr(ref, false).Error())))
+
+					// the plain gateway does not turn into an ssl one
+					Expect(proxy.Listeners).To(HaveLen(1))
+					Expect(proxy.Listeners[0].SslConfigurations).To(BeEmpty())
+					listener := listenerFor(proxy)
+					Expect(listener.VirtualHosts).To(HaveLen(1))
+					Expect(listener.VirtualHosts[0].Name).To(Equal(VirtualHostName(snap.VirtualServices[0])))
+				})
+
+				It("warns if the default virtual service is missing", func() {
+					ref := core.ResourceRef{Namespace: ns, Name: "missing"}
+					httpGateway.DefaultVirtualService = &ref
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.Validate()).NotTo(HaveOccurred())
+					Expect(reports[snap.Gateways[0]].Warnings).To(ConsistOf(DefaultVirtualServiceMissingWarning(ref).Error()))
+					Expect(listenerFor(proxy).VirtualHosts).To(HaveLen(1))
+				})
+
+				It("appends the unmatched path response to every virtual host", func() {
+					ref := snap.VirtualServices[2].Metadata.Ref()
+					httpGateway.DefaultVirtualService = &ref
+					httpGateway.UnmatchedPathResponse = response
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+					expectedRoute := &gloov1.Route{
+						Matchers: []*matchers.Matcher{defaults.DefaultMatcher()},
+						Action:   &gloov1.Route_DirectResponseAction{DirectResponseAction: response},
+					}
+					listener := listenerFor(proxy)
+					Expect(listener.VirtualHosts).To(HaveLen(2))
+					for _, vh := range listener.VirtualHosts {
+						Expect(vh.Routes).To(HaveLen(2))
+						Expect(vh.Routes[1]).To(Equal(expectedRoute))
+					}
+				})
+
+				It("adds a '*' virtual host with the unmatched host response", func() {
+					httpGateway.UnmatchedHostResponse = response
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+
+					listener := listenerFor(proxy)
+					Expect(listener.VirtualHosts).To(HaveLen(2))
+					vh := listener.VirtualHosts[1]
+					Expect(vh.Name).To(Equal(UnmatchedHostVirtualHostName(snap.Gateways[0])))
+					Expect(vh.Domains).To(Equal([]string{"*"}))
+					Expect(vh.Routes).To(HaveLen(1))
+					Expect(vh.Routes[0].GetDirectResponseAction()).To(Equal(response))
+				})
+
+				It("ignores the unmatched host response if a virtual service claims the '*' domain", func() {
+					httpGateway.UnmatchedHostResponse = response
+					snap.VirtualServices[0].VirtualHost.Domains = nil
+
+					proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+					Expect(reports.Validate()).NotTo(HaveOccurred())
+					Expect(reports[snap.Gateways[0]].Warnings).To(ConsistOf(UnmatchedHostResponseIgnoredWarning(
+						[]string{snap.VirtualServices[0].Metadata.Ref().Key()}).Error()))
+					Expect(listenerFor(proxy).VirtualHosts).To(HaveLen(1))
+				})
+			})
 		})
 
 		Context("using RouteTables and delegation", func() {
@@ -1060,6 +1231,184 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("using RouteOptions and VirtualHostOptions", func() {
+
+		var (
+			parentTimeout = time.Minute
+			refTimeout    = time.Second
+		)
+
+		prefixRoute := func(prefix string, options *gloov1.RouteOptions, refs *v1.DelegateOptionsRefs) *v1.Route {
+			return &v1.Route{
+				Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: prefix},
+				}},
+				Action: &v1.Route_DirectResponseAction{
+					DirectResponseAction: &gloov1.DirectResponseAction{Status: 200},
+				},
+				Options:           options,
+				OptionsConfigRefs: refs,
+			}
+		}
+
+		BeforeEach(func() {
+			translator = NewTranslator([]ListenerFactory{&HttpTranslator{}}, Opts{})
+			delegatedRoute := prefixRoute("/delegated", &gloov1.RouteOptions{
+				PrefixRewrite: &types.StringValue{Value: "parent"},
+				Timeout:       &parentTimeout,
+				Retries:       &retries.RetryPolicy{RetryOn: "parent"},
+			}, nil)
+			delegatedRoute.Action = &v1.Route_DelegateAction{
+				DelegateAction: &v1.DelegateAction{
+					DelegationType: &v1.DelegateAction_Ref{
+						Ref: &core.ResourceRef{Name: "rt", Namespace: ns},
+					},
+				},
+			}
+
+			snap = &v1.ApiSnapshot{
+				Gateways: v1.GatewayList{
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "name"},
+						GatewayType: &v1.Gateway_HttpGateway{
+							HttpGateway: &v1.HttpGateway{},
+						},
+						BindPort: 2,
+					},
+				},
+				VirtualServices: v1.VirtualServiceList{
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "vs"},
+						VirtualHost: &v1.VirtualHost{
+							Domains: []string{"d1.com"},
+							Options: &gloov1.VirtualHostOptions{
+								Waf: &waf.Settings{CustomInterventionMessage: "inline"},
+							},
+							OptionsConfigRefs: &v1.DelegateOptionsRefs{
+								DelegateOptions: []core.ResourceRef{{Name: "vh-opts-ref", Namespace: ns}},
+								Selector:        &v1.OptionsSelector{Labels: labelSet},
+							},
+							Routes: []*v1.Route{
+								prefixRoute("/inline", &gloov1.RouteOptions{
+									PrefixRewrite: &types.StringValue{Value: "inline"},
+								}, &v1.DelegateOptionsRefs{
+									DelegateOptions: []core.ResourceRef{{Name: "rt-opts-ref", Namespace: ns}},
+									Selector:        &v1.OptionsSelector{Labels: labelSet},
+								}),
+								delegatedRoute,
+							},
+						},
+					},
+				},
+				RouteTables: v1.RouteTableList{
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "rt"},
+						Routes: []*v1.Route{
+							prefixRoute("/delegated/child", nil, &v1.DelegateOptionsRefs{
+								DelegateOptions: []core.ResourceRef{{Name: "rt-opts-ref", Namespace: ns}},
+							}),
+						},
+					},
+				},
+				VirtualHostOptions: v1.VirtualHostOptionList{
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "vh-opts-ref"},
+						Options: &gloov1.VirtualHostOptions{
+							Waf:     &waf.Settings{CustomInterventionMessage: "ref"},
+							Retries: &retries.RetryPolicy{RetryOn: "ref"},
+						},
+					},
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "vh-opts-selected", Labels: labelSet},
+						Options: &gloov1.VirtualHostOptions{
+							Retries: &retries.RetryPolicy{RetryOn: "selector"},
+							Cors:    &cors.CorsPolicy{AllowOrigin: []string{"selector"}},
+						},
+					},
+				},
+				RouteOptions: v1.RouteOptionList{
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "rt-opts-ref"},
+						Options: &gloov1.RouteOptions{
+							PrefixRewrite: &types.StringValue{Value: "ref"},
+							Timeout:       &refTimeout,
+						},
+					},
+					{
+						Metadata: core.Metadata{Namespace: ns, Name: "rt-opts-selected", Labels: labelSet},
+						Options: &gloov1.RouteOptions{
+							Timeout: &parentTimeout,
+							Retries: &retries.RetryPolicy{RetryOn: "selector"},
+						},
+					},
+				},
+			}
+		})
+
+		getVirtualHost := func(proxy *gloov1.Proxy) *gloov1.VirtualHost {
+			Expect(proxy.Listeners).To(HaveLen(1))
+			listener := proxy.Listeners[0].ListenerType.(*gloov1.Listener_HttpListener).HttpListener
+			Expect(listener.VirtualHosts).To(HaveLen(1))
+			return listener.VirtualHosts[0]
+		}
+
+		It("merges the virtual host options in order of precedence", func() {
+			proxy, errs := translator.Translate(context.TODO(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+			Expect(getVirtualHost(proxy).Options).To(Equal(&gloov1.VirtualHostOptions{
+				Waf:     &waf.Settings{CustomInterventionMessage: "inline"},
+				Retries: &retries.RetryPolicy{RetryOn: "ref"},
+				Cors:    &cors.CorsPolicy{AllowOrigin: []string{"selector"}},
+			}))
+			// the options of the virtual service are left untouched
+			Expect(snap.VirtualServices[0].VirtualHost.Options).To(Equal(&gloov1.VirtualHostOptions{
+				Waf: &waf.Settings{CustomInterventionMessage: "inline"},
+			}))
+		})
+
+		It("merges the route options in order of precedence", func() {
+			proxy, errs := translator.Translate(context.TODO(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+			routes := getVirtualHost(proxy).Routes
+			Expect(routes).To(HaveLen(2))
+			Expect(routes[0].Options).To(Equal(&gloov1.RouteOptions{
+				PrefixRewrite: &types.StringValue{Value: "inline"},
+				Timeout:       &refTimeout,
+				Retries:       &retries.RetryPolicy{RetryOn: "selector"},
+			}))
+			// the options referenced by the delegated route take precedence over the ones of its parent
+			Expect(routes[1].Options).To(Equal(&gloov1.RouteOptions{
+				PrefixRewrite: &types.StringValue{Value: "ref"},
+				Timeout:       &refTimeout,
+				Retries:       &retries.RetryPolicy{RetryOn: "parent"},
+			}))
+		})
+
+		It("warns about missing option resources and selectors that match none", func() {
+			snap.VirtualHostOptions = nil
+			snap.RouteOptions = nil
+
+			_, errs := translator.Translate(context.TODO(), defaults.GatewayProxyName, ns, snap, snap.Gateways)
+			Expect(errs.Validate()).NotTo(HaveOccurred())
+
+			vsReport := errs[snap.VirtualServices[0]]
+			Expect(vsReport.Warnings).To(ConsistOf(
+				VirtualHostOptionMissingWarning(core.ResourceRef{Name: "vh-opts-ref", Namespace: ns}).Error(),
+				NoMatchingVirtualHostOptionsWarning.Error(),
+				RouteOptionMissingWarning(core.ResourceRef{Name: "rt-opts-ref", Namespace: ns}).Error(),
+				NoMatchingRouteOptionsWarning.Error(),
+				TopLevelVirtualResourceErr(snap.RouteTables[0].Metadata,
+					RouteOptionMissingWarning(core.ResourceRef{Name: "rt-opts-ref", Namespace: ns})).Error(),
+			))
+			rtReport := errs[snap.RouteTables[0]]
+			Expect(rtReport.Warnings).To(ConsistOf(
+				RouteOptionMissingWarning(core.ResourceRef{Name: "rt-opts-ref", Namespace: ns}).Error(),
+			))
+		})
+	})
+
 	Context("tcp", func() {
 		var (
 			factory     *TcpTranslator
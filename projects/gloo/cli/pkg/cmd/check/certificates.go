@@ -0,0 +1,160 @@
+package check
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/spf13/cobra"
+)
+
+func certificatesCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "certificates",
+		Short: "lists the TLS secrets referenced by upstreams and virtual services, with the expiry of their certificates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ok, err := checkCertificates(opts)
+			if err != nil {
+				// Not returning error here because this shouldn't propagate as a standard CLI error, which prints usage.
+				fmt.Printf("Error!\n")
+				fmt.Printf("%s\n", err.Error())
+				os.Exit(1)
+			} else if !ok {
+				fmt.Printf("Problems detected!\n")
+				os.Exit(1)
+			}
+			return nil
+		},
+	}
+	pflags := cmd.PersistentFlags()
+	pflags.DurationVar(&opts.Check.CertificateWarningPeriod, "warning-period", utils.DefaultCertificateExpiryWarningPeriod,
+		"report certificates that expire within this period")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+// CertificateReport describes the certificates of a TLS secret and the resources that reference it.
+type CertificateReport struct {
+	Secret       core.ResourceRef
+	ReferencedBy []string
+	// the earliest expiry of the certificate chain and root CA of the secret
+	NotAfter time.Time
+	// set if the secret could not be found or its certificates could not be parsed
+	Err error
+}
+
+// Status returns a description of the certificate expiry, and whether it is a problem.
+func (r CertificateReport) Status(now time.Time, warningPeriod time.Duration) (string, bool) {
+	switch {
+	case r.Err != nil:
+		return r.Err.Error(), false
+	case !now.Before(r.NotAfter):
+		return "expired", false
+	case r.NotAfter.Sub(now) <= warningPeriod:
+		return fmt.Sprintf("expires in %v", r.NotAfter.Sub(now).Round(time.Minute)), false
+	}
+	return "OK", true
+}
+
+// CertificateReports returns a report for each TLS secret referenced by the ssl configs of the upstreams and
+// virtual services, sorted by secret.
+func CertificateReports(upstreams v1.UpstreamList, virtualServices gatewayv1.VirtualServiceList, secrets v1.SecretList) []CertificateReport {
+	referencedBy := make(map[core.ResourceRef][]string)
+	for _, upstream := range upstreams {
+		if ref := upstream.GetSslConfig().GetSecretRef(); ref != nil {
+			referencedBy[*ref] = append(referencedBy[*ref], "upstream "+renderMetadata(upstream.GetMetadata()))
+		}
+	}
+	for _, virtualService := range virtualServices {
+		if ref := virtualService.GetSslConfig().GetSecretRef(); ref != nil {
+			referencedBy[*ref] = append(referencedBy[*ref], "virtual service "+renderMetadata(virtualService.GetMetadata()))
+		}
+	}
+
+	var reports []CertificateReport
+	for ref, resources := range referencedBy {
+		notAfter, err := utils.SecretCertificateNotAfter(ref, secrets)
+		reports = append(reports, CertificateReport{
+			Secret:       ref,
+			ReferencedBy: resources,
+			NotAfter:     notAfter,
+			Err:          err,
+		})
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Secret.Key() < reports[j].Secret.Key()
+	})
+	return reports
+}
+
+func checkCertificates(opts *options.Options) (bool, error) {
+	settings, err := getSettings(opts)
+	if err != nil {
+		return false, err
+	}
+	namespaces, err := getNamespaces(settings)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		upstreams       v1.UpstreamList
+		virtualServices gatewayv1.VirtualServiceList
+		secrets         v1.SecretList
+	)
+	secretClient := helpers.MustSecretClientWithOptions(5*time.Second, namespaces)
+	for _, ns := range namespaces {
+		nsUpstreams, err := helpers.MustNamespacedUpstreamClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return false, err
+		}
+		upstreams = append(upstreams, nsUpstreams...)
+		nsVirtualServices, err := helpers.MustNamespacedVirtualServiceClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return false, err
+		}
+		virtualServices = append(virtualServices, nsVirtualServices...)
+		nsSecrets, err := secretClient.List(ns, clients.ListOpts{})
+		if err != nil {
+			return false, err
+		}
+		secrets = append(secrets, nsSecrets...)
+	}
+
+	reports := CertificateReports(upstreams, virtualServices, secrets)
+	return CertificateTable(reports, time.Now(), opts.Check.CertificateWarningPeriod, os.Stdout), nil
+}
+
+// CertificateTable prints the certificate reports, and returns false if any of them has a problem.
+func CertificateTable(reports []CertificateReport, now time.Time, warningPeriod time.Duration, w io.Writer) bool {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Secret", "Referenced by", "Expires", "Status"})
+
+	ok := true
+	for _, report := range reports {
+		status, reportOk := report.Status(now, warningPeriod)
+		ok = ok && reportOk
+		expires := ""
+		if report.Err == nil {
+			expires = report.NotAfter.UTC().Format(time.RFC3339)
+		}
+		table.Append([]string{renderRef(&report.Secret), strings.Join(report.ReferencedBy, "\n"), expires, status})
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAutoWrapText(false)
+	table.Render()
+	return ok
+}
@@ -0,0 +1,102 @@
+package check_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/check"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Certificates", func() {
+
+	var (
+		now       time.Time
+		secrets   v1.SecretList
+		upstreams v1.UpstreamList
+		vss       gatewayv1.VirtualServiceList
+	)
+
+	tlsSecret := func(name string, validFor time.Duration) *v1.Secret {
+		validFrom := now.Add(-time.Hour)
+		validFor += time.Hour
+		cert, key := helpers.GetCerts(helpers.Params{Hosts: name, ValidFrom: &validFrom, ValidFor: &validFor})
+		return &v1.Secret{
+			Metadata: core.Metadata{Name: name, Namespace: "gloo-system"},
+			Kind: &v1.Secret_Tls{
+				Tls: &v1.TlsSecret{CertChain: cert, PrivateKey: key},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Now().Truncate(time.Second)
+		secrets = v1.SecretList{
+			tlsSecret("valid", 90*24*time.Hour),
+			tlsSecret("expiring", 24*time.Hour),
+		}
+		upstreams = v1.UpstreamList{{
+			Metadata: core.Metadata{Name: "us", Namespace: "gloo-system"},
+			SslConfig: &v1.UpstreamSslConfig{
+				SslSecrets: &v1.UpstreamSslConfig_SecretRef{
+					SecretRef: &core.ResourceRef{Name: "expiring", Namespace: "gloo-system"},
+				},
+			},
+		}}
+		vss = gatewayv1.VirtualServiceList{
+			{
+				Metadata: core.Metadata{Name: "vs", Namespace: "default"},
+				SslConfig: &v1.SslConfig{
+					SslSecrets: &v1.SslConfig_SecretRef{
+						SecretRef: &core.ResourceRef{Name: "valid", Namespace: "gloo-system"},
+					},
+				},
+			},
+			{
+				Metadata: core.Metadata{Name: "vs2", Namespace: "default"},
+				SslConfig: &v1.SslConfig{
+					SslSecrets: &v1.SslConfig_SecretRef{
+						SecretRef: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"},
+					},
+				},
+			},
+		}
+	})
+
+	It("reports the expiry of every referenced secret", func() {
+		reports := check.CertificateReports(upstreams, vss, secrets)
+		Expect(reports).To(HaveLen(3))
+
+		Expect(reports[0].Secret.Name).To(Equal("expiring"))
+		Expect(reports[0].ReferencedBy).To(Equal([]string{"upstream gloo-system us"}))
+		Expect(reports[0].NotAfter).To(BeTemporally("==", now.Add(24*time.Hour)))
+		status, ok := reports[0].Status(now, 30*24*time.Hour)
+		Expect(status).To(Equal("expires in 24h0m0s"))
+		Expect(ok).To(BeFalse())
+
+		Expect(reports[1].Secret.Name).To(Equal("missing"))
+		Expect(reports[1].Err).To(HaveOccurred())
+
+		Expect(reports[2].Secret.Name).To(Equal("valid"))
+		Expect(reports[2].ReferencedBy).To(Equal([]string{"virtual service default vs"}))
+		status, ok = reports[2].Status(now, 30*24*time.Hour)
+		Expect(status).To(Equal("OK"))
+		Expect(ok).To(BeTrue())
+	})
+
+	It("prints the reports and detects problems", func() {
+		var out bytes.Buffer
+		ok := check.CertificateTable(check.CertificateReports(upstreams, vss[:1], secrets), now, time.Hour, &out)
+		Expect(ok).To(BeTrue())
+		Expect(out.String()).To(ContainSubstring("gloo-system expiring"))
+		Expect(out.String()).To(ContainSubstring("virtual service default vs"))
+
+		ok = check.CertificateTable(check.CertificateReports(upstreams, vss[:1], secrets), now, 30*24*time.Hour, &out)
+		Expect(ok).To(BeFalse())
+	})
+})
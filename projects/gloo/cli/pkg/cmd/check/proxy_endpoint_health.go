@@ -0,0 +1,145 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	envoyadmin "github.com/envoyproxy/go-control-plane/envoy/admin/v2alpha"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/solo-io/gloo/pkg/cliutil"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	v1 "k8s.io/api/apps/v1"
+)
+
+const clustersPath = "/clusters?format=json"
+
+// EndpointHealth counts the endpoints of an Envoy cluster by their health, as reported by the Envoy admin API.
+type EndpointHealth struct {
+	Total             int
+	Healthy           int
+	FailedHealthCheck int
+	Ejected           int
+}
+
+// ParseEndpointHealth returns the endpoint health of each cluster in the JSON output of the
+// Envoy admin /clusters endpoint, keyed by cluster name.
+func ParseEndpointHealth(clusters string) (map[string]EndpointHealth, error) {
+	var clusterStatuses envoyadmin.Clusters
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := unmarshaler.Unmarshal(strings.NewReader(clusters), &clusterStatuses); err != nil {
+		return nil, err
+	}
+
+	endpointHealth := make(map[string]EndpointHealth)
+	for _, cluster := range clusterStatuses.GetClusterStatuses() {
+		var health EndpointHealth
+		for _, host := range cluster.GetHostStatuses() {
+			health.Total++
+			hostHealth := host.GetHealthStatus()
+			if hostHealth.GetFailedActiveHealthCheck() {
+				health.FailedHealthCheck++
+			}
+			if hostHealth.GetFailedOutlierCheck() {
+				health.Ejected++
+			}
+			if !hostHealth.GetFailedActiveHealthCheck() && !hostHealth.GetFailedOutlierCheck() &&
+				(hostHealth.GetEdsHealthStatus() == envoycore.HealthStatus_HEALTHY ||
+					hostHealth.GetEdsHealthStatus() == envoycore.HealthStatus_UNKNOWN) {
+				health.Healthy++
+			}
+		}
+		endpointHealth[cluster.GetName()] = health
+	}
+	return endpointHealth, nil
+}
+
+// Unhealthy endpoints are expected while upstreams scale or recover from outlier ejection, so they are only reported
+// as warnings and do not fail the check.
+func checkProxiesEndpointHealth(ctx context.Context, namespaces []string, glooNamespace string, deployments *v1.DeploymentList) (bool, error) {
+	fmt.Printf("Checking upstream endpoint health... ")
+
+	upstreamsByCluster := make(map[string]string)
+	for _, ns := range namespaces {
+		upstreams, err := helpers.MustNamespacedUpstreamClient(ns).List(ns, clients.ListOpts{})
+		if err != nil {
+			return false, err
+		}
+		for _, upstream := range upstreams {
+			upstreamsByCluster[translator.UpstreamToClusterName(upstream.GetMetadata().Ref())] = renderMetadata(upstream.GetMetadata())
+		}
+	}
+
+	var warnings []string
+	for _, deployment := range deployments.Items {
+		if deployment.Name == "gateway-proxy" || deployment.Name == "ingress-proxy" || deployment.Name == "knative-external-proxy" || deployment.Name == "knative-internal-proxy" {
+			endpointHealth, err := getProxyEndpointHealth(ctx, glooNamespace, deployment.Name)
+			if err != nil {
+				return false, err
+			}
+			warnings = append(warnings, UnhealthyEndpointWarnings(deployment.Name, endpointHealth, upstreamsByCluster)...)
+		}
+	}
+	if len(warnings) == 0 {
+		fmt.Printf("OK\n")
+		return true, nil
+	}
+	fmt.Printf("%d warnings\n", len(warnings))
+	for _, warning := range warnings {
+		fmt.Print(warning)
+	}
+	return true, nil
+}
+
+func getProxyEndpointHealth(ctx context.Context, glooNamespace string, deploymentName string) (map[string]EndpointHealth, error) {
+	errMessage := "Problem while checking upstream endpoint health"
+
+	// port-forward proxy deployment and get cluster statuses
+	freePort, err := cliutil.GetFreePort()
+	if err != nil {
+		fmt.Println(errMessage)
+		return nil, err
+	}
+	localPort := strconv.Itoa(freePort)
+	adminPort := strconv.Itoa(int(defaults.EnvoyAdminPort))
+	clusters, portFwdCmd, err := cliutil.PortForwardGet(ctx, glooNamespace, "deploy/"+deploymentName,
+		localPort, adminPort, false, clustersPath)
+	if err != nil {
+		fmt.Println(errMessage)
+		return nil, err
+	}
+	if portFwdCmd.Process != nil {
+		defer portFwdCmd.Process.Release()
+		defer portFwdCmd.Process.Kill()
+	}
+
+	endpointHealth, err := ParseEndpointHealth(clusters)
+	if err != nil {
+		fmt.Println(errMessage + ": could not parse the output of the " + clustersPath + " endpoint of the " + deploymentName + " deployment")
+		return nil, err
+	}
+	return endpointHealth, nil
+}
+
+// UnhealthyEndpointWarnings returns a warning, sorted by upstream, for each upstream that has unhealthy endpoints in
+// the given proxy deployment.
+func UnhealthyEndpointWarnings(deploymentName string, endpointHealth map[string]EndpointHealth, upstreamsByCluster map[string]string) []string {
+	var warnings []string
+	for cluster, health := range endpointHealth {
+		upstream, ok := upstreamsByCluster[cluster]
+		if !ok || health.Healthy == health.Total {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("Warning: found upstream with unhealthy endpoints in %s: %s\n"+
+			"Healthy endpoints: %d/%d (%d failing health checks, %d ejected by outlier detection)\n",
+			deploymentName, upstream, health.Healthy, health.Total, health.FailedHealthCheck, health.Ejected))
+	}
+	sort.Strings(warnings)
+	return warnings
+}
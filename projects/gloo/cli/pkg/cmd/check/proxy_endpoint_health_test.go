@@ -0,0 +1,93 @@
+package check_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/check"
+)
+
+var _ = Describe("Proxy Endpoint Health", func() {
+
+	It("counts healthy, failing and ejected endpoints per cluster", func() {
+		clusters := `
+{
+ "cluster_statuses": [
+  {
+   "name": "default-petstore-8080_gloo-system",
+   "added_via_api": true,
+   "host_statuses": [
+    {
+     "address": {"socket_address": {"address": "10.0.0.1", "port_value": 8080}},
+     "stats": [{"name": "cx_total", "value": "3"}],
+     "health_status": {"eds_health_status": "HEALTHY"},
+     "weight": 1
+    },
+    {
+     "address": {"socket_address": {"address": "10.0.0.2", "port_value": 8080}},
+     "health_status": {"failed_outlier_check": true, "eds_health_status": "HEALTHY"},
+     "weight": 1
+    },
+    {
+     "address": {"socket_address": {"address": "10.0.0.3", "port_value": 8080}},
+     "health_status": {"failed_active_health_check": true, "eds_health_status": "HEALTHY"},
+     "weight": 1
+    },
+    {
+     "address": {"socket_address": {"address": "10.0.0.4", "port_value": 8080}},
+     "health_status": {"eds_health_status": "DRAINING"},
+     "weight": 1
+    }
+   ]
+  },
+  {
+   "name": "static_gloo-system",
+   "added_via_api": true,
+   "host_statuses": [
+    {
+     "address": {"socket_address": {"address": "10.0.0.5", "port_value": 80}},
+     "health_status": {}
+    }
+   ]
+  }
+ ]
+}
+`
+		endpointHealth, err := check.ParseEndpointHealth(clusters)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpointHealth).To(Equal(map[string]check.EndpointHealth{
+			"default-petstore-8080_gloo-system": {
+				Total:             4,
+				Healthy:           1,
+				FailedHealthCheck: 1,
+				Ejected:           1,
+			},
+			"static_gloo-system": {
+				Total:   1,
+				Healthy: 1,
+			},
+		}))
+	})
+
+	It("warns about the upstreams with unhealthy endpoints", func() {
+		endpointHealth := map[string]check.EndpointHealth{
+			"default-petstore-8080_gloo-system": {Total: 4, Healthy: 1, FailedHealthCheck: 1, Ejected: 1},
+			"default-other-8080_gloo-system":    {Total: 2, Healthy: 2},
+			"unknown-cluster":                   {Total: 1},
+		}
+		upstreamsByCluster := map[string]string{
+			"default-petstore-8080_gloo-system": "gloo-system default-petstore-8080",
+			"default-other-8080_gloo-system":    "gloo-system default-other-8080",
+		}
+
+		warnings := check.UnhealthyEndpointWarnings("gateway-proxy", endpointHealth, upstreamsByCluster)
+		Expect(warnings).To(Equal([]string{
+			"Warning: found upstream with unhealthy endpoints in gateway-proxy: gloo-system default-petstore-8080\n" +
+				"Healthy endpoints: 1/4 (1 failing health checks, 1 ejected by outlier detection)\n",
+		}))
+	})
+
+	It("errors on invalid output", func() {
+		_, err := check.ParseEndpointHealth("not json")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -45,6 +45,7 @@ func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.
 	}
 	pflags := cmd.PersistentFlags()
 	flagutils.AddNamespaceFlag(pflags, &opts.Metadata.Namespace)
+	cmd.AddCommand(certificatesCmd(opts))
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
@@ -107,6 +108,11 @@ func CheckResources(opts *options.Options) (bool, error) {
 		return ok, err
 	}
 
+	ok, err = checkProxiesEndpointHealth(opts.Top.Ctx, namespaces, opts.Metadata.Namespace, deployments)
+	if !ok || err != nil {
+		return ok, err
+	}
+
 	ok, err = checkGlooePromStats(opts.Top.Ctx, opts.Metadata.Namespace, deployments)
 	if !ok || err != nil {
 		return ok, err
@@ -0,0 +1,168 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	envoyadmin "github.com/envoyproxy/go-control-plane/envoy/admin/v2alpha"
+	envoytapservice "github.com/envoyproxy/go-control-plane/envoy/service/tap/v2alpha"
+	"github.com/solo-io/gloo/pkg/utils/protoutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	tapoptions "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tap"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func tapCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tap",
+		Short: "stream requests and responses tapped by one of the proxy instances",
+		Long: "stream the requests and responses matched by a tap, including their bodies, as JSON. " +
+			"The proxy's http listener must enable tapping through the admin endpoint with the `tap.admin` option.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return streamEnvoyTap(opts)
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	pflags.StringVar(&opts.Proxy.TapConfigId, "config-id", tap.DefaultAdminConfigId, "the config id of the tap, as set on the http listener's tap admin sink")
+	pflags.StringSliceVar(&opts.Proxy.TapHeaders, "header", nil, "only tap requests with this header, given as name=value, or name to match any value. can be repeated")
+	pflags.StringVar(&opts.Proxy.TapPathPrefix, "path-prefix", "", "only tap requests whose path starts with this prefix")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func tapRequest(opts *options.Options) ([]byte, error) {
+	match := &tapoptions.TapMatch{
+		PathPrefix: opts.Proxy.TapPathPrefix,
+	}
+	for _, header := range opts.Proxy.TapHeaders {
+		nameValue := strings.SplitN(header, "=", 2)
+		headerMatcher := &matchers.HeaderMatcher{
+			Name: nameValue[0],
+		}
+		if len(nameValue) == 2 {
+			headerMatcher.Value = nameValue[1]
+		}
+		match.Headers = append(match.Headers, headerMatcher)
+	}
+
+	return protoutils.MarshalBytes(&envoyadmin.TapRequest{
+		ConfigId: opts.Proxy.TapConfigId,
+		TapConfig: &envoytapservice.TapConfig{
+			MatchConfig: tap.TapMatchPredicate(opts.Top.Ctx, match),
+			OutputConfig: &envoytapservice.OutputConfig{
+				Sinks: []*envoytapservice.OutputSink{{
+					Format: envoytapservice.OutputSink_JSON_BODY_AS_STRING,
+					OutputSinkType: &envoytapservice.OutputSink_StreamingAdmin{
+						StreamingAdmin: &envoytapservice.StreamingAdminSink{},
+					},
+				}},
+			},
+		},
+	})
+}
+
+func streamEnvoyTap(opts *options.Options) error {
+	body, err := tapRequest(opts)
+	if err != nil {
+		return err
+	}
+
+	adminPort := strconv.Itoa(int(defaults.EnvoyAdminPort))
+	portFwd := exec.Command("kubectl", "port-forward", "-n", opts.Metadata.Namespace,
+		"deployment/"+opts.Proxy.Name, adminPort)
+	portFwd.Stdout = os.Stderr
+	portFwd.Stderr = os.Stderr
+	if err := portFwd.Start(); err != nil {
+		return errors.Wrapf(err, "failed to start port-forward")
+	}
+	defer func() {
+		if portFwd.Process != nil {
+			portFwd.Process.Kill()
+		}
+	}()
+	result := make(chan *http.Response)
+	errs := make(chan error)
+	go func() {
+		for {
+			select {
+			case <-opts.Top.Ctx.Done():
+				return
+			default:
+			}
+			req, err := http.NewRequest(http.MethodPost, "http://localhost:"+adminPort+"/tap", bytes.NewReader(body))
+			if err != nil {
+				errs <- err
+				return
+			}
+			res, err := http.DefaultClient.Do(req.WithContext(opts.Top.Ctx))
+			if err != nil {
+				errs <- err
+				time.Sleep(time.Millisecond * 250)
+				continue
+			}
+			if res.StatusCode != 200 {
+				res.Body.Close()
+				errs <- errors.Errorf("invalid status code: %v %v", res.StatusCode, res.Status)
+				time.Sleep(time.Millisecond * 250)
+				continue
+			}
+			result <- res
+			return
+		}
+	}()
+
+	var res *http.Response
+waitForTap:
+	for {
+		select {
+		case <-opts.Top.Ctx.Done():
+			return errors.Errorf("cancelled")
+		case err := <-errs:
+			log.Printf("connecting to envoy failed with err %v", err.Error())
+		case res = <-result:
+			break waitForTap
+		case <-time.After(time.Second * 30):
+			return errors.Errorf("timed out trying to connect to Envoy admin port")
+		}
+	}
+	defer res.Body.Close()
+
+	if err := printTraces(res.Body, os.Stdout); err != nil && opts.Top.Ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+// envoy streams each trace as a separate JSON document, until the tap is closed
+func printTraces(traces io.Reader, out io.Writer) error {
+	decoder := json.NewDecoder(traces)
+	for {
+		var trace json.RawMessage
+		if err := decoder.Decode(&trace); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrapf(err, "reading tapped traces")
+		}
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, trace, "", "  "); err != nil {
+			return err
+		}
+		fmt.Fprintln(out, indented.String())
+	}
+}
@@ -0,0 +1,103 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	envoyadmin "github.com/envoyproxy/go-control-plane/envoy/admin/v2alpha"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoytapservice "github.com/envoyproxy/go-control-plane/envoy/service/tap/v2alpha"
+	"github.com/golang/protobuf/jsonpb"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+)
+
+var _ = Describe("Tap", func() {
+
+	var opts *options.Options
+
+	BeforeEach(func() {
+		opts = &options.Options{}
+		opts.Top.Ctx = context.Background()
+		opts.Proxy.TapConfigId = "gloo-tap"
+	})
+
+	parseTapRequest := func() *envoyadmin.TapRequest {
+		body, err := tapRequest(opts)
+		Expect(err).NotTo(HaveOccurred())
+		var tapRequest envoyadmin.TapRequest
+		Expect(jsonpb.Unmarshal(bytes.NewReader(body), &tapRequest)).NotTo(HaveOccurred())
+		return &tapRequest
+	}
+
+	It("taps every request to the streaming admin sink by default", func() {
+		tapRequest := parseTapRequest()
+		Expect(tapRequest.ConfigId).To(Equal("gloo-tap"))
+		Expect(tapRequest.TapConfig.MatchConfig.GetAnyMatch()).To(BeTrue())
+
+		sinks := tapRequest.TapConfig.OutputConfig.Sinks
+		Expect(sinks).To(HaveLen(1))
+		Expect(sinks[0].Format).To(Equal(envoytapservice.OutputSink_JSON_BODY_AS_STRING))
+		Expect(sinks[0].GetStreamingAdmin()).NotTo(BeNil())
+	})
+
+	It("only taps the requests that match the headers and path prefix", func() {
+		opts.Proxy.TapHeaders = []string{"x-tenant=a", "x-debug"}
+		opts.Proxy.TapPathPrefix = "/api"
+
+		headers := parseTapRequest().TapConfig.MatchConfig.GetHttpRequestHeadersMatch().GetHeaders()
+		Expect(headers).To(HaveLen(3))
+		Expect(headers[0].Name).To(Equal("x-tenant"))
+		Expect(headers[0].GetExactMatch()).To(Equal("a"))
+		Expect(headers[1].Name).To(Equal("x-debug"))
+		Expect(headers[1].GetPresentMatch()).To(BeTrue())
+		Expect(headers[2]).To(Equal(&envoyroute.HeaderMatcher{
+			Name:                 ":path",
+			HeaderMatchSpecifier: &envoyroute.HeaderMatcher_PrefixMatch{PrefixMatch: "/api"},
+		}))
+	})
+
+	It("prints every streamed trace as indented JSON", func() {
+		traces := `{"http_buffered_trace":{"request":{"headers":[{"key":":path","value":"/api/a"}],"body":{"as_string":"ping"}}}}` +
+			`{"http_buffered_trace":{"response":{"headers":[{"key":":status","value":"200"}]}}}`
+
+		var out bytes.Buffer
+		Expect(printTraces(strings.NewReader(traces), &out)).NotTo(HaveOccurred())
+		Expect(out.String()).To(Equal(`{
+  "http_buffered_trace": {
+    "request": {
+      "headers": [
+        {
+          "key": ":path",
+          "value": "/api/a"
+        }
+      ],
+      "body": {
+        "as_string": "ping"
+      }
+    }
+  }
+}
+{
+  "http_buffered_trace": {
+    "response": {
+      "headers": [
+        {
+          "key": ":status",
+          "value": "200"
+        }
+      ]
+    }
+  }
+}
+`))
+	})
+
+	It("returns an error if a trace is not valid JSON", func() {
+		var out bytes.Buffer
+		err := printTraces(strings.NewReader(`{"http_buffered_trace":`), &out)
+		Expect(err).To(MatchError(ContainSubstring("reading tapped traces")))
+	})
+})
@@ -45,6 +45,8 @@ func init() {
 		"upstreamgroups.gloo.solo.io",
 		"virtualservices.gateway.solo.io",
 		"routetables.gateway.solo.io",
+		"virtualhostoptions.gateway.solo.io",
+		"routeoptions.gateway.solo.io",
 		"authconfigs.enterprise.gloo.solo.io",
 	}
 
@@ -3,6 +3,7 @@ package options
 import (
 	"context"
 	"sort"
+	"time"
 
 	"github.com/rotisserie/eris"
 	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
@@ -20,6 +21,7 @@ type Options struct {
 	Install   Install
 	Uninstall Uninstall
 	Proxy     Proxy
+	Check     Check
 	Upgrade   Upgrade
 	Create    Create
 	Delete    Delete
@@ -81,6 +83,13 @@ type Proxy struct {
 	Port             string
 	FollowLogs       bool
 	DebugLogs        bool
+	TapConfigId      string
+	TapHeaders       []string
+	TapPathPrefix    string
+}
+
+type Check struct {
+	CertificateWarningPeriod time.Duration
 }
 
 type Upgrade struct {
@@ -69,6 +69,8 @@ func upstreamType(up *v1.Upstream) string {
 		return "Consul"
 	case *v1.Upstream_AwsEc2:
 		return "AWS EC2"
+	case *v1.Upstream_DnsSrv:
+		return "DNS SRV"
 	case *v1.Upstream_Kube:
 		return "Kubernetes"
 	case *v1.Upstream_Static:
@@ -141,6 +143,14 @@ func upstreamDetails(up *v1.Upstream, xdsDump *xdsinspection.XdsDump) []string {
 		if usType.Consul.ServiceSpec != nil {
 			add(linesForServiceSpec(usType.Consul.ServiceSpec)...)
 		}
+	case *v1.Upstream_DnsSrv:
+		add(
+			fmt.Sprintf("record:   %v", usType.DnsSrv.Record),
+			fmt.Sprintf("resolver: %v", usType.DnsSrv.ResolverAddress),
+		)
+		if usType.DnsSrv.ServiceSpec != nil {
+			add(linesForServiceSpec(usType.DnsSrv.ServiceSpec)...)
+		}
 	case *v1.Upstream_Kube:
 		add(
 			fmt.Sprintf("svc name:      %v", usType.Kube.ServiceName),
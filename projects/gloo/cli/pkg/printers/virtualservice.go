@@ -264,6 +264,8 @@ func destinationString(route *v1.Route) string {
 			}
 		case *gloov1.RouteAction_UpstreamGroup:
 			return fmt.Sprintf("upstream group: %s.%s", dest.UpstreamGroup.Name, dest.UpstreamGroup.Namespace)
+		case *gloov1.RouteAction_DynamicForwardProxy:
+			return "dynamic forward proxy"
 		}
 	case *v1.Route_DirectResponseAction:
 		return strconv.Itoa(int(action.DirectResponseAction.Status))
@@ -54,7 +54,7 @@ func (x ListenerReport_Error_Type) String() string {
 }
 
 func (ListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{5, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{5, 0, 0}
 }
 
 type HttpListenerReport_Error_Type int32
@@ -76,7 +76,7 @@ func (x HttpListenerReport_Error_Type) String() string {
 }
 
 func (HttpListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{6, 0, 0}
 }
 
 type VirtualHostReport_Error_Type int32
@@ -107,7 +107,29 @@ func (x VirtualHostReport_Error_Type) String() string {
 }
 
 func (VirtualHostReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{7, 0, 0}
+}
+
+type VirtualHostReport_Warning_Type int32
+
+const (
+	VirtualHostReport_Warning_CertificateExpiryWarning VirtualHostReport_Warning_Type = 0
+)
+
+var VirtualHostReport_Warning_Type_name = map[int32]string{
+	0: "CertificateExpiryWarning",
+}
+
+var VirtualHostReport_Warning_Type_value = map[string]int32{
+	"CertificateExpiryWarning": 0,
+}
+
+func (x VirtualHostReport_Warning_Type) String() string {
+	return proto.EnumName(VirtualHostReport_Warning_Type_name, int32(x))
+}
+
+func (VirtualHostReport_Warning_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f4537dae4069b18, []int{7, 1, 0}
 }
 
 type RouteReport_Error_Type int32
@@ -132,7 +154,7 @@ func (x RouteReport_Error_Type) String() string {
 }
 
 func (RouteReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{8, 0, 0}
 }
 
 type RouteReport_Warning_Type int32
@@ -154,7 +176,7 @@ func (x RouteReport_Warning_Type) String() string {
 }
 
 func (RouteReport_Warning_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 1, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{8, 1, 0}
 }
 
 type TcpListenerReport_Error_Type int32
@@ -185,7 +207,7 @@ func (x TcpListenerReport_Error_Type) String() string {
 }
 
 func (TcpListenerReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{9, 0, 0}
 }
 
 type TcpHostReport_Error_Type int32
@@ -213,7 +235,7 @@ func (x TcpHostReport_Error_Type) String() string {
 }
 
 func (TcpHostReport_Error_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10, 0, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{10, 0, 0}
 }
 
 type ProxyValidationServiceRequest struct {
@@ -227,7 +249,7 @@ func (m *ProxyValidationServiceRequest) Reset()         { *m = ProxyValidationSe
 func (m *ProxyValidationServiceRequest) String() string { return proto.CompactTextString(m) }
 func (*ProxyValidationServiceRequest) ProtoMessage()    {}
 func (*ProxyValidationServiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{0}
+	return fileDescriptor_8f4537dae4069b18, []int{0}
 }
 func (m *ProxyValidationServiceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProxyValidationServiceRequest.Unmarshal(m, b)
@@ -265,7 +287,7 @@ func (m *ProxyValidationServiceResponse) Reset()         { *m = ProxyValidationS
 func (m *ProxyValidationServiceResponse) String() string { return proto.CompactTextString(m) }
 func (*ProxyValidationServiceResponse) ProtoMessage()    {}
 func (*ProxyValidationServiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{1}
+	return fileDescriptor_8f4537dae4069b18, []int{1}
 }
 func (m *ProxyValidationServiceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProxyValidationServiceResponse.Unmarshal(m, b)
@@ -302,7 +324,7 @@ func (m *NotifyOnResyncRequest) Reset()         { *m = NotifyOnResyncRequest{} }
 func (m *NotifyOnResyncRequest) String() string { return proto.CompactTextString(m) }
 func (*NotifyOnResyncRequest) ProtoMessage()    {}
 func (*NotifyOnResyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{2}
+	return fileDescriptor_8f4537dae4069b18, []int{2}
 }
 func (m *NotifyOnResyncRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyOnResyncRequest.Unmarshal(m, b)
@@ -332,7 +354,7 @@ func (m *NotifyOnResyncResponse) Reset()         { *m = NotifyOnResyncResponse{}
 func (m *NotifyOnResyncResponse) String() string { return proto.CompactTextString(m) }
 func (*NotifyOnResyncResponse) ProtoMessage()    {}
 func (*NotifyOnResyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{3}
+	return fileDescriptor_8f4537dae4069b18, []int{3}
 }
 func (m *NotifyOnResyncResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotifyOnResyncResponse.Unmarshal(m, b)
@@ -369,7 +391,7 @@ func (m *ProxyReport) Reset()         { *m = ProxyReport{} }
 func (m *ProxyReport) String() string { return proto.CompactTextString(m) }
 func (*ProxyReport) ProtoMessage()    {}
 func (*ProxyReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{4}
+	return fileDescriptor_8f4537dae4069b18, []int{4}
 }
 func (m *ProxyReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProxyReport.Unmarshal(m, b)
@@ -412,7 +434,7 @@ func (m *ListenerReport) Reset()         { *m = ListenerReport{} }
 func (m *ListenerReport) String() string { return proto.CompactTextString(m) }
 func (*ListenerReport) ProtoMessage()    {}
 func (*ListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{5}
+	return fileDescriptor_8f4537dae4069b18, []int{5}
 }
 func (m *ListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListenerReport.Unmarshal(m, b)
@@ -497,7 +519,7 @@ func (m *ListenerReport_Error) Reset()         { *m = ListenerReport_Error{} }
 func (m *ListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*ListenerReport_Error) ProtoMessage()    {}
 func (*ListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{5, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{5, 0}
 }
 func (m *ListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListenerReport_Error.Unmarshal(m, b)
@@ -544,7 +566,7 @@ func (m *HttpListenerReport) Reset()         { *m = HttpListenerReport{} }
 func (m *HttpListenerReport) String() string { return proto.CompactTextString(m) }
 func (*HttpListenerReport) ProtoMessage()    {}
 func (*HttpListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6}
+	return fileDescriptor_8f4537dae4069b18, []int{6}
 }
 func (m *HttpListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListenerReport.Unmarshal(m, b)
@@ -593,7 +615,7 @@ func (m *HttpListenerReport_Error) Reset()         { *m = HttpListenerReport_Err
 func (m *HttpListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*HttpListenerReport_Error) ProtoMessage()    {}
 func (*HttpListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{6, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{6, 0}
 }
 func (m *HttpListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpListenerReport_Error.Unmarshal(m, b)
@@ -629,18 +651,20 @@ func (m *HttpListenerReport_Error) GetReason() string {
 
 type VirtualHostReport struct {
 	// errors on top-level config of the virtual host
-	Errors               []*VirtualHostReport_Error `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
-	RouteReports         []*RouteReport             `protobuf:"bytes,2,rep,name=route_reports,json=routeReports,proto3" json:"route_reports,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	Errors       []*VirtualHostReport_Error `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	RouteReports []*RouteReport             `protobuf:"bytes,2,rep,name=route_reports,json=routeReports,proto3" json:"route_reports,omitempty"`
+	// warnings on top-level config of the virtual host
+	Warnings             []*VirtualHostReport_Warning `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
 func (m *VirtualHostReport) Reset()         { *m = VirtualHostReport{} }
 func (m *VirtualHostReport) String() string { return proto.CompactTextString(m) }
 func (*VirtualHostReport) ProtoMessage()    {}
 func (*VirtualHostReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7}
+	return fileDescriptor_8f4537dae4069b18, []int{7}
 }
 func (m *VirtualHostReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHostReport.Unmarshal(m, b)
@@ -674,6 +698,13 @@ func (m *VirtualHostReport) GetRouteReports() []*RouteReport {
 	return nil
 }
 
+func (m *VirtualHostReport) GetWarnings() []*VirtualHostReport_Warning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 // error types for top-level virtual host config
 type VirtualHostReport_Error struct {
 	// the type of the error
@@ -689,7 +720,7 @@ func (m *VirtualHostReport_Error) Reset()         { *m = VirtualHostReport_Error
 func (m *VirtualHostReport_Error) String() string { return proto.CompactTextString(m) }
 func (*VirtualHostReport_Error) ProtoMessage()    {}
 func (*VirtualHostReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{7, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{7, 0}
 }
 func (m *VirtualHostReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VirtualHostReport_Error.Unmarshal(m, b)
@@ -723,6 +754,55 @@ func (m *VirtualHostReport_Error) GetReason() string {
 	return ""
 }
 
+// warning types for top-level virtual host config
+type VirtualHostReport_Warning struct {
+	// the type of the warning
+	Type VirtualHostReport_Warning_Type `protobuf:"varint,1,opt,name=type,proto3,enum=gloo.solo.io.VirtualHostReport_Warning_Type" json:"type,omitempty"`
+	// any extra info as a string
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VirtualHostReport_Warning) Reset()         { *m = VirtualHostReport_Warning{} }
+func (m *VirtualHostReport_Warning) String() string { return proto.CompactTextString(m) }
+func (*VirtualHostReport_Warning) ProtoMessage()    {}
+func (*VirtualHostReport_Warning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f4537dae4069b18, []int{7, 1}
+}
+func (m *VirtualHostReport_Warning) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VirtualHostReport_Warning.Unmarshal(m, b)
+}
+func (m *VirtualHostReport_Warning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VirtualHostReport_Warning.Marshal(b, m, deterministic)
+}
+func (m *VirtualHostReport_Warning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VirtualHostReport_Warning.Merge(m, src)
+}
+func (m *VirtualHostReport_Warning) XXX_Size() int {
+	return xxx_messageInfo_VirtualHostReport_Warning.Size(m)
+}
+func (m *VirtualHostReport_Warning) XXX_DiscardUnknown() {
+	xxx_messageInfo_VirtualHostReport_Warning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VirtualHostReport_Warning proto.InternalMessageInfo
+
+func (m *VirtualHostReport_Warning) GetType() VirtualHostReport_Warning_Type {
+	if m != nil {
+		return m.Type
+	}
+	return VirtualHostReport_Warning_CertificateExpiryWarning
+}
+
+func (m *VirtualHostReport_Warning) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type RouteReport struct {
 	// errors on the config of the route
 	Errors []*RouteReport_Error `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
@@ -737,7 +817,7 @@ func (m *RouteReport) Reset()         { *m = RouteReport{} }
 func (m *RouteReport) String() string { return proto.CompactTextString(m) }
 func (*RouteReport) ProtoMessage()    {}
 func (*RouteReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8}
+	return fileDescriptor_8f4537dae4069b18, []int{8}
 }
 func (m *RouteReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport.Unmarshal(m, b)
@@ -786,7 +866,7 @@ func (m *RouteReport_Error) Reset()         { *m = RouteReport_Error{} }
 func (m *RouteReport_Error) String() string { return proto.CompactTextString(m) }
 func (*RouteReport_Error) ProtoMessage()    {}
 func (*RouteReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{8, 0}
 }
 func (m *RouteReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport_Error.Unmarshal(m, b)
@@ -835,7 +915,7 @@ func (m *RouteReport_Warning) Reset()         { *m = RouteReport_Warning{} }
 func (m *RouteReport_Warning) String() string { return proto.CompactTextString(m) }
 func (*RouteReport_Warning) ProtoMessage()    {}
 func (*RouteReport_Warning) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{8, 1}
+	return fileDescriptor_8f4537dae4069b18, []int{8, 1}
 }
 func (m *RouteReport_Warning) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteReport_Warning.Unmarshal(m, b)
@@ -882,7 +962,7 @@ func (m *TcpListenerReport) Reset()         { *m = TcpListenerReport{} }
 func (m *TcpListenerReport) String() string { return proto.CompactTextString(m) }
 func (*TcpListenerReport) ProtoMessage()    {}
 func (*TcpListenerReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9}
+	return fileDescriptor_8f4537dae4069b18, []int{9}
 }
 func (m *TcpListenerReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListenerReport.Unmarshal(m, b)
@@ -931,7 +1011,7 @@ func (m *TcpListenerReport_Error) Reset()         { *m = TcpListenerReport_Error
 func (m *TcpListenerReport_Error) String() string { return proto.CompactTextString(m) }
 func (*TcpListenerReport_Error) ProtoMessage()    {}
 func (*TcpListenerReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{9, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{9, 0}
 }
 func (m *TcpListenerReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpListenerReport_Error.Unmarshal(m, b)
@@ -977,7 +1057,7 @@ func (m *TcpHostReport) Reset()         { *m = TcpHostReport{} }
 func (m *TcpHostReport) String() string { return proto.CompactTextString(m) }
 func (*TcpHostReport) ProtoMessage()    {}
 func (*TcpHostReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10}
+	return fileDescriptor_8f4537dae4069b18, []int{10}
 }
 func (m *TcpHostReport) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHostReport.Unmarshal(m, b)
@@ -1019,7 +1099,7 @@ func (m *TcpHostReport_Error) Reset()         { *m = TcpHostReport_Error{} }
 func (m *TcpHostReport_Error) String() string { return proto.CompactTextString(m) }
 func (*TcpHostReport_Error) ProtoMessage()    {}
 func (*TcpHostReport_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aacaf097b496f502, []int{10, 0}
+	return fileDescriptor_8f4537dae4069b18, []int{10, 0}
 }
 func (m *TcpHostReport_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpHostReport_Error.Unmarshal(m, b)
@@ -1057,6 +1137,7 @@ func init() {
 	proto.RegisterEnum("gloo.solo.io.ListenerReport_Error_Type", ListenerReport_Error_Type_name, ListenerReport_Error_Type_value)
 	proto.RegisterEnum("gloo.solo.io.HttpListenerReport_Error_Type", HttpListenerReport_Error_Type_name, HttpListenerReport_Error_Type_value)
 	proto.RegisterEnum("gloo.solo.io.VirtualHostReport_Error_Type", VirtualHostReport_Error_Type_name, VirtualHostReport_Error_Type_value)
+	proto.RegisterEnum("gloo.solo.io.VirtualHostReport_Warning_Type", VirtualHostReport_Warning_Type_name, VirtualHostReport_Warning_Type_value)
 	proto.RegisterEnum("gloo.solo.io.RouteReport_Error_Type", RouteReport_Error_Type_name, RouteReport_Error_Type_value)
 	proto.RegisterEnum("gloo.solo.io.RouteReport_Warning_Type", RouteReport_Warning_Type_name, RouteReport_Warning_Type_value)
 	proto.RegisterEnum("gloo.solo.io.TcpListenerReport_Error_Type", TcpListenerReport_Error_Type_name, TcpListenerReport_Error_Type_value)
@@ -1072,6 +1153,7 @@ func init() {
 	proto.RegisterType((*HttpListenerReport_Error)(nil), "gloo.solo.io.HttpListenerReport.Error")
 	proto.RegisterType((*VirtualHostReport)(nil), "gloo.solo.io.VirtualHostReport")
 	proto.RegisterType((*VirtualHostReport_Error)(nil), "gloo.solo.io.VirtualHostReport.Error")
+	proto.RegisterType((*VirtualHostReport_Warning)(nil), "gloo.solo.io.VirtualHostReport.Warning")
 	proto.RegisterType((*RouteReport)(nil), "gloo.solo.io.RouteReport")
 	proto.RegisterType((*RouteReport_Error)(nil), "gloo.solo.io.RouteReport.Error")
 	proto.RegisterType((*RouteReport_Warning)(nil), "gloo.solo.io.RouteReport.Warning")
@@ -1082,66 +1164,69 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("projects/gloo/api/grpc/validation/proxy_validation.proto", fileDescriptor_aacaf097b496f502)
-}
-
-var fileDescriptor_aacaf097b496f502 = []byte{
-	// 878 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x52, 0x2b, 0x45,
-	0x14, 0xc6, 0x33, 0x09, 0x17, 0xf5, 0x04, 0x72, 0x43, 0x27, 0x37, 0x84, 0xc1, 0xeb, 0x0d, 0x23,
-	0x20, 0x8a, 0x4e, 0x34, 0x5a, 0x25, 0xa2, 0x40, 0x15, 0x42, 0x89, 0x16, 0x62, 0x18, 0x22, 0x56,
-	0xb9, 0x30, 0x35, 0x0c, 0x4d, 0xd2, 0x9a, 0x4c, 0x0f, 0xdd, 0x9d, 0x68, 0x16, 0xee, 0xdc, 0xfb,
-	0x06, 0x2e, 0xdd, 0xf9, 0x10, 0x56, 0xe9, 0x03, 0xb8, 0x75, 0xe5, 0xce, 0x77, 0x70, 0x65, 0x65,
-	0xa6, 0x33, 0x64, 0xfe, 0x24, 0x33, 0xcb, 0xbb, 0xec, 0xce, 0x39, 0x5f, 0x7f, 0xfd, 0x3b, 0x27,
-	0x3d, 0x07, 0xf6, 0x1c, 0x46, 0xbf, 0xc5, 0x96, 0xe0, 0xf5, 0x4e, 0x8f, 0xd2, 0xba, 0xe9, 0x90,
-	0x7a, 0x87, 0x39, 0x56, 0x7d, 0x68, 0xf6, 0xc8, 0xad, 0x29, 0x08, 0xb5, 0xeb, 0x0e, 0xa3, 0x3f,
-	0x8c, 0xda, 0x0f, 0x1b, 0xba, 0xc3, 0xa8, 0xa0, 0x68, 0x69, 0x9c, 0xa0, 0x73, 0xda, 0xa3, 0x3a,
-	0xa1, 0xea, 0x96, 0x9b, 0x1e, 0x15, 0x1b, 0xbe, 0xe3, 0xe5, 0x7b, 0x49, 0xda, 0x67, 0xf0, 0xb4,
-	0x39, 0x5e, 0x5e, 0xfb, 0x6a, 0x57, 0x98, 0x0d, 0x89, 0x85, 0x0d, 0x7c, 0x3f, 0xc0, 0x5c, 0xa0,
-	0xd7, 0xe1, 0x91, 0x1b, 0x5f, 0x55, 0x6a, 0xca, 0x4e, 0xbe, 0x51, 0xd2, 0xa7, 0x4f, 0xd1, 0xdd,
-	0x5c, 0xc3, 0x8b, 0xd0, 0xbe, 0x81, 0x57, 0x66, 0x69, 0x71, 0x87, 0xda, 0x1c, 0xa3, 0x8f, 0x60,
-	0xc9, 0x33, 0xcf, 0xb0, 0x43, 0x99, 0x90, 0x9a, 0x6b, 0x71, 0x9a, 0x6e, 0x80, 0x91, 0x77, 0x1e,
-	0x16, 0xda, 0x2a, 0x3c, 0xb9, 0xa0, 0x82, 0xdc, 0x8d, 0xbe, 0xb0, 0x0d, 0xcc, 0x47, 0xb6, 0x25,
-	0x3d, 0x6a, 0x55, 0xa8, 0x84, 0x7f, 0xf0, 0x0e, 0xd4, 0xae, 0x21, 0x3f, 0x25, 0x87, 0x3e, 0x81,
-	0x62, 0x8f, 0x70, 0x81, 0x6d, 0xcc, 0xa4, 0x05, 0x5e, 0x55, 0x6a, 0xb9, 0x9d, 0x7c, 0xe3, 0xe5,
-	0xa0, 0x87, 0x73, 0x19, 0x25, 0x6d, 0x3c, 0xee, 0x05, 0xd6, 0x5c, 0xfb, 0x2b, 0x07, 0x85, 0x60,
-	0x0c, 0xda, 0x87, 0x45, 0xcc, 0x18, 0x65, 0xbc, 0x9a, 0x75, 0x15, 0xb5, 0x79, 0x8a, 0xfa, 0xe9,
-	0x38, 0xd4, 0x90, 0x19, 0xa8, 0x05, 0xe5, 0xae, 0x10, 0x4e, 0x3b, 0x64, 0xae, 0x9a, 0x73, 0xf9,
-	0xd4, 0x82, 0x4a, 0x67, 0x42, 0x38, 0x41, 0xb5, 0xb3, 0x8c, 0x81, 0xba, 0x91, 0x5d, 0x74, 0x09,
-	0x25, 0x61, 0x45, 0x45, 0x17, 0x5c, 0xd1, 0x67, 0x41, 0xd1, 0x96, 0x15, 0xd5, 0x5c, 0x11, 0xe1,
-	0x4d, 0xf5, 0x77, 0x05, 0x1e, 0xb9, 0xd6, 0xd1, 0x87, 0xb0, 0x20, 0x46, 0x0e, 0x76, 0x4b, 0x58,
-	0x68, 0xbc, 0x96, 0x7c, 0x59, 0xbd, 0x35, 0x72, 0xb0, 0xe1, 0x26, 0xa1, 0x0a, 0x2c, 0x32, 0x6c,
-	0x72, 0x6a, 0x57, 0xb3, 0x35, 0x65, 0xe7, 0x25, 0x43, 0xae, 0x34, 0x0b, 0x16, 0x5a, 0xde, 0xef,
-	0xe8, 0xc2, 0xec, 0xe3, 0x0b, 0x2a, 0xbe, 0xb4, 0xc9, 0xfd, 0x00, 0xbb, 0x02, 0xc5, 0x0c, 0x52,
-	0xa1, 0x72, 0x4c, 0xec, 0xdb, 0x26, 0x65, 0x22, 0xf4, 0x9b, 0x82, 0x10, 0x14, 0xae, 0xae, 0xce,
-	0x3f, 0xa6, 0xf6, 0x1d, 0xe9, 0x78, 0x7b, 0x59, 0x54, 0x82, 0xc7, 0x4d, 0x46, 0x2d, 0xcc, 0x39,
-	0xb1, 0xe5, 0x66, 0xee, 0xb8, 0x02, 0x65, 0x1f, 0xc9, 0xd8, 0x8d, 0xe4, 0xa2, 0xfd, 0x9a, 0x05,
-	0x14, 0x65, 0x8b, 0x0e, 0xfd, 0xba, 0x7a, 0x9d, 0xb2, 0x9d, 0x54, 0x8d, 0x50, 0x6d, 0x2f, 0xa1,
-	0x3c, 0x24, 0x4c, 0x0c, 0xcc, 0x5e, 0xbb, 0x4b, 0xb9, 0xf0, 0xfb, 0xce, 0xeb, 0x92, 0x50, 0x19,
-	0xae, 0xbd, 0xc8, 0x33, 0xca, 0x85, 0x6c, 0x3d, 0x34, 0x0c, 0x6f, 0x71, 0xf5, 0xc7, 0x49, 0x11,
-	0x8e, 0x02, 0x45, 0xd8, 0x4d, 0xe7, 0x2c, 0x4d, 0x21, 0xd6, 0x65, 0x21, 0x62, 0x00, 0x66, 0xb4,
-	0xbf, 0xb3, 0xb0, 0x12, 0x31, 0x8a, 0x0e, 0x42, 0x9c, 0xb6, 0x12, 0x6e, 0x16, 0xc2, 0x74, 0x08,
-	0xcb, 0x8c, 0x0e, 0x04, 0x0e, 0xf1, 0x09, 0xbd, 0x0d, 0xc6, 0x38, 0x44, 0x92, 0x59, 0x62, 0x0f,
-	0x0b, 0xae, 0xfe, 0xe9, 0x77, 0xe6, 0x61, 0x00, 0xca, 0x1b, 0xa9, 0x6c, 0xa4, 0x61, 0x72, 0x9b,
-	0xd0, 0x9c, 0x6b, 0xf0, 0xe4, 0x84, 0xf6, 0x4d, 0x62, 0xf3, 0x48, 0x6f, 0xc6, 0x60, 0xcc, 0xa2,
-	0x32, 0x14, 0x4f, 0xfb, 0x8e, 0x18, 0x79, 0x49, 0xb2, 0x3b, 0xb5, 0x5f, 0x72, 0x90, 0x9f, 0xba,
-	0x25, 0x7a, 0x3f, 0x84, 0xf5, 0xd9, 0x4c, 0x20, 0x21, 0xa0, 0x07, 0xf0, 0xe2, 0xf7, 0x26, 0xb3,
-	0x89, 0xdd, 0x99, 0xb0, 0xdc, 0x98, 0x9d, 0xfa, 0x95, 0x17, 0x69, 0xf8, 0x29, 0xea, 0xcf, 0x3e,
-	0xcf, 0xbd, 0x00, 0xcf, 0xcd, 0x84, 0xf3, 0xd3, 0x90, 0x7c, 0x4f, 0x92, 0x5c, 0x85, 0xd2, 0xa7,
-	0xb6, 0xfb, 0x1d, 0xfb, 0xdc, 0x14, 0x56, 0x17, 0xb3, 0x09, 0xca, 0x18, 0x5e, 0x8a, 0xfa, 0x93,
-	0x02, 0x2f, 0x48, 0x9f, 0x68, 0x3f, 0xe0, 0x69, 0x3b, 0xf1, 0x62, 0x69, 0x5c, 0x6d, 0x49, 0x57,
-	0x4f, 0x61, 0x4d, 0xba, 0x3a, 0xc1, 0x5c, 0x10, 0xdb, 0xfd, 0x92, 0x49, 0x9d, 0x62, 0x46, 0xfb,
-	0x27, 0x0b, 0x2b, 0x91, 0xd7, 0x32, 0xa9, 0xfb, 0x23, 0x09, 0xa1, 0x62, 0x9d, 0x42, 0x71, 0xfc,
-	0x54, 0xc7, 0x3c, 0x10, 0xeb, 0x11, 0xa1, 0xa9, 0xc7, 0xa1, 0x20, 0xa6, 0x97, 0x5c, 0xfd, 0x23,
-	0xdd, 0x9f, 0x60, 0x86, 0x9b, 0xe7, 0xe5, 0x85, 0xd6, 0xfe, 0x53, 0x60, 0x39, 0x70, 0x51, 0xf4,
-	0x41, 0xe8, 0xe3, 0xba, 0x31, 0x87, 0x4a, 0x10, 0xad, 0xfa, 0x9b, 0xcf, 0x64, 0x6e, 0xd3, 0xc4,
-	0x48, 0xa4, 0xe1, 0xd1, 0x4c, 0xe0, 0xb1, 0x0e, 0xab, 0xd1, 0x66, 0x9a, 0xf7, 0x2c, 0x34, 0xfe,
-	0x55, 0xa0, 0x12, 0x3f, 0x46, 0xa1, 0x36, 0x14, 0x82, 0x73, 0x0e, 0x7a, 0x35, 0x78, 0x89, 0xd8,
-	0xf1, 0x48, 0xdd, 0x9c, 0x1f, 0x24, 0x47, 0xa5, 0xcc, 0xdb, 0x0a, 0xea, 0xc1, 0xb2, 0x3c, 0x15,
-	0xbb, 0x16, 0xd0, 0x6e, 0xcc, 0x68, 0x36, 0x6b, 0x54, 0x54, 0xdf, 0x4c, 0x17, 0x3c, 0x39, 0xef,
-	0xf8, 0xe8, 0xeb, 0x83, 0x0e, 0x11, 0xdd, 0xc1, 0x8d, 0x6e, 0xd1, 0x7e, 0x7d, 0x9c, 0xf6, 0x16,
-	0xa1, 0xf5, 0x98, 0xb9, 0xd5, 0xf9, 0xae, 0x13, 0x37, 0x08, 0xdf, 0x2c, 0xba, 0x33, 0xec, 0xbb,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x49, 0x1d, 0x72, 0x24, 0x34, 0x0b, 0x00, 0x00,
+	proto.RegisterFile("github.com/solo-io/gloo/projects/gloo/api/grpc/validation/proxy_validation.proto", fileDescriptor_8f4537dae4069b18)
+}
+
+var fileDescriptor_8f4537dae4069b18 = []byte{
+	// 924 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0xb7, 0xec, 0x34, 0xc0, 0x73, 0xe2, 0x3a, 0x9b, 0xd4, 0x51, 0x94, 0x96, 0xba, 0x22, 0x69,
+	0x03, 0x2d, 0x32, 0x18, 0x66, 0x80, 0x42, 0x52, 0x26, 0xa9, 0x87, 0xc0, 0x94, 0xe0, 0x2a, 0x26,
+	0xcc, 0x70, 0xc0, 0xa3, 0x2a, 0x1b, 0x7b, 0xc1, 0xd6, 0xaa, 0xbb, 0x6b, 0x13, 0x1f, 0xb8, 0x71,
+	0xe1, 0xc4, 0x37, 0xe0, 0xc8, 0x85, 0xe1, 0x43, 0x30, 0x03, 0x1f, 0x80, 0x6f, 0xc0, 0x8d, 0xef,
+	0xc0, 0x89, 0x91, 0xb4, 0x91, 0xad, 0x3f, 0xb6, 0x74, 0xec, 0x71, 0x57, 0xef, 0xfd, 0xf6, 0xf7,
+	0x7e, 0xbf, 0xa7, 0xdd, 0x07, 0xed, 0x1e, 0x11, 0xfd, 0xd1, 0x33, 0xc3, 0xa6, 0xc3, 0x06, 0xa7,
+	0x03, 0xfa, 0x26, 0xa1, 0x8d, 0xde, 0x80, 0xd2, 0x86, 0xcb, 0xe8, 0xb7, 0xd8, 0x16, 0x3c, 0x58,
+	0x59, 0x2e, 0x69, 0xf4, 0x98, 0x6b, 0x37, 0xc6, 0xd6, 0x80, 0x9c, 0x5b, 0x82, 0x50, 0xc7, 0x8b,
+	0xb8, 0x9c, 0x74, 0xa7, 0x1b, 0x86, 0xcb, 0xa8, 0xa0, 0x68, 0xc5, 0x4b, 0x30, 0x3c, 0x2c, 0x83,
+	0x50, 0x6d, 0x77, 0x0e, 0xd8, 0xf8, 0xed, 0x20, 0x3f, 0x48, 0xd2, 0x3f, 0x83, 0x5b, 0x6d, 0x6f,
+	0x79, 0x16, 0xa2, 0x9d, 0x62, 0x36, 0x26, 0x36, 0x36, 0xf1, 0xf3, 0x11, 0xe6, 0x02, 0xbd, 0x0e,
+	0xd7, 0xfc, 0x78, 0x55, 0xa9, 0x2b, 0x7b, 0xe5, 0xe6, 0xba, 0x31, 0x7b, 0x8a, 0xe1, 0xe7, 0x9a,
+	0x41, 0x84, 0xfe, 0x0d, 0xbc, 0x3a, 0x0f, 0x8b, 0xbb, 0xd4, 0xe1, 0x18, 0x7d, 0x04, 0x2b, 0x01,
+	0x79, 0x86, 0x5d, 0xca, 0x84, 0xc4, 0xdc, 0x4a, 0xc3, 0xf4, 0x03, 0xcc, 0xb2, 0x3b, 0x5d, 0xe8,
+	0x9b, 0x70, 0xe3, 0x84, 0x0a, 0x72, 0x31, 0xf9, 0xc2, 0x31, 0x31, 0x9f, 0x38, 0xb6, 0xe4, 0xa8,
+	0xab, 0x50, 0x8b, 0x7f, 0x08, 0x0e, 0xd4, 0xcf, 0xa0, 0x3c, 0x03, 0x87, 0x3e, 0x81, 0xea, 0x80,
+	0x70, 0x81, 0x1d, 0xcc, 0x24, 0x05, 0xae, 0x2a, 0xf5, 0xd2, 0x5e, 0xb9, 0x79, 0x33, 0xca, 0xe1,
+	0x89, 0x8c, 0x92, 0x34, 0xae, 0x0f, 0x22, 0x6b, 0xae, 0xff, 0x5d, 0x82, 0x4a, 0x34, 0x06, 0x3d,
+	0x84, 0x65, 0xcc, 0x18, 0x65, 0x5c, 0x2d, 0xfa, 0x88, 0xfa, 0x22, 0x44, 0xa3, 0xe5, 0x85, 0x9a,
+	0x32, 0x03, 0x75, 0x60, 0xa3, 0x2f, 0x84, 0xdb, 0x8d, 0x91, 0x53, 0x4b, 0xbe, 0x3e, 0xf5, 0x28,
+	0xd2, 0xb1, 0x10, 0x6e, 0x14, 0xed, 0xb8, 0x60, 0xa2, 0x7e, 0x62, 0x17, 0x3d, 0x85, 0x75, 0x61,
+	0x27, 0x41, 0x97, 0x7c, 0xd0, 0xdb, 0x51, 0xd0, 0x8e, 0x9d, 0xc4, 0x5c, 0x13, 0xf1, 0x4d, 0xed,
+	0x0f, 0x05, 0xae, 0xf9, 0xd4, 0xd1, 0x87, 0xb0, 0x24, 0x26, 0x2e, 0xf6, 0x2d, 0xac, 0x34, 0xef,
+	0x65, 0x17, 0x6b, 0x74, 0x26, 0x2e, 0x36, 0xfd, 0x24, 0x54, 0x83, 0x65, 0x86, 0x2d, 0x4e, 0x1d,
+	0xb5, 0x58, 0x57, 0xf6, 0x5e, 0x31, 0xe5, 0x4a, 0xb7, 0x61, 0xa9, 0x13, 0x7c, 0x47, 0x27, 0xd6,
+	0x10, 0x9f, 0x50, 0xf1, 0xa5, 0x43, 0x9e, 0x8f, 0xb0, 0x0f, 0x50, 0x2d, 0x20, 0x0d, 0x6a, 0x87,
+	0xc4, 0x39, 0x6f, 0x53, 0x26, 0x62, 0xdf, 0x14, 0x84, 0xa0, 0x72, 0x7a, 0xfa, 0xe4, 0x88, 0x3a,
+	0x17, 0xa4, 0x17, 0xec, 0x15, 0xd1, 0x3a, 0x5c, 0x6f, 0x33, 0x6a, 0x63, 0xce, 0x89, 0x23, 0x37,
+	0x4b, 0x87, 0x35, 0xd8, 0x08, 0x25, 0xf1, 0xd8, 0x48, 0x5d, 0xf4, 0x5f, 0x8b, 0x80, 0x92, 0xda,
+	0xa2, 0x83, 0xd0, 0xd7, 0xa0, 0x53, 0xee, 0x66, 0xb9, 0x11, 0xf3, 0xf6, 0x29, 0x6c, 0x8c, 0x09,
+	0x13, 0x23, 0x6b, 0xd0, 0xed, 0x53, 0x2e, 0xc2, 0xbe, 0x0b, 0xba, 0x24, 0x66, 0xc3, 0x59, 0x10,
+	0x79, 0x4c, 0xb9, 0x90, 0xad, 0x87, 0xc6, 0xf1, 0x2d, 0xae, 0xfd, 0x70, 0x65, 0xc2, 0xa3, 0x88,
+	0x09, 0xf7, 0xf3, 0x31, 0xcb, 0x63, 0xc4, 0xb6, 0x34, 0x22, 0x45, 0xc0, 0x82, 0xfe, 0xdb, 0x12,
+	0xac, 0x25, 0x88, 0xa2, 0xfd, 0x98, 0x4e, 0xbb, 0x19, 0x95, 0xc5, 0x64, 0x3a, 0x80, 0x55, 0x46,
+	0x47, 0x02, 0xc7, 0xf4, 0x89, 0xdd, 0x0d, 0xa6, 0x17, 0x22, 0x95, 0x59, 0x61, 0xd3, 0x05, 0x47,
+	0x47, 0xf0, 0xf2, 0xf7, 0x16, 0x73, 0x88, 0xd3, 0xe3, 0x6a, 0xc9, 0x4f, 0xbd, 0x97, 0x45, 0xe0,
+	0xab, 0x20, 0xde, 0x0c, 0x13, 0xb5, 0xbf, 0xc2, 0xf6, 0x3e, 0x88, 0x28, 0xfb, 0x46, 0xae, 0x5a,
+	0xf2, 0x08, 0x7b, 0x9e, 0xd1, 0xe1, 0x5b, 0x70, 0xe3, 0x31, 0x1d, 0x5a, 0xc4, 0xe1, 0x89, 0x06,
+	0x4f, 0xf1, 0xa2, 0x88, 0x36, 0xa0, 0xda, 0x1a, 0xba, 0x62, 0x12, 0x24, 0xc9, 0x16, 0xd7, 0x7e,
+	0x52, 0xe0, 0x25, 0x59, 0x1d, 0xfa, 0x38, 0x52, 0xc9, 0x83, 0x9c, 0xa2, 0xe4, 0xa9, 0x65, 0x47,
+	0xd6, 0x72, 0x13, 0xd4, 0x23, 0xcc, 0x04, 0xb9, 0x20, 0xb6, 0x25, 0x70, 0xeb, 0xd2, 0x25, 0x6c,
+	0x22, 0x61, 0xaa, 0x05, 0xfd, 0x97, 0x12, 0x94, 0x67, 0x6c, 0x43, 0xef, 0xc5, 0xfa, 0xe4, 0xf6,
+	0x5c, 0x87, 0x63, 0x1d, 0xb2, 0x3f, 0xe3, 0x70, 0xd0, 0x1c, 0x77, 0xe6, 0xa7, 0x26, 0xbd, 0xfd,
+	0x39, 0xf4, 0xf6, 0xfd, 0x88, 0x22, 0x3b, 0x19, 0xe7, 0xe7, 0x51, 0xe2, 0x5d, 0xa9, 0xc4, 0x26,
+	0xac, 0x7f, 0xea, 0xf8, 0x0f, 0xf3, 0xe7, 0x96, 0xb0, 0xfb, 0x98, 0x5d, 0xd9, 0x9a, 0xe2, 0x9d,
+	0xa2, 0xfd, 0x38, 0xe3, 0xd2, 0xc3, 0x08, 0xa7, 0xbb, 0x99, 0x85, 0xe5, 0x61, 0xb5, 0x2b, 0x59,
+	0xdd, 0x82, 0x2d, 0xc9, 0xea, 0x31, 0xe6, 0x82, 0x38, 0xfe, 0xd3, 0x3c, 0x35, 0xe8, 0x9f, 0x22,
+	0xac, 0x25, 0xae, 0xff, 0xac, 0xdf, 0x39, 0x91, 0x10, 0x33, 0xab, 0x05, 0x55, 0xef, 0xed, 0x49,
+	0xb9, 0xf1, 0xb6, 0x13, 0x40, 0x33, 0xb7, 0x5d, 0x45, 0xcc, 0x2e, 0xb9, 0xf6, 0x67, 0xbe, 0x1f,
+	0x72, 0x0e, 0x9b, 0x17, 0xe5, 0xc9, 0xd1, 0xff, 0x53, 0x60, 0x35, 0x52, 0x28, 0xfa, 0x20, 0x36,
+	0x2d, 0xdc, 0x59, 0xa0, 0x4a, 0x54, 0x5a, 0xed, 0xf7, 0x50, 0x93, 0x85, 0x4d, 0x93, 0x02, 0x91,
+	0x47, 0x8f, 0x76, 0x86, 0x1e, 0xdb, 0xb0, 0x99, 0x6c, 0xa6, 0x45, 0x57, 0x54, 0xf3, 0x5f, 0x05,
+	0x6a, 0xe9, 0x73, 0x21, 0xea, 0x42, 0x25, 0x3a, 0xb8, 0xa1, 0xd7, 0xa2, 0x45, 0xa4, 0xce, 0x7b,
+	0xda, 0xce, 0xe2, 0x20, 0x39, 0xfb, 0x15, 0xde, 0x52, 0xd0, 0x00, 0x56, 0xe5, 0xa9, 0xd8, 0xa7,
+	0x80, 0xee, 0xa7, 0xcc, 0x9a, 0xf3, 0x66, 0x5f, 0xed, 0x41, 0xbe, 0xe0, 0xab, 0xf3, 0x0e, 0x1f,
+	0x7d, 0xbd, 0x9f, 0x6f, 0xaa, 0x77, 0xbf, 0xeb, 0xa5, 0x4d, 0xf6, 0xcf, 0x96, 0xfd, 0xa1, 0xfc,
+	0x9d, 0xff, 0x07, 0x00, 0xe2, 0xa7, 0x7b, 0x0d, 0x1d, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1289,5 +1374,5 @@ var _ProxyValidationService_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 		},
 	},
-	Metadata: "projects/gloo/api/grpc/validation/proxy_validation.proto",
+	Metadata: "github.com/solo-io/gloo/projects/gloo/api/grpc/validation/proxy_validation.proto",
 }
@@ -29,13 +29,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // See the [envoy docs](https://www.envoyproxy.io/docs/envoy/v1.14.1/api-v2/api/v2/cluster/circuit_breaker.proto#envoy-api-msg-cluster-circuitbreakers)
 // for the meaning of these values.
 type CircuitBreakerConfig struct {
-	MaxConnections       *types.UInt32Value `protobuf:"bytes,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
-	MaxPendingRequests   *types.UInt32Value `protobuf:"bytes,2,opt,name=max_pending_requests,json=maxPendingRequests,proto3" json:"max_pending_requests,omitempty"`
-	MaxRequests          *types.UInt32Value `protobuf:"bytes,3,opt,name=max_requests,json=maxRequests,proto3" json:"max_requests,omitempty"`
-	MaxRetries           *types.UInt32Value `protobuf:"bytes,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	MaxConnections     *types.UInt32Value `protobuf:"bytes,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	MaxPendingRequests *types.UInt32Value `protobuf:"bytes,2,opt,name=max_pending_requests,json=maxPendingRequests,proto3" json:"max_pending_requests,omitempty"`
+	MaxRequests        *types.UInt32Value `protobuf:"bytes,3,opt,name=max_requests,json=maxRequests,proto3" json:"max_requests,omitempty"`
+	MaxRetries         *types.UInt32Value `protobuf:"bytes,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Limits the concurrent retries to a share of the active requests. Takes precedence over `max_retries`.
+	RetryBudget *CircuitBreakerConfig_RetryBudget `protobuf:"bytes,5,opt,name=retry_budget,json=retryBudget,proto3" json:"retry_budget,omitempty"`
+	// Publish the number of resources remaining before each circuit breaker opens as stats.
+	TrackRemaining bool `protobuf:"varint,6,opt,name=track_remaining,json=trackRemaining,proto3" json:"track_remaining,omitempty"`
+	// Thresholds for requests on high priority routes. The other fields apply to requests on default
+	// priority routes. If not set, envoy's defaults apply to high priority requests.
+	HighPriority         *CircuitBreakerConfig_Thresholds `protobuf:"bytes,7,opt,name=high_priority,json=highPriority,proto3" json:"high_priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
 func (m *CircuitBreakerConfig) Reset()         { *m = CircuitBreakerConfig{} }
@@ -90,8 +97,162 @@ func (m *CircuitBreakerConfig) GetMaxRetries() *types.UInt32Value {
 	return nil
 }
 
+func (m *CircuitBreakerConfig) GetRetryBudget() *CircuitBreakerConfig_RetryBudget {
+	if m != nil {
+		return m.RetryBudget
+	}
+	return nil
+}
+
+func (m *CircuitBreakerConfig) GetTrackRemaining() bool {
+	if m != nil {
+		return m.TrackRemaining
+	}
+	return false
+}
+
+func (m *CircuitBreakerConfig) GetHighPriority() *CircuitBreakerConfig_Thresholds {
+	if m != nil {
+		return m.HighPriority
+	}
+	return nil
+}
+
+// Limits the number of concurrent retries to a share of the active requests, instead of `max_retries`.
+type CircuitBreakerConfig_RetryBudget struct {
+	// The percentage of the active requests (and pending requests) that can be retries. Between 0 and 100,
+	// defaults to 20%.
+	BudgetPercent *types.DoubleValue `protobuf:"bytes,1,opt,name=budget_percent,json=budgetPercent,proto3" json:"budget_percent,omitempty"`
+	// The number of concurrent retries that are always allowed, whatever the budget. Defaults to 3.
+	MinRetryConcurrency  *types.UInt32Value `protobuf:"bytes,2,opt,name=min_retry_concurrency,json=minRetryConcurrency,proto3" json:"min_retry_concurrency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CircuitBreakerConfig_RetryBudget) Reset()         { *m = CircuitBreakerConfig_RetryBudget{} }
+func (m *CircuitBreakerConfig_RetryBudget) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerConfig_RetryBudget) ProtoMessage()    {}
+func (*CircuitBreakerConfig_RetryBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358fe1fcb8924174, []int{0, 0}
+}
+func (m *CircuitBreakerConfig_RetryBudget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitBreakerConfig_RetryBudget.Unmarshal(m, b)
+}
+func (m *CircuitBreakerConfig_RetryBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitBreakerConfig_RetryBudget.Marshal(b, m, deterministic)
+}
+func (m *CircuitBreakerConfig_RetryBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerConfig_RetryBudget.Merge(m, src)
+}
+func (m *CircuitBreakerConfig_RetryBudget) XXX_Size() int {
+	return xxx_messageInfo_CircuitBreakerConfig_RetryBudget.Size(m)
+}
+func (m *CircuitBreakerConfig_RetryBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerConfig_RetryBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerConfig_RetryBudget proto.InternalMessageInfo
+
+func (m *CircuitBreakerConfig_RetryBudget) GetBudgetPercent() *types.DoubleValue {
+	if m != nil {
+		return m.BudgetPercent
+	}
+	return nil
+}
+
+func (m *CircuitBreakerConfig_RetryBudget) GetMinRetryConcurrency() *types.UInt32Value {
+	if m != nil {
+		return m.MinRetryConcurrency
+	}
+	return nil
+}
+
+// The thresholds for requests on high priority routes.
+type CircuitBreakerConfig_Thresholds struct {
+	MaxConnections     *types.UInt32Value `protobuf:"bytes,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	MaxPendingRequests *types.UInt32Value `protobuf:"bytes,2,opt,name=max_pending_requests,json=maxPendingRequests,proto3" json:"max_pending_requests,omitempty"`
+	MaxRequests        *types.UInt32Value `protobuf:"bytes,3,opt,name=max_requests,json=maxRequests,proto3" json:"max_requests,omitempty"`
+	MaxRetries         *types.UInt32Value `protobuf:"bytes,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// Limits the concurrent retries to a share of the active requests. Takes precedence over `max_retries`.
+	RetryBudget *CircuitBreakerConfig_RetryBudget `protobuf:"bytes,5,opt,name=retry_budget,json=retryBudget,proto3" json:"retry_budget,omitempty"`
+	// Publish the number of resources remaining before each circuit breaker opens as stats.
+	TrackRemaining       bool     `protobuf:"varint,6,opt,name=track_remaining,json=trackRemaining,proto3" json:"track_remaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CircuitBreakerConfig_Thresholds) Reset()         { *m = CircuitBreakerConfig_Thresholds{} }
+func (m *CircuitBreakerConfig_Thresholds) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerConfig_Thresholds) ProtoMessage()    {}
+func (*CircuitBreakerConfig_Thresholds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358fe1fcb8924174, []int{0, 1}
+}
+func (m *CircuitBreakerConfig_Thresholds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitBreakerConfig_Thresholds.Unmarshal(m, b)
+}
+func (m *CircuitBreakerConfig_Thresholds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitBreakerConfig_Thresholds.Marshal(b, m, deterministic)
+}
+func (m *CircuitBreakerConfig_Thresholds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerConfig_Thresholds.Merge(m, src)
+}
+func (m *CircuitBreakerConfig_Thresholds) XXX_Size() int {
+	return xxx_messageInfo_CircuitBreakerConfig_Thresholds.Size(m)
+}
+func (m *CircuitBreakerConfig_Thresholds) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerConfig_Thresholds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerConfig_Thresholds proto.InternalMessageInfo
+
+func (m *CircuitBreakerConfig_Thresholds) GetMaxConnections() *types.UInt32Value {
+	if m != nil {
+		return m.MaxConnections
+	}
+	return nil
+}
+
+func (m *CircuitBreakerConfig_Thresholds) GetMaxPendingRequests() *types.UInt32Value {
+	if m != nil {
+		return m.MaxPendingRequests
+	}
+	return nil
+}
+
+func (m *CircuitBreakerConfig_Thresholds) GetMaxRequests() *types.UInt32Value {
+	if m != nil {
+		return m.MaxRequests
+	}
+	return nil
+}
+
+func (m *CircuitBreakerConfig_Thresholds) GetMaxRetries() *types.UInt32Value {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return nil
+}
+
+func (m *CircuitBreakerConfig_Thresholds) GetRetryBudget() *CircuitBreakerConfig_RetryBudget {
+	if m != nil {
+		return m.RetryBudget
+	}
+	return nil
+}
+
+func (m *CircuitBreakerConfig_Thresholds) GetTrackRemaining() bool {
+	if m != nil {
+		return m.TrackRemaining
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*CircuitBreakerConfig)(nil), "gloo.solo.io.CircuitBreakerConfig")
+	proto.RegisterType((*CircuitBreakerConfig_RetryBudget)(nil), "gloo.solo.io.CircuitBreakerConfig.RetryBudget")
+	proto.RegisterType((*CircuitBreakerConfig_Thresholds)(nil), "gloo.solo.io.CircuitBreakerConfig.Thresholds")
 }
 
 func init() {
@@ -99,26 +260,37 @@ func init() {
 }
 
 var fileDescriptor_358fe1fcb8924174 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x4b, 0x3b, 0x31,
-	0x14, 0xc7, 0xb9, 0xfe, 0xca, 0x6f, 0x48, 0x8b, 0x42, 0xe8, 0x50, 0x8a, 0x14, 0x71, 0x72, 0x31,
-	0xd1, 0x76, 0x13, 0x44, 0x68, 0x71, 0x70, 0x11, 0x29, 0xe8, 0xe0, 0x52, 0x72, 0xf1, 0x35, 0xc6,
-	0xde, 0xe5, 0xc5, 0x24, 0xa7, 0xf9, 0x93, 0x5c, 0xdc, 0xfd, 0x7b, 0xfc, 0x1f, 0xdc, 0xe5, 0x2e,
-	0x67, 0x5d, 0x6f, 0xcb, 0xcb, 0xfb, 0x7e, 0x3e, 0xdf, 0xe1, 0x91, 0x85, 0xd2, 0xe1, 0xa9, 0xca,
-	0x99, 0xc4, 0x92, 0x7b, 0x2c, 0xf0, 0x44, 0x23, 0x57, 0x05, 0x22, 0xb7, 0x0e, 0x9f, 0x41, 0x06,
-	0x9f, 0x26, 0x61, 0x35, 0x7f, 0x3d, 0xe3, 0x52, 0x3b, 0x59, 0xe9, 0xb0, 0xce, 0x1d, 0x88, 0x2d,
-	0x38, 0x66, 0x1d, 0x06, 0xa4, 0xc3, 0x3a, 0xc2, 0x6a, 0x9a, 0x69, 0x9c, 0x8c, 0x14, 0x2a, 0x6c,
-	0x16, 0xbc, 0x7e, 0xa5, 0xcc, 0x64, 0xaa, 0x10, 0x55, 0x01, 0xbc, 0x99, 0xf2, 0x6a, 0xc3, 0xdf,
-	0x9c, 0xb0, 0x16, 0x9c, 0x6f, 0xf7, 0x14, 0x62, 0x48, 0x10, 0xc4, 0x90, 0xfe, 0x8e, 0x3e, 0x7a,
-	0x64, 0xb4, 0x4c, 0x8d, 0x8b, 0x54, 0xb8, 0x44, 0xb3, 0xd1, 0x8a, 0x5e, 0x91, 0xfd, 0x52, 0xc4,
-	0xb5, 0x44, 0x63, 0x40, 0x06, 0x8d, 0xc6, 0x8f, 0xb3, 0xc3, 0xec, 0x78, 0x30, 0x3b, 0x60, 0xa9,
-	0x86, 0xfd, 0xd6, 0xb0, 0xbb, 0x6b, 0x13, 0xe6, 0xb3, 0x7b, 0x51, 0x54, 0xb0, 0xda, 0x2b, 0x45,
-	0x5c, 0xfe, 0x31, 0xf4, 0x86, 0x8c, 0x6a, 0x8d, 0x05, 0xf3, 0xa8, 0x8d, 0x5a, 0x3b, 0x78, 0xa9,
-	0xc0, 0x07, 0x3f, 0xee, 0x75, 0x70, 0xd1, 0x52, 0xc4, 0xdb, 0x04, 0xae, 0x5a, 0x8e, 0x5e, 0x92,
-	0x61, 0xed, 0xdb, 0x79, 0xfe, 0x75, 0xf0, 0x0c, 0x4a, 0x11, 0x77, 0x82, 0x0b, 0x32, 0x48, 0x82,
-	0xe0, 0x34, 0xf8, 0x71, 0xbf, 0x03, 0x4f, 0x1a, 0xbe, 0xc9, 0x2f, 0xce, 0x3f, 0xbf, 0xfb, 0xd9,
-	0xfb, 0xd7, 0x34, 0x7b, 0x38, 0xed, 0x76, 0x55, 0xbb, 0x55, 0xed, 0x65, 0xf3, 0xff, 0x8d, 0x7d,
-	0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xce, 0x7d, 0x6c, 0x10, 0x02, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0xc1, 0x6e, 0xd4, 0x30,
+	0x10, 0x86, 0x15, 0x5a, 0x4a, 0xe5, 0x6c, 0x5b, 0xc9, 0x2c, 0x52, 0xb4, 0x42, 0x55, 0xc5, 0x85,
+	0x5e, 0xea, 0x40, 0x7b, 0x43, 0x42, 0x48, 0x1b, 0x38, 0x70, 0x41, 0x4b, 0x04, 0x1c, 0xb8, 0x44,
+	0x8e, 0x77, 0xea, 0x98, 0x4d, 0x3c, 0xc1, 0x76, 0x20, 0xfb, 0x34, 0x5c, 0x38, 0xf0, 0x08, 0x3c,
+	0x01, 0x0f, 0xc2, 0x3b, 0x70, 0x47, 0xb1, 0xb7, 0xdd, 0x1e, 0x40, 0xca, 0x91, 0x03, 0xb7, 0xcc,
+	0x64, 0xbe, 0xff, 0xf7, 0xcc, 0x58, 0x26, 0x73, 0xa9, 0x5c, 0xd5, 0x95, 0x4c, 0x60, 0x93, 0x5a,
+	0xac, 0xf1, 0x4c, 0x61, 0x2a, 0x6b, 0xc4, 0xb4, 0x35, 0xf8, 0x01, 0x84, 0xb3, 0x21, 0xe2, 0xad,
+	0x4a, 0x3f, 0x3d, 0x4e, 0x85, 0x32, 0xa2, 0x53, 0xae, 0x28, 0x0d, 0xf0, 0x15, 0x18, 0xd6, 0x1a,
+	0x74, 0x48, 0x27, 0x43, 0x09, 0x1b, 0x68, 0xa6, 0x70, 0x36, 0x95, 0x28, 0xd1, 0xff, 0x48, 0x87,
+	0xaf, 0x50, 0x33, 0x3b, 0x96, 0x88, 0xb2, 0x86, 0xd4, 0x47, 0x65, 0x77, 0x99, 0x7e, 0x36, 0xbc,
+	0x6d, 0xc1, 0xd8, 0xcd, 0x7f, 0x0a, 0xbd, 0x0b, 0x10, 0xf4, 0x2e, 0xe4, 0x1e, 0xfc, 0xd8, 0x27,
+	0xd3, 0x2c, 0x38, 0xce, 0x83, 0x61, 0x86, 0xfa, 0x52, 0x49, 0xfa, 0x82, 0x1c, 0x35, 0xbc, 0x2f,
+	0x04, 0x6a, 0x0d, 0xc2, 0x29, 0xd4, 0x36, 0x89, 0x4e, 0xa2, 0xd3, 0xf8, 0xfc, 0x3e, 0x0b, 0x36,
+	0xec, 0xca, 0x86, 0xbd, 0x7d, 0xa9, 0xdd, 0xc5, 0xf9, 0x3b, 0x5e, 0x77, 0x90, 0x1f, 0x36, 0xbc,
+	0xcf, 0xb6, 0x0c, 0x7d, 0x45, 0xa6, 0x83, 0x4c, 0x0b, 0x7a, 0xa9, 0xb4, 0x2c, 0x0c, 0x7c, 0xec,
+	0xc0, 0x3a, 0x9b, 0xdc, 0x1a, 0xa1, 0x45, 0x1b, 0xde, 0x2f, 0x02, 0x98, 0x6f, 0x38, 0xfa, 0x8c,
+	0x4c, 0x06, 0xbd, 0x6b, 0x9d, 0x9d, 0x11, 0x3a, 0x71, 0xc3, 0xfb, 0x6b, 0x81, 0xa7, 0x24, 0x0e,
+	0x02, 0xce, 0x28, 0xb0, 0xc9, 0xee, 0x08, 0x9e, 0x78, 0xde, 0xd7, 0xd3, 0xd7, 0x64, 0x32, 0xa0,
+	0xeb, 0xa2, 0xec, 0x96, 0x12, 0x5c, 0x72, 0xdb, 0xf3, 0x8c, 0xdd, 0x5c, 0x0f, 0xfb, 0xd3, 0x40,
+	0xd9, 0xa0, 0xb0, 0x9e, 0x7b, 0x2a, 0x8f, 0xcd, 0x36, 0xa0, 0x0f, 0xc9, 0x91, 0x33, 0x5c, 0xac,
+	0x0a, 0x03, 0x0d, 0x57, 0x5a, 0x69, 0x99, 0xec, 0x9d, 0x44, 0xa7, 0xfb, 0xf9, 0xa1, 0x4f, 0xe7,
+	0x57, 0x59, 0x9a, 0x93, 0x83, 0x4a, 0xc9, 0xaa, 0x68, 0x8d, 0x42, 0xa3, 0xdc, 0x3a, 0xb9, 0xe3,
+	0xcd, 0xcf, 0x46, 0x98, 0xbf, 0xa9, 0x0c, 0xd8, 0x0a, 0xeb, 0xa5, 0xcd, 0x27, 0x83, 0xc6, 0x62,
+	0x23, 0x31, 0xfb, 0x1a, 0x91, 0xf8, 0xc6, 0xc9, 0x68, 0x46, 0x0e, 0x43, 0x67, 0x45, 0x0b, 0x46,
+	0x80, 0x76, 0x7f, 0xdd, 0xfa, 0x73, 0xec, 0xca, 0x1a, 0xc2, 0x84, 0x0e, 0x02, 0xb3, 0x08, 0x08,
+	0x5d, 0x90, 0x7b, 0x8d, 0xd2, 0x45, 0x18, 0x94, 0x40, 0x2d, 0x3a, 0x63, 0x40, 0x8b, 0xf5, 0xa8,
+	0xad, 0xdf, 0x6d, 0x94, 0xf6, 0x27, 0xca, 0xb6, 0xe0, 0xec, 0xcb, 0x0e, 0x21, 0xdb, 0x1e, 0xfe,
+	0x5f, 0xce, 0x7f, 0xee, 0x72, 0xce, 0x9f, 0x7c, 0xff, 0xb5, 0x1b, 0x7d, 0xfb, 0x79, 0x1c, 0xbd,
+	0x7f, 0x34, 0xee, 0xb9, 0x6b, 0x57, 0x72, 0xf3, 0xe4, 0x95, 0x7b, 0xbe, 0xb3, 0x8b, 0xdf, 0x03,
+	0x00, 0x50, 0x18, 0x7b, 0xc5, 0x29, 0x05, 0x00, 0x00,
 }
 
 func (this *CircuitBreakerConfig) Equal(that interface{}) bool {
@@ -152,6 +324,87 @@ func (this *CircuitBreakerConfig) Equal(that interface{}) bool {
 	if !this.MaxRetries.Equal(that1.MaxRetries) {
 		return false
 	}
+	if !this.RetryBudget.Equal(that1.RetryBudget) {
+		return false
+	}
+	if this.TrackRemaining != that1.TrackRemaining {
+		return false
+	}
+	if !this.HighPriority.Equal(that1.HighPriority) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CircuitBreakerConfig_RetryBudget) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CircuitBreakerConfig_RetryBudget)
+	if !ok {
+		that2, ok := that.(CircuitBreakerConfig_RetryBudget)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.BudgetPercent.Equal(that1.BudgetPercent) {
+		return false
+	}
+	if !this.MinRetryConcurrency.Equal(that1.MinRetryConcurrency) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CircuitBreakerConfig_Thresholds) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CircuitBreakerConfig_Thresholds)
+	if !ok {
+		that2, ok := that.(CircuitBreakerConfig_Thresholds)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxConnections.Equal(that1.MaxConnections) {
+		return false
+	}
+	if !this.MaxPendingRequests.Equal(that1.MaxPendingRequests) {
+		return false
+	}
+	if !this.MaxRequests.Equal(that1.MaxRequests) {
+		return false
+	}
+	if !this.MaxRetries.Equal(that1.MaxRetries) {
+		return false
+	}
+	if !this.RetryBudget.Equal(that1.RetryBudget) {
+		return false
+	}
+	if this.TrackRemaining != that1.TrackRemaining {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -154,9 +154,231 @@ func (m *ConnectionConfig_TcpKeepAlive) GetKeepaliveInterval() *time.Duration {
 	return nil
 }
 
+// Fine tune the HTTP protocols used for connections to an upstream
+type ProtocolOptions struct {
+	// Options common to HTTP/1.1 and HTTP/2 connections
+	CommonHttpProtocolOptions *ProtocolOptions_HttpProtocolOptions `protobuf:"bytes,1,opt,name=common_http_protocol_options,json=commonHttpProtocolOptions,proto3" json:"common_http_protocol_options,omitempty"`
+	// Options for HTTP/1.1 connections
+	Http1ProtocolOptions *ProtocolOptions_Http1ProtocolOptions `protobuf:"bytes,2,opt,name=http1_protocol_options,json=http1ProtocolOptions,proto3" json:"http1_protocol_options,omitempty"`
+	// Options for HTTP/2 connections
+	Http2ProtocolOptions *ProtocolOptions_Http2ProtocolOptions `protobuf:"bytes,3,opt,name=http2_protocol_options,json=http2ProtocolOptions,proto3" json:"http2_protocol_options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *ProtocolOptions) Reset()         { *m = ProtocolOptions{} }
+func (m *ProtocolOptions) String() string { return proto.CompactTextString(m) }
+func (*ProtocolOptions) ProtoMessage()    {}
+func (*ProtocolOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56610fe13cf10c84, []int{1}
+}
+func (m *ProtocolOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProtocolOptions.Unmarshal(m, b)
+}
+func (m *ProtocolOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProtocolOptions.Marshal(b, m, deterministic)
+}
+func (m *ProtocolOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolOptions.Merge(m, src)
+}
+func (m *ProtocolOptions) XXX_Size() int {
+	return xxx_messageInfo_ProtocolOptions.Size(m)
+}
+func (m *ProtocolOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolOptions proto.InternalMessageInfo
+
+func (m *ProtocolOptions) GetCommonHttpProtocolOptions() *ProtocolOptions_HttpProtocolOptions {
+	if m != nil {
+		return m.CommonHttpProtocolOptions
+	}
+	return nil
+}
+
+func (m *ProtocolOptions) GetHttp1ProtocolOptions() *ProtocolOptions_Http1ProtocolOptions {
+	if m != nil {
+		return m.Http1ProtocolOptions
+	}
+	return nil
+}
+
+func (m *ProtocolOptions) GetHttp2ProtocolOptions() *ProtocolOptions_Http2ProtocolOptions {
+	if m != nil {
+		return m.Http2ProtocolOptions
+	}
+	return nil
+}
+
+// Options common to HTTP/1.1 and HTTP/2 connections.
+// For more info, see the [envoy docs](https://www.envoyproxy.io/docs/envoy/v1.14.1/api-v2/api/v2/core/protocol.proto#envoy-api-msg-core-httpprotocoloptions)
+type ProtocolOptions_HttpProtocolOptions struct {
+	// The time a connection can stay open without active requests before it is closed. Defaults to 1 hour.
+	// Set to 0 to disable the idle timeout.
+	IdleTimeout *time.Duration `protobuf:"bytes,1,opt,name=idle_timeout,json=idleTimeout,proto3,stdduration" json:"idle_timeout,omitempty"`
+	// The maximum number of headers in a response. Responses with more headers are rejected. Defaults to 100.
+	MaxHeadersCount      *types.UInt32Value `protobuf:"bytes,2,opt,name=max_headers_count,json=maxHeadersCount,proto3" json:"max_headers_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ProtocolOptions_HttpProtocolOptions) Reset()         { *m = ProtocolOptions_HttpProtocolOptions{} }
+func (m *ProtocolOptions_HttpProtocolOptions) String() string { return proto.CompactTextString(m) }
+func (*ProtocolOptions_HttpProtocolOptions) ProtoMessage()    {}
+func (*ProtocolOptions_HttpProtocolOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56610fe13cf10c84, []int{1, 0}
+}
+func (m *ProtocolOptions_HttpProtocolOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProtocolOptions_HttpProtocolOptions.Unmarshal(m, b)
+}
+func (m *ProtocolOptions_HttpProtocolOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProtocolOptions_HttpProtocolOptions.Marshal(b, m, deterministic)
+}
+func (m *ProtocolOptions_HttpProtocolOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolOptions_HttpProtocolOptions.Merge(m, src)
+}
+func (m *ProtocolOptions_HttpProtocolOptions) XXX_Size() int {
+	return xxx_messageInfo_ProtocolOptions_HttpProtocolOptions.Size(m)
+}
+func (m *ProtocolOptions_HttpProtocolOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolOptions_HttpProtocolOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolOptions_HttpProtocolOptions proto.InternalMessageInfo
+
+func (m *ProtocolOptions_HttpProtocolOptions) GetIdleTimeout() *time.Duration {
+	if m != nil {
+		return m.IdleTimeout
+	}
+	return nil
+}
+
+func (m *ProtocolOptions_HttpProtocolOptions) GetMaxHeadersCount() *types.UInt32Value {
+	if m != nil {
+		return m.MaxHeadersCount
+	}
+	return nil
+}
+
+// Options for HTTP/1.1 connections. Ignored when HTTP/2 is used.
+type ProtocolOptions_Http1ProtocolOptions struct {
+	// Send header names in proper case (e.g. `Content-Type`) instead of lower case, for services that
+	// do not treat header names as case insensitive.
+	ProperCaseHeaderKeyFormat bool     `protobuf:"varint,1,opt,name=proper_case_header_key_format,json=properCaseHeaderKeyFormat,proto3" json:"proper_case_header_key_format,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *ProtocolOptions_Http1ProtocolOptions) Reset()         { *m = ProtocolOptions_Http1ProtocolOptions{} }
+func (m *ProtocolOptions_Http1ProtocolOptions) String() string { return proto.CompactTextString(m) }
+func (*ProtocolOptions_Http1ProtocolOptions) ProtoMessage()    {}
+func (*ProtocolOptions_Http1ProtocolOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56610fe13cf10c84, []int{1, 1}
+}
+func (m *ProtocolOptions_Http1ProtocolOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProtocolOptions_Http1ProtocolOptions.Unmarshal(m, b)
+}
+func (m *ProtocolOptions_Http1ProtocolOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProtocolOptions_Http1ProtocolOptions.Marshal(b, m, deterministic)
+}
+func (m *ProtocolOptions_Http1ProtocolOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolOptions_Http1ProtocolOptions.Merge(m, src)
+}
+func (m *ProtocolOptions_Http1ProtocolOptions) XXX_Size() int {
+	return xxx_messageInfo_ProtocolOptions_Http1ProtocolOptions.Size(m)
+}
+func (m *ProtocolOptions_Http1ProtocolOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolOptions_Http1ProtocolOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolOptions_Http1ProtocolOptions proto.InternalMessageInfo
+
+func (m *ProtocolOptions_Http1ProtocolOptions) GetProperCaseHeaderKeyFormat() bool {
+	if m != nil {
+		return m.ProperCaseHeaderKeyFormat
+	}
+	return false
+}
+
+// Options for HTTP/2 connections. Setting them makes envoy use HTTP/2 for the upstream, like `useHttp2`.
+// For more info, see the [envoy docs](https://www.envoyproxy.io/docs/envoy/v1.14.1/api-v2/api/v2/core/protocol.proto#envoy-api-msg-core-http2protocoloptions)
+type ProtocolOptions_Http2ProtocolOptions struct {
+	// The maximum number of concurrent streams on a connection. Between 1 and 2147483647, defaults to 2147483647.
+	MaxConcurrentStreams *types.UInt32Value `protobuf:"bytes,1,opt,name=max_concurrent_streams,json=maxConcurrentStreams,proto3" json:"max_concurrent_streams,omitempty"`
+	// The initial flow control window of a stream, in bytes. Between 65535 and 2147483647, defaults to 268435456 (256 * 1024 * 1024).
+	InitialStreamWindowSize *types.UInt32Value `protobuf:"bytes,2,opt,name=initial_stream_window_size,json=initialStreamWindowSize,proto3" json:"initial_stream_window_size,omitempty"`
+	// The initial flow control window of a connection, in bytes. Between 65535 and 2147483647, defaults to 268435456 (256 * 1024 * 1024).
+	InitialConnectionWindowSize *types.UInt32Value `protobuf:"bytes,3,opt,name=initial_connection_window_size,json=initialConnectionWindowSize,proto3" json:"initial_connection_window_size,omitempty"`
+	// The maximum size of the HPACK header table, in bytes. Defaults to 4096, and 0 disables header compression.
+	HpackTableSize       *types.UInt32Value `protobuf:"bytes,4,opt,name=hpack_table_size,json=hpackTableSize,proto3" json:"hpack_table_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ProtocolOptions_Http2ProtocolOptions) Reset()         { *m = ProtocolOptions_Http2ProtocolOptions{} }
+func (m *ProtocolOptions_Http2ProtocolOptions) String() string { return proto.CompactTextString(m) }
+func (*ProtocolOptions_Http2ProtocolOptions) ProtoMessage()    {}
+func (*ProtocolOptions_Http2ProtocolOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56610fe13cf10c84, []int{1, 2}
+}
+func (m *ProtocolOptions_Http2ProtocolOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProtocolOptions_Http2ProtocolOptions.Unmarshal(m, b)
+}
+func (m *ProtocolOptions_Http2ProtocolOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProtocolOptions_Http2ProtocolOptions.Marshal(b, m, deterministic)
+}
+func (m *ProtocolOptions_Http2ProtocolOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolOptions_Http2ProtocolOptions.Merge(m, src)
+}
+func (m *ProtocolOptions_Http2ProtocolOptions) XXX_Size() int {
+	return xxx_messageInfo_ProtocolOptions_Http2ProtocolOptions.Size(m)
+}
+func (m *ProtocolOptions_Http2ProtocolOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolOptions_Http2ProtocolOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolOptions_Http2ProtocolOptions proto.InternalMessageInfo
+
+func (m *ProtocolOptions_Http2ProtocolOptions) GetMaxConcurrentStreams() *types.UInt32Value {
+	if m != nil {
+		return m.MaxConcurrentStreams
+	}
+	return nil
+}
+
+func (m *ProtocolOptions_Http2ProtocolOptions) GetInitialStreamWindowSize() *types.UInt32Value {
+	if m != nil {
+		return m.InitialStreamWindowSize
+	}
+	return nil
+}
+
+func (m *ProtocolOptions_Http2ProtocolOptions) GetInitialConnectionWindowSize() *types.UInt32Value {
+	if m != nil {
+		return m.InitialConnectionWindowSize
+	}
+	return nil
+}
+
+func (m *ProtocolOptions_Http2ProtocolOptions) GetHpackTableSize() *types.UInt32Value {
+	if m != nil {
+		return m.HpackTableSize
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConnectionConfig)(nil), "gloo.solo.io.ConnectionConfig")
 	proto.RegisterType((*ConnectionConfig_TcpKeepAlive)(nil), "gloo.solo.io.ConnectionConfig.TcpKeepAlive")
+	proto.RegisterType((*ProtocolOptions)(nil), "gloo.solo.io.ProtocolOptions")
+	proto.RegisterType((*ProtocolOptions_HttpProtocolOptions)(nil), "gloo.solo.io.ProtocolOptions.HttpProtocolOptions")
+	proto.RegisterType((*ProtocolOptions_Http1ProtocolOptions)(nil), "gloo.solo.io.ProtocolOptions.Http1ProtocolOptions")
+	proto.RegisterType((*ProtocolOptions_Http2ProtocolOptions)(nil), "gloo.solo.io.ProtocolOptions.Http2ProtocolOptions")
 }
 
 func init() {
@@ -164,34 +386,53 @@ func init() {
 }
 
 var fileDescriptor_56610fe13cf10c84 = []byte{
-	// 431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6e, 0xd4, 0x30,
-	0x14, 0xc6, 0x15, 0x18, 0xb1, 0x30, 0x33, 0x6d, 0xb1, 0x58, 0x84, 0x01, 0x4a, 0x61, 0x55, 0x84,
-	0x70, 0xa0, 0xdd, 0x21, 0x75, 0xc1, 0x14, 0x21, 0x2a, 0x10, 0x1a, 0x45, 0x85, 0x05, 0x1b, 0xcb,
-	0x09, 0x2f, 0xc6, 0x34, 0xc9, 0x33, 0x8e, 0x33, 0x84, 0x9b, 0x70, 0x04, 0xb8, 0x01, 0xd7, 0xe0,
-	0x04, 0x48, 0xdc, 0x81, 0x3d, 0xb2, 0x63, 0x92, 0x81, 0x2e, 0x98, 0x5d, 0xde, 0x9f, 0xef, 0x97,
-	0xef, 0x7d, 0x32, 0x39, 0x92, 0xca, 0xbe, 0x6b, 0x33, 0x96, 0x63, 0x95, 0x34, 0x58, 0xe2, 0x7d,
-	0x85, 0x89, 0x2c, 0x11, 0x13, 0x6d, 0xf0, 0x3d, 0xe4, 0xb6, 0xe9, 0x2b, 0xa1, 0x55, 0xb2, 0x7a,
-	0x98, 0xe4, 0x58, 0xd7, 0x90, 0x5b, 0x85, 0x35, 0xd3, 0x06, 0x2d, 0xd2, 0xa9, 0x9b, 0x32, 0x27,
-	0x64, 0x0a, 0xe7, 0x57, 0x25, 0x4a, 0xf4, 0x83, 0xc4, 0x7d, 0xf5, 0x3b, 0xf3, 0x5d, 0x89, 0x28,
-	0x4b, 0x48, 0x7c, 0x95, 0xb5, 0x45, 0xf2, 0xb6, 0x35, 0x62, 0x64, 0x9c, 0x9f, 0x7f, 0x34, 0x42,
-	0x6b, 0x30, 0x4d, 0x98, 0x53, 0xe8, 0x6c, 0x0f, 0x85, 0xce, 0xf6, 0xbd, 0x3b, 0x5f, 0x27, 0x64,
-	0xe7, 0x78, 0x30, 0x73, 0x8c, 0x75, 0xa1, 0x24, 0x3d, 0x22, 0xd7, 0x2b, 0xd1, 0x71, 0x03, 0x1f,
-	0x5a, 0x68, 0x6c, 0xc3, 0x35, 0x18, 0x3e, 0x3a, 0x8e, 0xa3, 0xbd, 0x68, 0x7f, 0x96, 0xc6, 0x95,
-	0xe8, 0xd2, 0xb0, 0xb1, 0x04, 0x33, 0x42, 0xe8, 0x33, 0xb2, 0x1d, 0xb6, 0xb9, 0x55, 0x15, 0x60,
-	0x6b, 0xe3, 0x0b, 0x7b, 0xd1, 0xfe, 0xe5, 0x83, 0x6b, 0xac, 0x77, 0xc8, 0xfe, 0x38, 0x64, 0x4f,
-	0xc2, 0x05, 0x8b, 0xc9, 0xe7, 0x1f, 0xb7, 0xa2, 0x74, 0x2b, 0xe8, 0x4e, 0x7b, 0x19, 0x5d, 0x92,
-	0x99, 0xcd, 0x35, 0x3f, 0x03, 0xd0, 0xa2, 0x54, 0x2b, 0x88, 0x2f, 0x7a, 0xce, 0x3d, 0xb6, 0x9e,
-	0x16, 0xfb, 0xd7, 0x3f, 0x3b, 0xcd, 0xf5, 0x73, 0x00, 0xfd, 0xd8, 0x49, 0xd2, 0xa9, 0xed, 0x2b,
-	0x0f, 0xa0, 0x05, 0xb9, 0xfd, 0xf7, 0x35, 0x3c, 0x6b, 0x8b, 0x02, 0x0c, 0x2f, 0x55, 0xa5, 0x2c,
-	0xcf, 0x3e, 0x59, 0x68, 0xe2, 0x89, 0xff, 0xcb, 0x8d, 0x73, 0x6e, 0x5f, 0x9d, 0xd4, 0xf6, 0xf0,
-	0xe0, 0xb5, 0x28, 0x5b, 0x48, 0x6f, 0xea, 0xf5, 0x9b, 0x17, 0x1e, 0xf2, 0xc2, 0x31, 0x16, 0x0e,
-	0x31, 0xff, 0x1e, 0x91, 0xe9, 0xba, 0x0d, 0x7a, 0x97, 0xec, 0x0c, 0x67, 0x70, 0x6d, 0x30, 0x83,
-	0x26, 0x04, 0xb9, 0x3d, 0xf4, 0x97, 0xbe, 0x4d, 0x9f, 0x92, 0xad, 0x71, 0xd5, 0x25, 0xb8, 0x69,
-	0x7c, 0xb3, 0x41, 0xe6, 0x02, 0xa4, 0x2f, 0x09, 0x1d, 0x39, 0xaa, 0xb6, 0x60, 0x56, 0xa2, 0x0c,
-	0x11, 0xfe, 0x97, 0x75, 0x65, 0x90, 0x9e, 0x04, 0xe5, 0xe2, 0xd1, 0xb7, 0x5f, 0x93, 0xe8, 0xcb,
-	0xcf, 0xdd, 0xe8, 0xcd, 0x83, 0xcd, 0x1e, 0xbb, 0x3e, 0x93, 0xe1, 0xc1, 0x67, 0x97, 0xfc, 0x7f,
-	0x0e, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x37, 0x2f, 0xf0, 0x27, 0x03, 0x00, 0x00,
+	// 728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0x41, 0x4b, 0x68, 0x8b, 0xb5, 0x6c, 0xd9, 0x5b, 0xc1, 0x95, 0xe5, 0x8f, 0xba, 0x3e,
+	0xb9, 0x28, 0x4a, 0x56, 0xf2, 0xad, 0x80, 0x81, 0x56, 0x0a, 0x0c, 0x19, 0x0e, 0x12, 0x81, 0x76,
+	0x3e, 0x2f, 0x8b, 0x15, 0x35, 0xa2, 0x36, 0x22, 0xb9, 0x9b, 0xe5, 0xd2, 0x96, 0xfd, 0x24, 0xb9,
+	0xe7, 0x92, 0xbc, 0x41, 0x1e, 0x22, 0x97, 0x3c, 0x41, 0x80, 0x3c, 0x41, 0x2e, 0xb9, 0x07, 0xcb,
+	0xa5, 0x3e, 0x2c, 0x09, 0x0e, 0x6f, 0xe2, 0xcc, 0xfc, 0x7f, 0xff, 0x99, 0x11, 0x77, 0x89, 0x4e,
+	0x7c, 0xa6, 0x06, 0x49, 0xd7, 0xf6, 0x78, 0xe8, 0xc4, 0x3c, 0xe0, 0x7f, 0x33, 0xee, 0xf8, 0x01,
+	0xe7, 0x8e, 0x90, 0xfc, 0x15, 0x78, 0x2a, 0x36, 0x4f, 0x54, 0x30, 0xe7, 0xaa, 0xee, 0x78, 0x3c,
+	0x8a, 0xc0, 0x53, 0x8c, 0x47, 0xb6, 0x90, 0x5c, 0x71, 0x5c, 0xd2, 0x59, 0x5b, 0x0b, 0x6d, 0xc6,
+	0x6b, 0x15, 0x9f, 0xfb, 0x3c, 0x4d, 0x38, 0xfa, 0x97, 0xa9, 0xa9, 0xed, 0xfb, 0x9c, 0xfb, 0x01,
+	0x38, 0xe9, 0x53, 0x37, 0xe9, 0x3b, 0xbd, 0x44, 0xd2, 0x29, 0x63, 0x31, 0x7f, 0x2d, 0xa9, 0x10,
+	0x20, 0xe3, 0x2c, 0x8f, 0x61, 0xa4, 0x0c, 0x14, 0x46, 0xca, 0xc4, 0x0e, 0xdf, 0x17, 0xd1, 0x46,
+	0x6b, 0xd2, 0x4c, 0x8b, 0x47, 0x7d, 0xe6, 0xe3, 0x13, 0xb4, 0x13, 0xd2, 0x11, 0x91, 0xf0, 0x3a,
+	0x81, 0x58, 0xc5, 0x44, 0x80, 0x24, 0xd3, 0x8e, 0xab, 0xd6, 0x81, 0x75, 0xb4, 0xe6, 0x56, 0x43,
+	0x3a, 0x72, 0xb3, 0x8a, 0x0e, 0xc8, 0x29, 0x04, 0xb7, 0x51, 0x39, 0xab, 0x26, 0x8a, 0x85, 0xc0,
+	0x13, 0x55, 0x5d, 0x39, 0xb0, 0x8e, 0x56, 0x1b, 0xdb, 0xb6, 0xe9, 0xd0, 0x1e, 0x77, 0x68, 0x3f,
+	0xc8, 0x26, 0x68, 0x16, 0xdf, 0x7c, 0xfe, 0xdd, 0x72, 0xd7, 0x33, 0xdd, 0xa5, 0x91, 0xe1, 0x0e,
+	0x5a, 0x53, 0x9e, 0x20, 0x43, 0x00, 0x41, 0x03, 0x76, 0x05, 0xd5, 0x42, 0xca, 0xf9, 0xcb, 0x9e,
+	0xdd, 0x96, 0x3d, 0xdf, 0xbf, 0x7d, 0xe9, 0x89, 0x73, 0x00, 0xf1, 0xbf, 0x96, 0xb8, 0x25, 0x65,
+	0x9e, 0x52, 0x00, 0xee, 0xa3, 0x3f, 0xee, 0x4e, 0x43, 0xba, 0x49, 0xbf, 0x0f, 0x92, 0x04, 0x2c,
+	0x64, 0x8a, 0x74, 0x6f, 0x14, 0xc4, 0xd5, 0x62, 0xea, 0xb2, 0xbb, 0xd0, 0xed, 0x93, 0xb3, 0x48,
+	0x1d, 0x37, 0x9e, 0xd2, 0x20, 0x01, 0x77, 0x4f, 0xcc, 0xce, 0xdc, 0x4c, 0x21, 0x0f, 0x35, 0xa3,
+	0xa9, 0x11, 0xb5, 0x4f, 0x16, 0x2a, 0xcd, 0xb6, 0x81, 0xff, 0x44, 0x1b, 0x93, 0x31, 0x88, 0x90,
+	0xbc, 0x0b, 0x71, 0xb6, 0xc8, 0xf2, 0x24, 0xde, 0x49, 0xc3, 0xf8, 0x14, 0xad, 0x4f, 0x4b, 0xf5,
+	0x06, 0xf3, 0xae, 0x6f, 0x6d, 0x22, 0xd3, 0x0b, 0xc4, 0x8f, 0x10, 0x9e, 0x72, 0x58, 0xa4, 0x40,
+	0x5e, 0xd1, 0xa0, 0x5a, 0xc8, 0xc7, 0xda, 0x9c, 0x48, 0xcf, 0x32, 0xe5, 0xe1, 0xc7, 0x9f, 0x51,
+	0xb9, 0xa3, 0xcb, 0x3d, 0x1e, 0x3c, 0x16, 0xba, 0x36, 0xc6, 0x12, 0xed, 0x7a, 0x3c, 0x0c, 0x79,
+	0x44, 0x06, 0x4a, 0x09, 0x22, 0xb2, 0x34, 0xe1, 0x26, 0x9f, 0x8e, 0xb8, 0xda, 0xa8, 0xdf, 0xfd,
+	0xc3, 0xe6, 0x20, 0x76, 0x5b, 0x29, 0x31, 0x17, 0x73, 0xb7, 0x0d, 0x76, 0x49, 0x0a, 0x0f, 0xd0,
+	0x96, 0x36, 0xab, 0x2f, 0xba, 0x99, 0x3d, 0x35, 0x7e, 0xec, 0x56, 0x9f, 0xb7, 0xab, 0x0c, 0x96,
+	0x44, 0xc7, 0x4e, 0x8d, 0x45, 0xa7, 0x42, 0x5e, 0xa7, 0xc6, 0x52, 0xa7, 0xf9, 0x68, 0xed, 0xad,
+	0x85, 0x7e, 0x5d, 0x36, 0x6b, 0x13, 0x95, 0x58, 0x2f, 0x80, 0xc9, 0x41, 0xb2, 0xf2, 0xfd, 0x7b,
+	0xab, 0x5a, 0x34, 0x3e, 0x45, 0x6d, 0xb4, 0xa9, 0x8f, 0xf3, 0x00, 0x68, 0x0f, 0x64, 0x4c, 0x3c,
+	0x9e, 0x44, 0xe3, 0x13, 0x79, 0xff, 0x3b, 0x5e, 0x0e, 0xe9, 0xa8, 0x6d, 0x54, 0x2d, 0x2d, 0xaa,
+	0x3d, 0x47, 0x95, 0x65, 0xdb, 0xc3, 0xff, 0xa1, 0x3d, 0x21, 0x79, 0x7a, 0xb0, 0x68, 0x0c, 0x99,
+	0x13, 0x19, 0xc2, 0x0d, 0xe9, 0x73, 0x19, 0x52, 0xd3, 0xf6, 0x2f, 0xee, 0xb6, 0x29, 0x6a, 0xd1,
+	0x18, 0x0c, 0xf6, 0x1c, 0x6e, 0x4e, 0xd3, 0x82, 0xda, 0xd7, 0x15, 0x83, 0x9e, 0x5f, 0x0c, 0x76,
+	0xd1, 0x96, 0x6e, 0xde, 0xe3, 0x91, 0x97, 0x48, 0x09, 0x91, 0x22, 0xb1, 0x92, 0x40, 0xc3, 0xf1,
+	0xab, 0x75, 0xff, 0x04, 0x95, 0x90, 0x8e, 0x5a, 0x13, 0xe9, 0x85, 0x51, 0xe2, 0x17, 0xa8, 0xc6,
+	0x22, 0xa6, 0x18, 0x0d, 0x32, 0x18, 0xb9, 0x66, 0x51, 0x8f, 0x5f, 0x93, 0x98, 0xdd, 0x42, 0xae,
+	0xcd, 0xfc, 0x96, 0xe9, 0x0d, 0xf1, 0x59, 0xaa, 0xbe, 0x60, 0xb7, 0x80, 0x29, 0xda, 0x1f, 0xa3,
+	0x67, 0xee, 0x98, 0x59, 0x7c, 0x21, 0x07, 0x7e, 0x27, 0x63, 0x4c, 0x2f, 0x98, 0x19, 0x8b, 0x53,
+	0xb4, 0x31, 0x10, 0xd4, 0x1b, 0x12, 0x45, 0xbb, 0x01, 0x18, 0x68, 0x9e, 0x1b, 0x6b, 0x3d, 0x55,
+	0x5d, 0x6a, 0x91, 0xe6, 0x34, 0xff, 0xfd, 0xf0, 0xad, 0x68, 0xbd, 0xfb, 0xb2, 0x6f, 0xbd, 0xfc,
+	0x27, 0xdf, 0xb7, 0x4b, 0x0c, 0xfd, 0xec, 0xfb, 0xd5, 0xfd, 0x29, 0x75, 0x38, 0xfe, 0x3e, 0x00,
+	0x54, 0xfa, 0xef, 0x59, 0xf6, 0x06, 0x00, 0x00,
 }
 
 func (this *ConnectionConfig) Equal(that interface{}) bool {
@@ -281,3 +522,135 @@ func (this *ConnectionConfig_TcpKeepAlive) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ProtocolOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProtocolOptions)
+	if !ok {
+		that2, ok := that.(ProtocolOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CommonHttpProtocolOptions.Equal(that1.CommonHttpProtocolOptions) {
+		return false
+	}
+	if !this.Http1ProtocolOptions.Equal(that1.Http1ProtocolOptions) {
+		return false
+	}
+	if !this.Http2ProtocolOptions.Equal(that1.Http2ProtocolOptions) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ProtocolOptions_HttpProtocolOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProtocolOptions_HttpProtocolOptions)
+	if !ok {
+		that2, ok := that.(ProtocolOptions_HttpProtocolOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IdleTimeout != nil && that1.IdleTimeout != nil {
+		if *this.IdleTimeout != *that1.IdleTimeout {
+			return false
+		}
+	} else if this.IdleTimeout != nil {
+		return false
+	} else if that1.IdleTimeout != nil {
+		return false
+	}
+	if !this.MaxHeadersCount.Equal(that1.MaxHeadersCount) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ProtocolOptions_Http1ProtocolOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProtocolOptions_Http1ProtocolOptions)
+	if !ok {
+		that2, ok := that.(ProtocolOptions_Http1ProtocolOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProperCaseHeaderKeyFormat != that1.ProperCaseHeaderKeyFormat {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *ProtocolOptions_Http2ProtocolOptions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProtocolOptions_Http2ProtocolOptions)
+	if !ok {
+		that2, ok := that.(ProtocolOptions_Http2ProtocolOptions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxConcurrentStreams.Equal(that1.MaxConcurrentStreams) {
+		return false
+	}
+	if !this.InitialStreamWindowSize.Equal(that1.InitialStreamWindowSize) {
+		return false
+	}
+	if !this.InitialConnectionWindowSize.Equal(that1.InitialConnectionWindowSize) {
+		return false
+	}
+	if !this.HpackTableSize.Equal(that1.HpackTableSize) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -10,6 +10,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -39,11 +40,16 @@ type Endpoint struct {
 	Hostname string `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
 	// configuration for health checking the endpoint.
 	HealthCheck *HealthCheckConfig `protobuf:"bytes,5,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	// the locality of the endpoint, used for locality aware load balancing.
+	Locality *Locality `protobuf:"bytes,6,opt,name=locality,proto3" json:"locality,omitempty"`
 	// Metadata contains the object metadata for this resource
-	Metadata             core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Metadata core.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata"`
+	// the load balancing weight of the endpoint, relative to the other endpoints of its upstreams.
+	// if not set, the endpoint has a weight of 1.
+	LoadBalancingWeight  *types.UInt32Value `protobuf:"bytes,8,opt,name=load_balancing_weight,json=loadBalancingWeight,proto3" json:"load_balancing_weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Endpoint) Reset()         { *m = Endpoint{} }
@@ -105,6 +111,13 @@ func (m *Endpoint) GetHealthCheck() *HealthCheckConfig {
 	return nil
 }
 
+func (m *Endpoint) GetLocality() *Locality {
+	if m != nil {
+		return m.Locality
+	}
+	return nil
+}
+
 func (m *Endpoint) GetMetadata() core.Metadata {
 	if m != nil {
 		return m.Metadata
@@ -112,6 +125,13 @@ func (m *Endpoint) GetMetadata() core.Metadata {
 	return core.Metadata{}
 }
 
+func (m *Endpoint) GetLoadBalancingWeight() *types.UInt32Value {
+	if m != nil {
+		return m.LoadBalancingWeight
+	}
+	return nil
+}
+
 type HealthCheckConfig struct {
 	// hostname to use for the endpoint health checks if provided.
 	Hostname             string   `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
@@ -151,9 +171,69 @@ func (m *HealthCheckConfig) GetHostname() string {
 	return ""
 }
 
+// Identifies where an endpoint runs. For kubernetes endpoints, this is populated from the topology labels
+// of the node the endpoint's pod is scheduled on.
+type Locality struct {
+	// region the endpoint runs in.
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// zone the endpoint runs in.
+	Zone string `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	// subzone the endpoint runs in. this is a finer grained location within the zone.
+	SubZone              string   `protobuf:"bytes,3,opt,name=sub_zone,json=subZone,proto3" json:"sub_zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Locality) Reset()         { *m = Locality{} }
+func (m *Locality) String() string { return proto.CompactTextString(m) }
+func (*Locality) ProtoMessage()    {}
+func (*Locality) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7969f9617648787, []int{2}
+}
+func (m *Locality) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Locality.Unmarshal(m, b)
+}
+func (m *Locality) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Locality.Marshal(b, m, deterministic)
+}
+func (m *Locality) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Locality.Merge(m, src)
+}
+func (m *Locality) XXX_Size() int {
+	return xxx_messageInfo_Locality.Size(m)
+}
+func (m *Locality) XXX_DiscardUnknown() {
+	xxx_messageInfo_Locality.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Locality proto.InternalMessageInfo
+
+func (m *Locality) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *Locality) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *Locality) GetSubZone() string {
+	if m != nil {
+		return m.SubZone
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Endpoint)(nil), "gloo.solo.io.Endpoint")
 	proto.RegisterType((*HealthCheckConfig)(nil), "gloo.solo.io.HealthCheckConfig")
+	proto.RegisterType((*Locality)(nil), "gloo.solo.io.Locality")
 }
 
 func init() {
@@ -161,31 +241,38 @@ func init() {
 }
 
 var fileDescriptor_f7969f9617648787 = []byte{
-	// 373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0xce, 0xd3, 0x30,
-	0x14, 0xc5, 0xf9, 0x0c, 0x5f, 0xeb, 0x94, 0x01, 0x0b, 0xa1, 0x34, 0x12, 0x6d, 0xd4, 0x29, 0x0b,
-	0x0e, 0x94, 0x01, 0x54, 0xb6, 0x56, 0x48, 0x2c, 0x2c, 0x19, 0x59, 0x90, 0x9b, 0xdc, 0x26, 0xa1,
-	0x4d, 0xae, 0x65, 0xbb, 0xa8, 0x73, 0x9f, 0x06, 0xf1, 0x04, 0x3c, 0x02, 0x4f, 0xc1, 0xc0, 0x1b,
-	0x74, 0x60, 0x47, 0xf9, 0x6b, 0x29, 0x08, 0xe9, 0xdb, 0xee, 0xb9, 0xe7, 0x1c, 0xdf, 0x3f, 0xb3,
-	0x37, 0x59, 0x61, 0xf3, 0xfd, 0x5a, 0x24, 0x58, 0x46, 0x06, 0x77, 0xf8, 0xac, 0xc0, 0x28, 0xdb,
-	0x21, 0x46, 0x4a, 0xe3, 0x27, 0x48, 0xac, 0x69, 0x91, 0x54, 0x45, 0xf4, 0xf9, 0x45, 0x04, 0x55,
-	0xaa, 0xb0, 0xa8, 0xac, 0x50, 0x1a, 0x2d, 0xf2, 0x51, 0xcd, 0x89, 0xda, 0x26, 0x0a, 0xf4, 0x1f,
-	0x67, 0x98, 0x61, 0x43, 0x44, 0x75, 0xd4, 0x6a, 0x7c, 0x0e, 0x07, 0xdb, 0x26, 0xe1, 0xd0, 0xf9,
-	0xfc, 0x49, 0x53, 0x69, 0x5b, 0xd8, 0xfe, 0xdd, 0x12, 0xac, 0x4c, 0xa5, 0x95, 0x1d, 0x3f, 0xfe,
-	0x9b, 0xd7, 0xb0, 0xf9, 0x9f, 0xb5, 0xc7, 0x2d, 0x3f, 0xfb, 0xea, 0xb0, 0xc1, 0xdb, 0xae, 0x4b,
-	0xfe, 0x8a, 0x0d, 0xf7, 0xca, 0x58, 0x0d, 0xb2, 0x34, 0x1e, 0x09, 0x6e, 0x42, 0x77, 0x3e, 0x16,
-	0x09, 0x6a, 0xe8, 0x7b, 0x16, 0x31, 0x18, 0xdc, 0xeb, 0x04, 0x62, 0xd8, 0xc4, 0x17, 0x2d, 0xf7,
-	0xd8, 0xad, 0x4c, 0x53, 0x0d, 0xc6, 0x78, 0x4e, 0x40, 0xc2, 0x61, 0xdc, 0x43, 0xce, 0x19, 0x55,
-	0xa8, 0xad, 0x77, 0x13, 0x90, 0xf0, 0x61, 0xdc, 0xc4, 0xdc, 0x67, 0x83, 0x1c, 0x8d, 0xad, 0x64,
-	0x09, 0x1e, 0x6d, 0xe4, 0x67, 0xcc, 0x97, 0x6c, 0x94, 0x83, 0xdc, 0xd9, 0xfc, 0x63, 0x92, 0x43,
-	0xb2, 0xf5, 0xee, 0x07, 0x24, 0x74, 0xe7, 0x53, 0xf1, 0xe7, 0xe6, 0xc4, 0xbb, 0x46, 0xb1, 0xaa,
-	0x05, 0x2b, 0xac, 0x36, 0x45, 0x16, 0xbb, 0xf9, 0x25, 0xc5, 0x5f, 0xb3, 0x41, 0xbf, 0x20, 0xef,
-	0xb6, 0xf1, 0x3f, 0xb9, 0x9e, 0xe2, 0x7d, 0xc7, 0x2e, 0xe9, 0xf7, 0x1f, 0xd3, 0x7b, 0xf1, 0x59,
-	0xbd, 0x78, 0x7a, 0x3c, 0x51, 0xca, 0x1c, 0x50, 0xc7, 0x13, 0x75, 0xf9, 0xb0, 0x3f, 0x9f, 0x39,
-	0x9e, 0xa8, 0x13, 0x92, 0x59, 0xc4, 0x1e, 0xfd, 0x53, 0xfa, 0x6a, 0x1a, 0x72, 0x3d, 0xcd, 0x72,
-	0xf1, 0xed, 0x17, 0x25, 0x5f, 0x7e, 0x4e, 0xc8, 0x87, 0xe7, 0x77, 0xfb, 0x37, 0x6a, 0x9b, 0x75,
-	0x87, 0x5a, 0x3f, 0x68, 0x0e, 0xf4, 0xf2, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x49, 0xb7,
-	0xa1, 0x72, 0x02, 0x00, 0x00,
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0xc4, 0x4d, 0x48, 0x9d, 0x4d, 0x39, 0x74, 0xa1, 0x95, 0x13, 0xa1, 0x36, 0xca, 0x29, 0x17,
+	0xd6, 0x90, 0x1e, 0x40, 0xe5, 0x96, 0x0a, 0x09, 0x24, 0x90, 0xc0, 0x12, 0x20, 0xf5, 0x12, 0xad,
+	0xed, 0x97, 0xf5, 0x12, 0xc7, 0xcf, 0xda, 0x5d, 0xd3, 0xc2, 0x91, 0xaf, 0xe9, 0x27, 0xf0, 0x09,
+	0x7c, 0x05, 0x07, 0xfe, 0x80, 0x03, 0x77, 0xe4, 0xb5, 0x37, 0x25, 0x20, 0xa4, 0xde, 0x76, 0xde,
+	0xcc, 0xd8, 0x7e, 0x33, 0x6b, 0xf2, 0x54, 0x48, 0x93, 0x55, 0x31, 0x4b, 0x70, 0x1d, 0x6a, 0xcc,
+	0xf1, 0x81, 0xc4, 0x50, 0xe4, 0x88, 0x61, 0xa9, 0xf0, 0x03, 0x24, 0x46, 0x37, 0x88, 0x97, 0x32,
+	0xfc, 0xf8, 0x28, 0x84, 0x22, 0x2d, 0x51, 0x16, 0x86, 0x95, 0x0a, 0x0d, 0xd2, 0xbd, 0x9a, 0x63,
+	0xb5, 0x8d, 0x49, 0x1c, 0xdd, 0x13, 0x28, 0xd0, 0x12, 0x61, 0x7d, 0x6a, 0x34, 0x23, 0x0a, 0x97,
+	0xa6, 0x19, 0xc2, 0x65, 0xeb, 0x1b, 0x1d, 0x09, 0x44, 0x91, 0x43, 0x68, 0x51, 0x5c, 0x2d, 0xc3,
+	0x0b, 0xc5, 0xcb, 0x12, 0x94, 0x76, 0xbc, 0xfd, 0x92, 0x95, 0x34, 0xee, 0xbd, 0x6b, 0x30, 0x3c,
+	0xe5, 0x86, 0xb7, 0xfc, 0xf0, 0x6f, 0x5e, 0xc1, 0xf2, 0x7f, 0x56, 0x87, 0x1b, 0x7e, 0x72, 0xd5,
+	0x21, 0xfe, 0xb3, 0x76, 0x0b, 0xfa, 0x98, 0xf4, 0xab, 0x52, 0x1b, 0x05, 0x7c, 0xad, 0x03, 0x6f,
+	0xdc, 0x99, 0x0e, 0x66, 0x43, 0x96, 0xa0, 0x02, 0xb7, 0x13, 0x8b, 0x40, 0x63, 0xa5, 0x12, 0x88,
+	0x60, 0x19, 0x5d, 0x6b, 0x69, 0x40, 0x76, 0x79, 0x9a, 0x2a, 0xd0, 0x3a, 0xd8, 0x19, 0x7b, 0xd3,
+	0x7e, 0xe4, 0x20, 0xa5, 0xa4, 0x5b, 0xa2, 0x32, 0x41, 0x67, 0xec, 0x4d, 0xef, 0x44, 0xf6, 0x4c,
+	0x47, 0xc4, 0xcf, 0x50, 0x9b, 0x82, 0xaf, 0x21, 0xe8, 0x5a, 0xf9, 0x06, 0xd3, 0x39, 0xd9, 0xcb,
+	0x80, 0xe7, 0x26, 0x5b, 0x24, 0x19, 0x24, 0xab, 0xe0, 0xf6, 0xd8, 0x9b, 0x0e, 0x66, 0xc7, 0xec,
+	0xcf, 0x64, 0xd9, 0x73, 0xab, 0x38, 0xab, 0x05, 0x67, 0x58, 0x2c, 0xa5, 0x88, 0x06, 0xd9, 0xf5,
+	0x88, 0xce, 0x88, 0x9f, 0x63, 0xc2, 0x73, 0x69, 0x3e, 0x05, 0x3d, 0xeb, 0x3f, 0xdc, 0xf6, 0xbf,
+	0x6c, 0xd9, 0x68, 0xa3, 0xa3, 0x4f, 0x88, 0xef, 0x42, 0x0d, 0x76, 0x5b, 0xcf, 0xd6, 0xe6, 0xaf,
+	0x5a, 0x76, 0xde, 0xfd, 0xf6, 0xfd, 0xf8, 0x56, 0xb4, 0x51, 0xd3, 0xd7, 0xe4, 0x20, 0x47, 0x9e,
+	0x2e, 0x62, 0x9e, 0xf3, 0x22, 0x91, 0x85, 0x58, 0x5c, 0x80, 0x14, 0x99, 0x09, 0x7c, 0xfb, 0x98,
+	0xfb, 0xac, 0x29, 0x97, 0xb9, 0x72, 0xd9, 0xdb, 0x17, 0x85, 0x39, 0x99, 0xbd, 0xe3, 0x79, 0x05,
+	0xd1, 0xdd, 0xda, 0x3a, 0x77, 0xce, 0xf7, 0xd6, 0x78, 0x7a, 0xf0, 0xe5, 0x67, 0x77, 0x9f, 0xec,
+	0x40, 0x49, 0xfb, 0xee, 0x82, 0xe9, 0xa9, 0x37, 0x09, 0xc9, 0xfe, 0x3f, 0x8b, 0x6f, 0x65, 0xe9,
+	0x6d, 0x67, 0x39, 0x79, 0x43, 0x7c, 0xb7, 0x29, 0x3d, 0x24, 0x3d, 0x05, 0x42, 0x62, 0xd1, 0xaa,
+	0x5a, 0x54, 0xf7, 0xf3, 0x19, 0x0b, 0x68, 0x6b, 0xb3, 0x67, 0x3a, 0x24, 0xbe, 0xae, 0xe2, 0x85,
+	0x9d, 0x77, 0x9a, 0x3a, 0x75, 0x15, 0x9f, 0x63, 0x01, 0xf3, 0xd3, 0xaf, 0xbf, 0xba, 0xde, 0xd5,
+	0x8f, 0x23, 0xef, 0xfc, 0xe1, 0xcd, 0x7e, 0x94, 0x72, 0x25, 0xda, 0x9b, 0x17, 0xf7, 0x6c, 0x02,
+	0x27, 0xbf, 0x07, 0x00, 0x39, 0xd5, 0x49, 0xcd, 0x63, 0x03, 0x00, 0x00,
 }
 
 func (this *Endpoint) Equal(that interface{}) bool {
@@ -227,9 +314,15 @@ func (this *Endpoint) Equal(that interface{}) bool {
 	if !this.HealthCheck.Equal(that1.HealthCheck) {
 		return false
 	}
+	if !this.Locality.Equal(that1.Locality) {
+		return false
+	}
 	if !this.Metadata.Equal(&that1.Metadata) {
 		return false
 	}
+	if !this.LoadBalancingWeight.Equal(that1.LoadBalancingWeight) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -262,3 +355,36 @@ func (this *HealthCheckConfig) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Locality) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Locality)
+	if !ok {
+		that2, ok := that.(Locality)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.Zone != that1.Zone {
+		return false
+	}
+	if this.SubZone != that1.SubZone {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -50,3 +50,11 @@ func (us *Upstream_Consul) GetServiceSpec() *plugins.ServiceSpec {
 func (us *Upstream_Consul) SetServiceSpec(spec *plugins.ServiceSpec) {
 	us.Consul.ServiceSpec = spec
 }
+
+func (us *Upstream_DnsSrv) GetServiceSpec() *plugins.ServiceSpec {
+	return us.DnsSrv.ServiceSpec
+}
+
+func (us *Upstream_DnsSrv) SetServiceSpec(spec *plugins.ServiceSpec) {
+	us.DnsSrv.ServiceSpec = spec
+}
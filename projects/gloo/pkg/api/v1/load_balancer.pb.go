@@ -45,10 +45,19 @@ type LoadBalancerConfig struct {
 	//	*LoadBalancerConfig_Random_
 	//	*LoadBalancerConfig_RingHash_
 	//	*LoadBalancerConfig_Maglev_
-	Type                 isLoadBalancerConfig_Type `protobuf_oneof:"type"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	Type isLoadBalancerConfig_Type `protobuf_oneof:"type"`
+	// Types that are valid to be assigned to LocalityConfig:
+	//	*LoadBalancerConfig_ZoneAwareLbConfig_
+	//	*LoadBalancerConfig_LocalityWeightedLbConfig_
+	LocalityConfig isLoadBalancerConfig_LocalityConfig `protobuf_oneof:"locality_config"`
+	// Ramps up the share of traffic sent to newly discovered endpoints over this duration, so that they can warm up.
+	// The weight of a new endpoint starts at 10% of the weight of the other endpoints, and grows in 10 steps.
+	// Only applies to the endpoints of upstreams discovered through EDS (e.g. kubernetes or consul upstreams),
+	// and endpoints that existed when gloo started are considered warm.
+	SlowStartWindow      *time.Duration `protobuf:"bytes,10,opt,name=slow_start_window,json=slowStartWindow,proto3,stdduration" json:"slow_start_window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *LoadBalancerConfig) Reset()         { *m = LoadBalancerConfig{} }
@@ -79,6 +88,10 @@ type isLoadBalancerConfig_Type interface {
 	isLoadBalancerConfig_Type()
 	Equal(interface{}) bool
 }
+type isLoadBalancerConfig_LocalityConfig interface {
+	isLoadBalancerConfig_LocalityConfig()
+	Equal(interface{}) bool
+}
 
 type LoadBalancerConfig_RoundRobin_ struct {
 	RoundRobin *LoadBalancerConfig_RoundRobin `protobuf:"bytes,3,opt,name=round_robin,json=roundRobin,proto3,oneof" json:"round_robin,omitempty"`
@@ -95,12 +108,20 @@ type LoadBalancerConfig_RingHash_ struct {
 type LoadBalancerConfig_Maglev_ struct {
 	Maglev *LoadBalancerConfig_Maglev `protobuf:"bytes,7,opt,name=maglev,proto3,oneof" json:"maglev,omitempty"`
 }
+type LoadBalancerConfig_ZoneAwareLbConfig_ struct {
+	ZoneAwareLbConfig *LoadBalancerConfig_ZoneAwareLbConfig `protobuf:"bytes,8,opt,name=zone_aware_lb_config,json=zoneAwareLbConfig,proto3,oneof" json:"zone_aware_lb_config,omitempty"`
+}
+type LoadBalancerConfig_LocalityWeightedLbConfig_ struct {
+	LocalityWeightedLbConfig *LoadBalancerConfig_LocalityWeightedLbConfig `protobuf:"bytes,9,opt,name=locality_weighted_lb_config,json=localityWeightedLbConfig,proto3,oneof" json:"locality_weighted_lb_config,omitempty"`
+}
 
-func (*LoadBalancerConfig_RoundRobin_) isLoadBalancerConfig_Type()   {}
-func (*LoadBalancerConfig_LeastRequest_) isLoadBalancerConfig_Type() {}
-func (*LoadBalancerConfig_Random_) isLoadBalancerConfig_Type()       {}
-func (*LoadBalancerConfig_RingHash_) isLoadBalancerConfig_Type()     {}
-func (*LoadBalancerConfig_Maglev_) isLoadBalancerConfig_Type()       {}
+func (*LoadBalancerConfig_RoundRobin_) isLoadBalancerConfig_Type()                         {}
+func (*LoadBalancerConfig_LeastRequest_) isLoadBalancerConfig_Type()                       {}
+func (*LoadBalancerConfig_Random_) isLoadBalancerConfig_Type()                             {}
+func (*LoadBalancerConfig_RingHash_) isLoadBalancerConfig_Type()                           {}
+func (*LoadBalancerConfig_Maglev_) isLoadBalancerConfig_Type()                             {}
+func (*LoadBalancerConfig_ZoneAwareLbConfig_) isLoadBalancerConfig_LocalityConfig()        {}
+func (*LoadBalancerConfig_LocalityWeightedLbConfig_) isLoadBalancerConfig_LocalityConfig() {}
 
 func (m *LoadBalancerConfig) GetType() isLoadBalancerConfig_Type {
 	if m != nil {
@@ -108,6 +129,12 @@ func (m *LoadBalancerConfig) GetType() isLoadBalancerConfig_Type {
 	}
 	return nil
 }
+func (m *LoadBalancerConfig) GetLocalityConfig() isLoadBalancerConfig_LocalityConfig {
+	if m != nil {
+		return m.LocalityConfig
+	}
+	return nil
+}
 
 func (m *LoadBalancerConfig) GetHealthyPanicThreshold() *types.DoubleValue {
 	if m != nil {
@@ -158,6 +185,27 @@ func (m *LoadBalancerConfig) GetMaglev() *LoadBalancerConfig_Maglev {
 	return nil
 }
 
+func (m *LoadBalancerConfig) GetZoneAwareLbConfig() *LoadBalancerConfig_ZoneAwareLbConfig {
+	if x, ok := m.GetLocalityConfig().(*LoadBalancerConfig_ZoneAwareLbConfig_); ok {
+		return x.ZoneAwareLbConfig
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig) GetLocalityWeightedLbConfig() *LoadBalancerConfig_LocalityWeightedLbConfig {
+	if x, ok := m.GetLocalityConfig().(*LoadBalancerConfig_LocalityWeightedLbConfig_); ok {
+		return x.LocalityWeightedLbConfig
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig) GetSlowStartWindow() *time.Duration {
+	if m != nil {
+		return m.SlowStartWindow
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*LoadBalancerConfig) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -166,6 +214,8 @@ func (*LoadBalancerConfig) XXX_OneofWrappers() []interface{} {
 		(*LoadBalancerConfig_Random_)(nil),
 		(*LoadBalancerConfig_RingHash_)(nil),
 		(*LoadBalancerConfig_Maglev_)(nil),
+		(*LoadBalancerConfig_ZoneAwareLbConfig_)(nil),
+		(*LoadBalancerConfig_LocalityWeightedLbConfig_)(nil),
 	}
 }
 
@@ -389,6 +439,96 @@ func (m *LoadBalancerConfig_Maglev) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LoadBalancerConfig_Maglev proto.InternalMessageInfo
 
+// Routes requests to the endpoints in the same zone as the envoy instance, unless there are not enough
+// healthy endpoints in that zone to handle its share of the traffic.
+// Requires envoy to be started with its zone (`--service-zone`) and the name of its local cluster
+// (`--service-cluster`), which must also be the name of a cluster.
+// see more info [here](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/zone_aware).
+type LoadBalancerConfig_ZoneAwareLbConfig struct {
+	// Percentage of requests that are considered for zone aware routing. defaults to 100%.
+	RoutingEnabled *types.DoubleValue `protobuf:"bytes,1,opt,name=routing_enabled,json=routingEnabled,proto3" json:"routing_enabled,omitempty"`
+	// Zone aware routing is disabled when the local cluster has fewer hosts than this. defaults to 6.
+	MinClusterSize       *types.UInt64Value `protobuf:"bytes,2,opt,name=min_cluster_size,json=minClusterSize,proto3" json:"min_cluster_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) Reset()         { *m = LoadBalancerConfig_ZoneAwareLbConfig{} }
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) String() string { return proto.CompactTextString(m) }
+func (*LoadBalancerConfig_ZoneAwareLbConfig) ProtoMessage()    {}
+func (*LoadBalancerConfig_ZoneAwareLbConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 6}
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Unmarshal(m, b)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Marshal(b, m, deterministic)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Merge(m, src)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_Size() int {
+	return xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.Size(m)
+}
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalancerConfig_ZoneAwareLbConfig proto.InternalMessageInfo
+
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) GetRoutingEnabled() *types.DoubleValue {
+	if m != nil {
+		return m.RoutingEnabled
+	}
+	return nil
+}
+
+func (m *LoadBalancerConfig_ZoneAwareLbConfig) GetMinClusterSize() *types.UInt64Value {
+	if m != nil {
+		return m.MinClusterSize
+	}
+	return nil
+}
+
+// Spreads requests across localities by weight, then across the endpoints of the chosen locality.
+// Each locality is weighted by the number of its endpoints.
+// see more info [here](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/locality_weight).
+type LoadBalancerConfig_LocalityWeightedLbConfig struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) Reset() {
+	*m = LoadBalancerConfig_LocalityWeightedLbConfig{}
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) String() string {
+	return proto.CompactTextString(m)
+}
+func (*LoadBalancerConfig_LocalityWeightedLbConfig) ProtoMessage() {}
+func (*LoadBalancerConfig_LocalityWeightedLbConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaa1c019b03e4b0f, []int{0, 7}
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig.Unmarshal(m, b)
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig.Marshal(b, m, deterministic)
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig.Merge(m, src)
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) XXX_Size() int {
+	return xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig.Size(m)
+}
+func (m *LoadBalancerConfig_LocalityWeightedLbConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalancerConfig_LocalityWeightedLbConfig proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*LoadBalancerConfig)(nil), "gloo.solo.io.LoadBalancerConfig")
 	proto.RegisterType((*LoadBalancerConfig_RoundRobin)(nil), "gloo.solo.io.LoadBalancerConfig.RoundRobin")
@@ -397,6 +537,8 @@ func init() {
 	proto.RegisterType((*LoadBalancerConfig_RingHashConfig)(nil), "gloo.solo.io.LoadBalancerConfig.RingHashConfig")
 	proto.RegisterType((*LoadBalancerConfig_RingHash)(nil), "gloo.solo.io.LoadBalancerConfig.RingHash")
 	proto.RegisterType((*LoadBalancerConfig_Maglev)(nil), "gloo.solo.io.LoadBalancerConfig.Maglev")
+	proto.RegisterType((*LoadBalancerConfig_ZoneAwareLbConfig)(nil), "gloo.solo.io.LoadBalancerConfig.ZoneAwareLbConfig")
+	proto.RegisterType((*LoadBalancerConfig_LocalityWeightedLbConfig)(nil), "gloo.solo.io.LoadBalancerConfig.LocalityWeightedLbConfig")
 }
 
 func init() {
@@ -404,43 +546,54 @@ func init() {
 }
 
 var fileDescriptor_aaa1c019b03e4b0f = []byte{
-	// 570 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcb, 0x6e, 0xd4, 0x3c,
-	0x14, 0xc7, 0x67, 0xfa, 0xcd, 0x17, 0x8a, 0x3b, 0x2d, 0x34, 0x80, 0x08, 0x11, 0x2a, 0x97, 0x0d,
-	0x37, 0x35, 0xa1, 0x20, 0x36, 0xac, 0x60, 0xca, 0x22, 0x8b, 0x16, 0x90, 0xa9, 0x40, 0xb0, 0x89,
-	0x9c, 0xc4, 0x75, 0x0c, 0x8e, 0x4f, 0x70, 0x9c, 0xde, 0x9e, 0x84, 0x47, 0xe0, 0x11, 0x78, 0x9b,
-	0x4a, 0xbc, 0x03, 0x7b, 0xe4, 0xcb, 0x94, 0x42, 0x55, 0xcd, 0xac, 0xc6, 0xc7, 0xe7, 0xfc, 0xfe,
-	0xe7, 0x32, 0x27, 0x46, 0x2f, 0x18, 0xd7, 0x75, 0x5f, 0x24, 0x25, 0x34, 0x69, 0x07, 0x02, 0xd6,
-	0x39, 0xa4, 0x4c, 0x00, 0xa4, 0xad, 0x82, 0xcf, 0xb4, 0xd4, 0x9d, 0xb3, 0x48, 0xcb, 0xd3, 0xbd,
-	0x8d, 0x54, 0x00, 0xa9, 0xf2, 0x82, 0x08, 0x22, 0x4b, 0xaa, 0x92, 0x56, 0x81, 0x86, 0x70, 0x6c,
-	0x02, 0x12, 0xc3, 0x26, 0x1c, 0xe2, 0x67, 0xe7, 0xc3, 0xd0, 0x6a, 0x0e, 0xb2, 0x4b, 0x45, 0x51,
-	0x93, 0xae, 0xf6, 0x3f, 0x4e, 0x24, 0xbe, 0xca, 0x80, 0x81, 0x3d, 0xa6, 0xe6, 0xe4, 0x6f, 0xd7,
-	0x18, 0x00, 0x13, 0x34, 0xb5, 0x56, 0xd1, 0xef, 0xa6, 0x55, 0xaf, 0x88, 0x11, 0x39, 0xcf, 0xbf,
-	0xaf, 0x48, 0xdb, 0x52, 0xd5, 0x79, 0x7f, 0x48, 0x0f, 0xb4, 0x13, 0xa5, 0x07, 0xda, 0xdd, 0xdd,
-	0x3d, 0x0e, 0x50, 0xb8, 0x05, 0xa4, 0x9a, 0xf8, 0x2e, 0x36, 0x41, 0xee, 0x72, 0x16, 0xee, 0xa0,
-	0xeb, 0x35, 0x25, 0x42, 0xd7, 0x87, 0x79, 0x4b, 0x24, 0x2f, 0x73, 0x5d, 0x2b, 0xda, 0xd5, 0x20,
-	0xaa, 0x68, 0x78, 0x7b, 0x78, 0x7f, 0xe9, 0xc9, 0xcd, 0xc4, 0x25, 0x4b, 0xa6, 0xc9, 0x92, 0x57,
-	0xd0, 0x17, 0x82, 0xbe, 0x27, 0xa2, 0xa7, 0xf8, 0x9a, 0x87, 0xdf, 0x1a, 0x76, 0x67, 0x8a, 0x86,
-	0x6f, 0xd0, 0x95, 0xbe, 0xad, 0x88, 0xa6, 0x79, 0x43, 0x15, 0xa3, 0xf9, 0x3e, 0x97, 0x15, 0xec,
-	0x47, 0x0b, 0x56, 0xf1, 0xc6, 0x59, 0x45, 0xdf, 0xde, 0x64, 0xf4, 0xed, 0xf8, 0xd6, 0x10, 0xaf,
-	0x3a, 0x76, 0xdb, 0xa0, 0x1f, 0x2c, 0x19, 0xbe, 0x46, 0x4b, 0x0a, 0x7a, 0x59, 0xe5, 0x0a, 0x0a,
-	0x2e, 0xa3, 0xff, 0xac, 0xd0, 0xa3, 0xe4, 0xf4, 0x5f, 0x90, 0x9c, 0xed, 0x2e, 0xc1, 0x86, 0xc1,
-	0x06, 0xc9, 0x06, 0x18, 0xa9, 0x13, 0x2b, 0xdc, 0x41, 0xcb, 0x82, 0x92, 0x4e, 0xe7, 0x8a, 0x7e,
-	0xed, 0x69, 0xa7, 0xa3, 0x91, 0x55, 0x5c, 0x9f, 0xa9, 0xb8, 0x65, 0x28, 0xec, 0xa0, 0x6c, 0x80,
-	0xc7, 0xe2, 0x94, 0x1d, 0xbe, 0x44, 0x81, 0x22, 0xb2, 0x82, 0x26, 0xfa, 0xdf, 0xca, 0xdd, 0x9b,
-	0x5d, 0xa0, 0x0d, 0xcf, 0x06, 0xd8, 0x83, 0x61, 0x86, 0x2e, 0x2a, 0x2e, 0x59, 0x6e, 0x76, 0x24,
-	0x0a, 0xac, 0xca, 0x83, 0xd9, 0x2a, 0x5c, 0xb2, 0x8c, 0x74, 0x75, 0x36, 0xc0, 0x8b, 0xca, 0x9f,
-	0x4d, 0x31, 0x0d, 0x61, 0x82, 0xee, 0x45, 0x17, 0xe6, 0x2c, 0x66, 0xdb, 0x86, 0x9b, 0x62, 0x1c,
-	0x18, 0x8f, 0x11, 0xfa, 0x33, 0xc1, 0x78, 0x03, 0x8d, 0x4f, 0x77, 0x1f, 0xde, 0x41, 0xe3, 0xb2,
-	0x06, 0x5e, 0xd2, 0xbc, 0x84, 0x5e, 0x6a, 0xbb, 0x2f, 0xcb, 0x78, 0xc9, 0xdd, 0x6d, 0x9a, 0xab,
-	0x78, 0x11, 0x05, 0xae, 0xc3, 0xb8, 0x46, 0x2b, 0xd3, 0x2a, 0xfd, 0xe6, 0x3d, 0x44, 0xab, 0x0d,
-	0x97, 0xbc, 0xe9, 0x9b, 0xdc, 0x76, 0xdc, 0xf1, 0x23, 0x6a, 0x35, 0x46, 0xf8, 0x92, 0x77, 0x18,
-	0xe2, 0x1d, 0x3f, 0xa2, 0x36, 0x96, 0x1c, 0xfc, 0x13, 0xbb, 0xe0, 0x63, 0x9d, 0x63, 0x1a, 0x1b,
-	0x53, 0xb4, 0x38, 0xcd, 0x14, 0x7e, 0x44, 0x97, 0x4f, 0xa6, 0x99, 0x97, 0x36, 0xaf, 0x5f, 0xeb,
-	0x74, 0xee, 0xa1, 0x3a, 0x13, 0xaf, 0xa8, 0xbf, 0x6c, 0xd3, 0x9a, 0x9b, 0xd7, 0x24, 0x40, 0x23,
-	0x7d, 0xd8, 0xd2, 0xc9, 0xf3, 0x1f, 0xbf, 0x46, 0xc3, 0xef, 0x3f, 0xd7, 0x86, 0x9f, 0x1e, 0xcf,
-	0xf7, 0xb8, 0xb4, 0x5f, 0x98, 0x7f, 0x23, 0x8a, 0xc0, 0x7e, 0x0b, 0x4f, 0x7f, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x0a, 0xc7, 0x09, 0xc0, 0x97, 0x04, 0x00, 0x00,
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcd, 0x72, 0xeb, 0x34,
+	0x14, 0xc7, 0x93, 0x4b, 0x08, 0xb9, 0x6a, 0x6e, 0x73, 0x23, 0x2e, 0x83, 0x31, 0xcc, 0xe5, 0x63,
+	0xc3, 0xd7, 0x5c, 0x9b, 0x96, 0x8f, 0x19, 0x58, 0xd1, 0x94, 0x32, 0x61, 0x68, 0x81, 0x71, 0x0b,
+	0x1d, 0xba, 0xd1, 0xc8, 0xb6, 0x6a, 0x0b, 0x64, 0x1d, 0x23, 0xcb, 0x4d, 0x9a, 0x27, 0x61, 0xcd,
+	0x8a, 0x47, 0xe0, 0x6d, 0x98, 0x81, 0x67, 0x60, 0xcf, 0xe8, 0x23, 0x6d, 0x68, 0xc9, 0x24, 0xab,
+	0xf8, 0x1c, 0x9d, 0xdf, 0xff, 0x1c, 0x49, 0xff, 0xd8, 0xe8, 0xf3, 0x82, 0xeb, 0xb2, 0x4d, 0xa3,
+	0x0c, 0xaa, 0xb8, 0x01, 0x01, 0xcf, 0x38, 0xc4, 0x85, 0x00, 0x88, 0x6b, 0x05, 0x3f, 0xb1, 0x4c,
+	0x37, 0x2e, 0xa2, 0x35, 0x8f, 0xaf, 0xf6, 0x62, 0x01, 0x34, 0x27, 0x29, 0x15, 0x54, 0x66, 0x4c,
+	0x45, 0xb5, 0x02, 0x0d, 0x78, 0x68, 0x0a, 0x22, 0xc3, 0x46, 0x1c, 0xc2, 0x8f, 0xd7, 0xc3, 0x50,
+	0x6b, 0x0e, 0xb2, 0x89, 0x45, 0x5a, 0xd2, 0xa6, 0xf4, 0x3f, 0x4e, 0x24, 0x7c, 0x52, 0x40, 0x01,
+	0xf6, 0x31, 0x36, 0x4f, 0x3e, 0xfb, 0xb4, 0x00, 0x28, 0x04, 0x8b, 0x6d, 0x94, 0xb6, 0x97, 0x71,
+	0xde, 0x2a, 0x6a, 0x44, 0xd6, 0xad, 0xcf, 0x14, 0xad, 0x6b, 0xa6, 0x1a, 0xbf, 0x8e, 0xd9, 0x5c,
+	0x3b, 0x51, 0x36, 0xd7, 0x2e, 0xf7, 0xd6, 0xdf, 0x08, 0xe1, 0x63, 0xa0, 0xf9, 0xc4, 0xef, 0xe2,
+	0x10, 0xe4, 0x25, 0x2f, 0xf0, 0x19, 0x7a, 0xb9, 0x64, 0x54, 0xe8, 0xf2, 0x9a, 0xd4, 0x54, 0xf2,
+	0x8c, 0xe8, 0x52, 0xb1, 0xa6, 0x04, 0x91, 0x07, 0xdd, 0x37, 0xba, 0xef, 0xec, 0xec, 0xbf, 0x16,
+	0xb9, 0x66, 0xd1, 0xb2, 0x59, 0xf4, 0x05, 0xb4, 0xa9, 0x60, 0x3f, 0x50, 0xd1, 0xb2, 0xe4, 0x25,
+	0x0f, 0x7f, 0x67, 0xd8, 0xb3, 0x25, 0x8a, 0xbf, 0x45, 0x2f, 0xb6, 0x75, 0x4e, 0x35, 0x23, 0x15,
+	0x53, 0x05, 0x23, 0x33, 0x2e, 0x73, 0x98, 0x05, 0x0f, 0xac, 0xe2, 0x2b, 0xf7, 0x15, 0xfd, 0xf6,
+	0x26, 0xbd, 0x5f, 0xff, 0x7c, 0xbd, 0x9b, 0x8c, 0x1d, 0x7b, 0x62, 0xd0, 0x73, 0x4b, 0xe2, 0x6f,
+	0xd0, 0x8e, 0x82, 0x56, 0xe6, 0x44, 0x41, 0xca, 0x65, 0xf0, 0x9c, 0x15, 0x7a, 0x3f, 0x5a, 0xbd,
+	0x82, 0xe8, 0xfe, 0xee, 0xa2, 0xc4, 0x30, 0x89, 0x41, 0xa6, 0x9d, 0x04, 0xa9, 0x9b, 0x08, 0x9f,
+	0xa1, 0x47, 0x82, 0xd1, 0x46, 0x13, 0xc5, 0x7e, 0x69, 0x59, 0xa3, 0x83, 0x9e, 0x55, 0x7c, 0xb6,
+	0x51, 0xf1, 0xd8, 0x50, 0x89, 0x83, 0xa6, 0x9d, 0x64, 0x28, 0x56, 0x62, 0x7c, 0x80, 0xfa, 0x8a,
+	0xca, 0x1c, 0xaa, 0xe0, 0x79, 0x2b, 0xf7, 0xf6, 0xe6, 0x01, 0x6d, 0xf9, 0xb4, 0x93, 0x78, 0x10,
+	0x4f, 0xd1, 0x43, 0xc5, 0x65, 0x41, 0x8c, 0x47, 0x82, 0xbe, 0x55, 0x79, 0x77, 0xb3, 0x0a, 0x97,
+	0xc5, 0x94, 0x36, 0xe5, 0xb4, 0x93, 0x0c, 0x94, 0x7f, 0x36, 0xc3, 0x54, 0xb4, 0x10, 0xec, 0x2a,
+	0x78, 0x61, 0xcb, 0x61, 0x4e, 0x6c, 0xb9, 0x19, 0xc6, 0x81, 0x98, 0xa1, 0x27, 0x0b, 0x90, 0x8c,
+	0xd0, 0x19, 0x55, 0x8c, 0x88, 0x94, 0x64, 0xb6, 0x30, 0x18, 0x58, 0xc1, 0xfd, 0x8d, 0x82, 0x17,
+	0x20, 0xd9, 0x81, 0x61, 0x8f, 0x53, 0x97, 0x99, 0x76, 0x93, 0xf1, 0xe2, 0x6e, 0x12, 0x2f, 0xd0,
+	0xab, 0x02, 0x32, 0x2a, 0xb8, 0xbe, 0x26, 0x33, 0xc6, 0x8b, 0x52, 0xb3, 0x7c, 0xa5, 0xdb, 0x43,
+	0xdb, 0xed, 0xd3, 0xcd, 0x57, 0xe3, 0x35, 0xce, 0xbd, 0xc4, 0x4a, 0xd3, 0x40, 0xac, 0x59, 0xc3,
+	0x5f, 0xa3, 0x71, 0x23, 0x60, 0x46, 0x1a, 0x4d, 0x95, 0x5e, 0xfa, 0x14, 0x6d, 0xe7, 0xd3, 0x91,
+	0x21, 0x4f, 0x0d, 0xe8, 0x5c, 0x1a, 0x0e, 0x11, 0xba, 0x75, 0x5c, 0xb8, 0x87, 0x86, 0xab, 0x6e,
+	0xc1, 0x6f, 0xa2, 0x61, 0x56, 0x02, 0xcf, 0x18, 0xc9, 0xa0, 0x95, 0xda, 0xfe, 0xbf, 0x1e, 0x25,
+	0x3b, 0x2e, 0x77, 0x68, 0x52, 0xe1, 0x00, 0xf5, 0x9d, 0x23, 0xc2, 0x12, 0xed, 0x2e, 0x6f, 0xd5,
+	0x4f, 0xfa, 0x1e, 0x1a, 0x57, 0x5c, 0xf2, 0xaa, 0xad, 0x88, 0x75, 0x48, 0xc3, 0x17, 0xcc, 0x6a,
+	0xf4, 0x92, 0x91, 0x5f, 0x30, 0xc4, 0x29, 0x5f, 0x30, 0x5b, 0x4b, 0xe7, 0x77, 0x6a, 0x1f, 0xf8,
+	0x5a, 0x3a, 0x5f, 0xad, 0x0d, 0x19, 0x1a, 0x2c, 0x3b, 0xe1, 0x1f, 0xd1, 0xe3, 0x1b, 0xf7, 0x2d,
+	0x8f, 0xdf, 0xbd, 0x06, 0xe2, 0xad, 0x4d, 0xe8, 0xc2, 0x64, 0x57, 0xfd, 0x27, 0x36, 0x5b, 0x73,
+	0xfe, 0x0a, 0x7f, 0xeb, 0xa2, 0xf1, 0x3d, 0x67, 0xe0, 0x23, 0x34, 0x52, 0xd0, 0x6a, 0xd3, 0x9d,
+	0x49, 0x9a, 0x0a, 0xb6, 0xdd, 0x0b, 0x68, 0xd7, 0x43, 0x47, 0x8e, 0xc1, 0x5f, 0xa2, 0xc7, 0x15,
+	0x97, 0x24, 0x13, 0x6d, 0xa3, 0x99, 0xba, 0xdd, 0xf8, 0xff, 0xe9, 0x7c, 0xff, 0x95, 0xd4, 0x9f,
+	0x7c, 0xe4, 0x75, 0x2a, 0x2e, 0x0f, 0x1d, 0x64, 0x4f, 0x25, 0x44, 0xc1, 0x3a, 0x3f, 0x4d, 0xfa,
+	0xa8, 0xa7, 0xaf, 0x6b, 0x36, 0x19, 0xa3, 0xd1, 0x8d, 0x6f, 0xdd, 0x61, 0x4d, 0x3e, 0xfb, 0xe3,
+	0x9f, 0x5e, 0xf7, 0xf7, 0xbf, 0x9e, 0x76, 0x2f, 0x3e, 0xd8, 0xee, 0x03, 0x53, 0xff, 0x5c, 0xf8,
+	0xef, 0x44, 0xda, 0xb7, 0x83, 0x7d, 0xf8, 0xef, 0x00, 0xbc, 0x8f, 0x8e, 0x36, 0x9b, 0x06, 0x00,
+	0x00,
 }
 
 func (this *LoadBalancerConfig) Equal(that interface{}) bool {
@@ -483,6 +636,24 @@ func (this *LoadBalancerConfig) Equal(that interface{}) bool {
 	} else if !this.Type.Equal(that1.Type) {
 		return false
 	}
+	if that1.LocalityConfig == nil {
+		if this.LocalityConfig != nil {
+			return false
+		}
+	} else if this.LocalityConfig == nil {
+		return false
+	} else if !this.LocalityConfig.Equal(that1.LocalityConfig) {
+		return false
+	}
+	if this.SlowStartWindow != nil && that1.SlowStartWindow != nil {
+		if *this.SlowStartWindow != *that1.SlowStartWindow {
+			return false
+		}
+	} else if this.SlowStartWindow != nil {
+		return false
+	} else if that1.SlowStartWindow != nil {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -608,6 +779,54 @@ func (this *LoadBalancerConfig_Maglev_) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LoadBalancerConfig_ZoneAwareLbConfig_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_ZoneAwareLbConfig_)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_ZoneAwareLbConfig_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ZoneAwareLbConfig.Equal(that1.ZoneAwareLbConfig) {
+		return false
+	}
+	return true
+}
+func (this *LoadBalancerConfig_LocalityWeightedLbConfig_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_LocalityWeightedLbConfig_)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_LocalityWeightedLbConfig_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LocalityWeightedLbConfig.Equal(that1.LocalityWeightedLbConfig) {
+		return false
+	}
+	return true
+}
 func (this *LoadBalancerConfig_RoundRobin) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -764,3 +983,57 @@ func (this *LoadBalancerConfig_Maglev) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LoadBalancerConfig_ZoneAwareLbConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_ZoneAwareLbConfig)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_ZoneAwareLbConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RoutingEnabled.Equal(that1.RoutingEnabled) {
+		return false
+	}
+	if !this.MinClusterSize.Equal(that1.MinClusterSize) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LoadBalancerConfig_LocalityWeightedLbConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadBalancerConfig_LocalityWeightedLbConfig)
+	if !ok {
+		that2, ok := that.(LoadBalancerConfig_LocalityWeightedLbConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
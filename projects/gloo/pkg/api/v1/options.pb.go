@@ -14,6 +14,7 @@ import (
 	types "github.com/gogo/protobuf/types"
 	v2 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/filter/http/gzip/v2"
 	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/buffer/v3"
+	v31 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/lua/v3"
 	proxylatency "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/proxylatency"
 	transformation "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
 	dlp "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/dlp"
@@ -26,6 +27,8 @@ import (
 	aws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
 	azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
 	cors "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	csrf "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/csrf"
+	dynamic_forward_proxy "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
 	faultinjection "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/faultinjection"
 	grpc "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc"
 	grpc_web "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_web"
@@ -33,11 +36,13 @@ import (
 	headers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 	healthcheck "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/healthcheck"
 	lbhash "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/lbhash"
+	local_ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
 	protocol_upgrade "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	rest "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
 	retries "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	shadowing "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
 	stats "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/stats"
+	tap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
 	tcp "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tcp"
 	tracing "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tracing"
 	wasm "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
@@ -166,10 +171,19 @@ type HttpListenerOptions struct {
 	// Buffer can be used to set the maximum request size
 	// that the filter will buffer before the connection
 	// manager will stop buffering and return a 413 response.
-	Buffer               *v3.Buffer `protobuf:"bytes,12,opt,name=buffer,proto3" json:"buffer,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Buffer *v3.Buffer `protobuf:"bytes,12,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	// Enables the dynamic forward proxy on this listener, for use by routes with a `dynamicForwardProxy` destination.
+	// Configures the DNS cache used to resolve the hosts requests are forwarded to.
+	DynamicForwardProxy *dynamic_forward_proxy.FilterConfig `protobuf:"bytes,13,opt,name=dynamic_forward_proxy,json=dynamicForwardProxy,proto3" json:"dynamic_forward_proxy,omitempty"`
+	// Lua runs the given Lua script for every request on this listener.
+	// Named scripts can be added under `sourceCodes`, to be selected by
+	// virtual hosts and routes with `luaPerRoute`.
+	Lua *v31.Lua `protobuf:"bytes,14,opt,name=lua,proto3" json:"lua,omitempty"`
+	// Tap captures full requests and responses on this listener, for debugging.
+	Tap                  *tap.Tap `protobuf:"bytes,15,opt,name=tap,proto3" json:"tap,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *HttpListenerOptions) Reset()         { *m = HttpListenerOptions{} }
@@ -280,6 +294,27 @@ func (m *HttpListenerOptions) GetBuffer() *v3.Buffer {
 	return nil
 }
 
+func (m *HttpListenerOptions) GetDynamicForwardProxy() *dynamic_forward_proxy.FilterConfig {
+	if m != nil {
+		return m.DynamicForwardProxy
+	}
+	return nil
+}
+
+func (m *HttpListenerOptions) GetLua() *v31.Lua {
+	if m != nil {
+		return m.Lua
+	}
+	return nil
+}
+
+func (m *HttpListenerOptions) GetTap() *tap.Tap {
+	if m != nil {
+		return m.Tap
+	}
+	return nil
+}
+
 // Optional, feature-specific configuration that lives on tcp listeners
 type TcpListenerOptions struct {
 	TcpProxySettings     *tcp.TcpProxySettings `protobuf:"bytes,3,opt,name=tcp_proxy_settings,json=tcpProxySettings,proto3" json:"tcp_proxy_settings,omitempty"`
@@ -366,10 +401,23 @@ type VirtualHostOptions struct {
 	// manager will stop buffering and return a 413 response.
 	// Note: If you have not set a global config (at the gateway level), this
 	// override will not do anything by itself.
-	BufferPerRoute       *v3.BufferPerRoute `protobuf:"bytes,14,opt,name=buffer_per_route,json=bufferPerRoute,proto3" json:"buffer_per_route,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	BufferPerRoute *v3.BufferPerRoute `protobuf:"bytes,14,opt,name=buffer_per_route,json=bufferPerRoute,proto3" json:"buffer_per_route,omitempty"`
+	// Rate limit requests on this virtual host using a token bucket enforced by Envoy itself.
+	// Unlike `ratelimit` and `ratelimitBasic`, this does not require a rate limit server.
+	LocalRatelimit *local_ratelimit.LocalRateLimit `protobuf:"bytes,15,opt,name=local_ratelimit,json=localRatelimit,proto3" json:"local_ratelimit,omitempty"`
+	// LuaPerRoute can be used to disable the Lua filter, or to run one of the
+	// named scripts from the listener's Lua config instead of its inline code,
+	// for all routes on this virtual host.
+	// Note: If you have not set a Lua config (at the gateway level), this
+	// override will not do anything by itself.
+	LuaPerRoute *v31.LuaPerRoute `protobuf:"bytes,16,opt,name=lua_per_route,json=luaPerRoute,proto3" json:"lua_per_route,omitempty"`
+	// Protect the routes on this virtual host from cross-site request forgery.
+	Csrf *csrf.CsrfPolicy `protobuf:"bytes,17,opt,name=csrf,proto3" json:"csrf,omitempty"`
+	// Access logging options for this virtual host. Access logs themselves are configured on the listener.
+	AccessLogging        *als.VirtualHostAccessLogging `protobuf:"bytes,18,opt,name=access_logging,json=accessLogging,proto3" json:"access_logging,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *VirtualHostOptions) Reset()         { *m = VirtualHostOptions{} }
@@ -494,6 +542,34 @@ func (m *VirtualHostOptions) GetBufferPerRoute() *v3.BufferPerRoute {
 	return nil
 }
 
+func (m *VirtualHostOptions) GetLocalRatelimit() *local_ratelimit.LocalRateLimit {
+	if m != nil {
+		return m.LocalRatelimit
+	}
+	return nil
+}
+
+func (m *VirtualHostOptions) GetLuaPerRoute() *v31.LuaPerRoute {
+	if m != nil {
+		return m.LuaPerRoute
+	}
+	return nil
+}
+
+func (m *VirtualHostOptions) GetCsrf() *csrf.CsrfPolicy {
+	if m != nil {
+		return m.Csrf
+	}
+	return nil
+}
+
+func (m *VirtualHostOptions) GetAccessLogging() *als.VirtualHostAccessLogging {
+	if m != nil {
+		return m.AccessLogging
+	}
+	return nil
+}
+
 // Optional, feature-specific configuration that lives on routes.
 // Each RouteOption object contains configuration for a specific feature.
 // Note to developers: new Route plugins must be added to this struct
@@ -566,10 +642,22 @@ type RouteOptions struct {
 	// manager will stop buffering and return a 413 response.
 	// Note: If you have not set a global config (at the gateway level), this
 	// override will not do anything by itself.
-	BufferPerRoute       *v3.BufferPerRoute `protobuf:"bytes,22,opt,name=buffer_per_route,json=bufferPerRoute,proto3" json:"buffer_per_route,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	BufferPerRoute *v3.BufferPerRoute `protobuf:"bytes,22,opt,name=buffer_per_route,json=bufferPerRoute,proto3" json:"buffer_per_route,omitempty"`
+	// Rate limit requests on this route using a token bucket enforced by Envoy itself.
+	// Unlike `ratelimit` and `ratelimitBasic`, this does not require a rate limit server.
+	// If set, this replaces any local rate limit defined on the route's virtual host.
+	LocalRatelimit *local_ratelimit.LocalRateLimit `protobuf:"bytes,23,opt,name=local_ratelimit,json=localRatelimit,proto3" json:"local_ratelimit,omitempty"`
+	// LuaPerRoute can be used to disable the Lua filter, or to run one of the
+	// named scripts from the listener's Lua config instead of its inline code.
+	// Note: If you have not set a Lua config (at the gateway level), this
+	// override will not do anything by itself.
+	LuaPerRoute *v31.LuaPerRoute `protobuf:"bytes,24,opt,name=lua_per_route,json=luaPerRoute,proto3" json:"lua_per_route,omitempty"`
+	// Protect this route from cross-site request forgery.
+	// If set, this replaces any csrf policy defined on the route's virtual host.
+	Csrf                 *csrf.CsrfPolicy `protobuf:"bytes,25,opt,name=csrf,proto3" json:"csrf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *RouteOptions) Reset()         { *m = RouteOptions{} }
@@ -772,6 +860,27 @@ func (m *RouteOptions) GetBufferPerRoute() *v3.BufferPerRoute {
 	return nil
 }
 
+func (m *RouteOptions) GetLocalRatelimit() *local_ratelimit.LocalRateLimit {
+	if m != nil {
+		return m.LocalRatelimit
+	}
+	return nil
+}
+
+func (m *RouteOptions) GetLuaPerRoute() *v31.LuaPerRoute {
+	if m != nil {
+		return m.LuaPerRoute
+	}
+	return nil
+}
+
+func (m *RouteOptions) GetCsrf() *csrf.CsrfPolicy {
+	if m != nil {
+		return m.Csrf
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*RouteOptions) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -990,119 +1099,132 @@ func init() {
 }
 
 var fileDescriptor_94dcee4f7557dfdc = []byte{
-	// 1792 bytes of a gzipped FileDescriptorProto
+	// 1996 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdd, 0x72, 0xdb, 0xc6,
-	0x15, 0x36, 0x4d, 0x5a, 0x94, 0x56, 0xb4, 0x24, 0xaf, 0xdc, 0x0c, 0xab, 0x49, 0x52, 0x45, 0x9d,
-	0x36, 0x8a, 0xdb, 0x2c, 0x1d, 0xaa, 0xad, 0x62, 0x39, 0x9d, 0x54, 0x52, 0x6c, 0xd3, 0x13, 0x65,
-	0xaa, 0x01, 0x65, 0xc7, 0x6d, 0x2f, 0x30, 0x4b, 0x70, 0x09, 0xac, 0x03, 0x61, 0x31, 0x8b, 0x05,
-	0x29, 0xf9, 0xaa, 0x57, 0x9d, 0x3e, 0x42, 0xfb, 0x06, 0xbd, 0xe9, 0x7d, 0x67, 0xfa, 0x10, 0x7d,
-	0x84, 0xce, 0xf4, 0x1d, 0x7a, 0xdf, 0xd9, 0x1f, 0xfc, 0x90, 0x04, 0x45, 0x50, 0x61, 0x2f, 0x00,
-	0xee, 0x02, 0xfb, 0x7d, 0xfb, 0x77, 0xce, 0x77, 0xce, 0x82, 0xe0, 0xc8, 0xa5, 0xc2, 0x8b, 0x7b,
-	0xc8, 0x61, 0x97, 0xad, 0x88, 0xf9, 0xec, 0x53, 0xca, 0x5a, 0xae, 0xcf, 0x58, 0x2b, 0xe4, 0xec,
-	0x2d, 0x71, 0x44, 0xa4, 0x6b, 0x38, 0xa4, 0xad, 0xe1, 0x67, 0x2d, 0x16, 0x0a, 0xca, 0x82, 0x08,
-	0x85, 0x9c, 0x09, 0x06, 0x1b, 0xf2, 0x15, 0x92, 0x28, 0x44, 0xd9, 0xce, 0xfb, 0x2e, 0x63, 0xae,
-	0x4f, 0x5a, 0xea, 0x5d, 0x2f, 0x1e, 0xb4, 0x22, 0xc1, 0x63, 0x47, 0xe8, 0xb6, 0x3b, 0x0f, 0x5d,
-	0xe6, 0x32, 0x55, 0x6c, 0xc9, 0x92, 0x79, 0x0a, 0xc9, 0x95, 0xd0, 0x0f, 0xc9, 0x55, 0xd2, 0xf2,
-	0xd1, 0xec, 0xee, 0xc9, 0x95, 0x20, 0x41, 0x94, 0x8d, 0x60, 0xe7, 0xb3, 0xb9, 0x43, 0x6d, 0x39,
-	0x8c, 0xeb, 0x5b, 0x79, 0x08, 0x27, 0x91, 0x50, 0xb7, 0xf2, 0x10, 0x97, 0x87, 0x8e, 0xba, 0x19,
-	0xc8, 0xfc, 0x35, 0x6c, 0x61, 0x5f, 0x5d, 0x06, 0xf0, 0xa4, 0x5c, 0x1f, 0xf6, 0x88, 0xf4, 0xd2,
-	0x42, 0xf9, 0xbe, 0x3c, 0xe7, 0x52, 0x5e, 0x06, 0xf0, 0xcb, 0xf9, 0x00, 0xbf, 0xe7, 0xe1, 0xc8,
-	0x33, 0x3f, 0x06, 0xf6, 0x74, 0x3e, 0x2c, 0xf2, 0x70, 0x9f, 0x8d, 0x68, 0xe0, 0x66, 0xa5, 0xf2,
-	0x83, 0x14, 0x4e, 0x28, 0x2f, 0x03, 0x38, 0x2c, 0x01, 0xe0, 0xd8, 0x91, 0x7d, 0x99, 0xdf, 0xf2,
-	0x40, 0x4e, 0x04, 0xa7, 0x24, 0xfd, 0x35, 0xc0, 0x83, 0x12, 0xf3, 0x13, 0x58, 0x98, 0xbb, 0x01,
-	0x7d, 0x31, 0x1f, 0x34, 0xc0, 0xb1, 0x2f, 0x68, 0x20, 0x1b, 0x50, 0x16, 0xe8, 0x6a, 0xf9, 0xb1,
-	0x7a, 0x04, 0xf7, 0x09, 0x4f, 0x7f, 0x17, 0xb0, 0xaf, 0x91, 0xba, 0xca, 0xdb, 0xf0, 0x08, 0x47,
-	0x97, 0xea, 0x56, 0x7e, 0x3d, 0xf0, 0xbb, 0x98, 0x13, 0x7d, 0x37, 0xa0, 0x2f, 0x4b, 0xcd, 0xc8,
-	0x17, 0x9e, 0xe3, 0x11, 0xe7, 0xbb, 0x7c, 0xd9, 0x10, 0xbc, 0x9c, 0x4f, 0xa0, 0x1a, 0x3a, 0xcc,
-	0xb7, 0xe3, 0xd0, 0xe5, 0xb8, 0x4f, 0xa6, 0x1e, 0x18, 0xaa, 0x8b, 0x19, 0x54, 0x52, 0x46, 0x78,
-	0x80, 0xfd, 0x16, 0x09, 0x86, 0xec, 0x3a, 0xa7, 0x2a, 0xd2, 0x92, 0x82, 0x68, 0xc0, 0xf8, 0x25,
-	0x56, 0x5b, 0x35, 0x5e, 0x35, 0xac, 0xe7, 0x0b, 0xb3, 0x86, 0x9c, 0x5d, 0x5d, 0xfb, 0x58, 0x90,
-	0xc0, 0xb9, 0x1e, 0xab, 0xdc, 0x7a, 0x9c, 0x03, 0xea, 0x0b, 0x65, 0x14, 0x42, 0x84, 0xad, 0x5e,
-	0x3c, 0x18, 0x10, 0xde, 0x1a, 0x1e, 0x98, 0x92, 0x61, 0xfd, 0xba, 0x1c, 0xab, 0xc3, 0x82, 0x01,
-	0x75, 0x0d, 0xa3, 0x26, 0x74, 0xdf, 0xd1, 0xb0, 0x35, 0x6c, 0xab, 0x5f, 0x43, 0xf6, 0xec, 0x06,
-	0x51, 0x0e, 0x04, 0xe1, 0x21, 0xa7, 0x11, 0x49, 0x37, 0x88, 0x5c, 0x09, 0x1c, 0x0b, 0xcf, 0x48,
-	0xb6, 0x2c, 0x1a, 0x9a, 0xa3, 0x85, 0x68, 0xde, 0x8e, 0x84, 0xbc, 0x0c, 0xf6, 0xf9, 0x42, 0x58,
-	0x8e, 0x05, 0xf1, 0xe9, 0x25, 0x15, 0x59, 0x69, 0xbe, 0xc7, 0x16, 0xf1, 0xf4, 0xb0, 0xa3, 0x6e,
-	0xb7, 0x9a, 0xc1, 0x08, 0x0f, 0xe4, 0x75, 0x2b, 0x6c, 0xdf, 0x0f, 0xe5, 0x65, 0xb0, 0x1f, 0x4e,
-	0x46, 0xd7, 0x7e, 0xcc, 0xf3, 0x56, 0x39, 0xf5, 0x7e, 0xc4, 0x71, 0x18, 0xa6, 0x82, 0xb1, 0xf7,
-	0xd7, 0xbb, 0x60, 0xf3, 0x8c, 0x46, 0x82, 0x04, 0x84, 0xff, 0x56, 0xf7, 0x00, 0xfb, 0xe0, 0x3d,
-	0xec, 0x38, 0x24, 0x8a, 0x6c, 0x9f, 0xb9, 0x2e, 0x0d, 0x5c, 0x3b, 0x22, 0x7c, 0x48, 0x1d, 0xd2,
-	0xac, 0xec, 0x56, 0xf6, 0xd7, 0xdb, 0x08, 0xc9, 0xf8, 0x94, 0xc4, 0xfc, 0x7c, 0xb0, 0x47, 0xc7,
-	0x0a, 0x77, 0xa6, 0x61, 0x5d, 0x8d, 0xb2, 0x1e, 0xe2, 0x82, 0xa7, 0xf0, 0x73, 0x00, 0x32, 0xcb,
-	0x6d, 0xde, 0x55, 0xcc, 0xcd, 0x71, 0xb6, 0x67, 0xe9, 0x7b, 0x2b, 0xd7, 0x16, 0x0e, 0xc0, 0x47,
-	0x21, 0xe1, 0xb6, 0xc3, 0x82, 0x40, 0x6b, 0xa7, 0xad, 0x0d, 0xdc, 0x56, 0xdb, 0x69, 0xf7, 0xae,
-	0x05, 0x89, 0x9a, 0x55, 0x45, 0xf8, 0x3e, 0xd2, 0xf3, 0x47, 0xc9, 0xfc, 0xd1, 0xab, 0x97, 0x81,
-	0x38, 0x68, 0xbf, 0xc6, 0x7e, 0x4c, 0xac, 0x0f, 0x42, 0xc2, 0x4f, 0x53, 0x96, 0x13, 0x45, 0x72,
-	0x26, 0x39, 0x4e, 0x24, 0xc5, 0xde, 0x9f, 0xeb, 0x60, 0xbb, 0x23, 0x44, 0x38, 0xb9, 0x3e, 0xc7,
-	0x60, 0x35, 0x09, 0xb5, 0x66, 0x45, 0x7e, 0x8a, 0xd2, 0xd8, 0x5b, 0xb8, 0x2c, 0x2f, 0x78, 0xe8,
-	0x7c, 0x4b, 0x7a, 0x56, 0xdd, 0xd5, 0x05, 0xf8, 0xc7, 0x0a, 0xd8, 0x95, 0x3e, 0x95, 0x9f, 0xc4,
-	0x25, 0x0e, 0xb0, 0x4b, 0xb8, 0x1d, 0x11, 0x21, 0x68, 0xe0, 0x26, 0x6b, 0x72, 0x88, 0x64, 0x84,
-	0x2e, 0xa4, 0x95, 0x83, 0xcb, 0xc6, 0xff, 0x8d, 0xc6, 0x77, 0x0d, 0xdc, 0xfa, 0xc0, 0xbb, 0xe9,
-	0x35, 0x3c, 0x07, 0x0d, 0xad, 0xb2, 0xb6, 0x92, 0xd9, 0x66, 0x4d, 0xf5, 0xf6, 0x29, 0xca, 0x4b,
-	0x6f, 0x71, 0xaf, 0xaa, 0xc1, 0xa9, 0x6c, 0x60, 0xad, 0x7b, 0x59, 0x65, 0x62, 0x47, 0xab, 0x0b,
-	0xec, 0xe8, 0x2f, 0x40, 0x75, 0x84, 0x07, 0xcd, 0x7b, 0x0a, 0xb2, 0x87, 0xa4, 0x6b, 0x14, 0x76,
-	0x9d, 0xce, 0x4d, 0x36, 0x87, 0x9f, 0x83, 0x6a, 0xdf, 0x0f, 0x9b, 0x2b, 0x66, 0x0b, 0xa4, 0x53,
-	0x14, 0xa2, 0x9e, 0x2b, 0x0d, 0x3b, 0x55, 0x82, 0x66, 0x49, 0x08, 0x7c, 0x0a, 0x6a, 0x32, 0xa0,
-	0x35, 0xeb, 0x0a, 0xfa, 0x31, 0x52, 0xd1, 0xad, 0x10, 0x7b, 0xee, 0xc7, 0x2e, 0x0d, 0xba, 0x2c,
-	0xe6, 0x0e, 0xb1, 0x14, 0x08, 0x3e, 0x05, 0x75, 0xa3, 0x5e, 0x4d, 0xa0, 0xf0, 0x1f, 0xa1, 0xcc,
-	0x4d, 0x67, 0x8c, 0x37, 0x41, 0xc0, 0x2e, 0xd8, 0x4a, 0x85, 0x47, 0xb9, 0x15, 0xe1, 0xcd, 0x75,
-	0xc5, 0xb2, 0x8f, 0x32, 0x45, 0xba, 0x79, 0xf2, 0x9b, 0x69, 0xc3, 0xae, 0x22, 0x80, 0x47, 0xa0,
-	0x26, 0x35, 0xb9, 0xb9, 0x6a, 0x56, 0x42, 0x29, 0x38, 0xd2, 0x0a, 0x8e, 0xb4, 0x82, 0x23, 0x69,
-	0x0c, 0x48, 0x29, 0xf7, 0xb0, 0x8d, 0x5e, 0xbc, 0xa3, 0xa1, 0xa5, 0x30, 0xf0, 0x0f, 0xe0, 0xbe,
-	0x0a, 0x3d, 0xb6, 0x89, 0x3d, 0xcd, 0x35, 0x45, 0xf2, 0xab, 0xd9, 0x24, 0x63, 0x91, 0x6a, 0xd8,
-	0x46, 0xe7, 0xb2, 0x7e, 0xa6, 0xeb, 0x56, 0x23, 0xcc, 0xd5, 0xe0, 0x0b, 0xb0, 0xa2, 0x5d, 0xb3,
-	0xd9, 0x50, 0xac, 0x2d, 0xc3, 0x9a, 0x4b, 0xd8, 0x4d, 0xc8, 0xd2, 0xd4, 0x26, 0x50, 0x0d, 0x0f,
-	0x90, 0x76, 0x46, 0xcb, 0xc0, 0xf7, 0x02, 0x00, 0x2f, 0x9c, 0x29, 0x47, 0x7c, 0x03, 0xa0, 0x70,
-	0x42, 0x5b, 0x8f, 0x3f, 0x75, 0x1b, 0x6d, 0x78, 0x8f, 0x90, 0xcc, 0x19, 0x0b, 0x17, 0xf2, 0xc2,
-	0x09, 0xd5, 0x98, 0xd3, 0x05, 0xdd, 0x12, 0x13, 0x4f, 0xf6, 0xfe, 0xb4, 0x0a, 0xe0, 0x6b, 0xca,
-	0x45, 0x8c, 0xfd, 0x0e, 0x8b, 0x44, 0xd2, 0xe1, 0xb8, 0x85, 0x57, 0x16, 0xb0, 0xf0, 0x53, 0x50,
-	0x37, 0x59, 0xa5, 0xb1, 0xf2, 0x4f, 0x50, 0x92, 0x65, 0x16, 0x8e, 0xd1, 0x22, 0x82, 0x5f, 0x9f,
-	0x33, 0x9f, 0x3a, 0xd7, 0x56, 0x82, 0x84, 0x87, 0xe0, 0x9e, 0xca, 0x31, 0x53, 0xbb, 0xd3, 0x19,
-	0x67, 0xb1, 0xb5, 0xc8, 0x57, 0x96, 0x6e, 0x0f, 0x31, 0xd8, 0xd6, 0x79, 0xa2, 0x14, 0x19, 0x1a,
-	0xc6, 0xbe, 0x0a, 0x11, 0x46, 0x60, 0x1e, 0xa3, 0x24, 0x87, 0x9c, 0xe5, 0xee, 0x7d, 0xc2, 0xbf,
-	0xc9, 0xe1, 0x2c, 0xe8, 0x4d, 0x3d, 0x83, 0x4f, 0x40, 0x4d, 0x9e, 0xa6, 0xcc, 0xea, 0xff, 0x04,
-	0xa9, 0xa3, 0x55, 0x21, 0xe1, 0x29, 0xe3, 0x91, 0x99, 0x99, 0x82, 0xc0, 0x37, 0x60, 0x73, 0x3c,
-	0xa3, 0x8a, 0x8c, 0x18, 0x21, 0x63, 0x2e, 0x38, 0xa4, 0xd2, 0xd0, 0xf2, 0x46, 0x68, 0xb1, 0x58,
-	0x90, 0x8b, 0x71, 0x94, 0x35, 0x49, 0x03, 0x7f, 0x07, 0x32, 0x5f, 0xb1, 0x7b, 0x38, 0xa2, 0x8e,
-	0x51, 0x8b, 0xc7, 0xf3, 0x9c, 0xed, 0x65, 0xe0, 0x72, 0x12, 0x45, 0x16, 0x16, 0x44, 0x45, 0x04,
-	0x6b, 0x23, 0x05, 0x9c, 0x48, 0x1e, 0xf8, 0x0a, 0xac, 0xa5, 0x4f, 0x8c, 0x8e, 0x1c, 0xce, 0x23,
-	0x4d, 0xd9, 0x5e, 0x7b, 0x2c, 0x12, 0xa9, 0xa5, 0x58, 0x19, 0x53, 0xa2, 0x84, 0xab, 0x8b, 0x29,
-	0xe1, 0x11, 0xa8, 0xbe, 0x1d, 0x09, 0xe3, 0xba, 0xfb, 0x48, 0x26, 0x47, 0x85, 0xa8, 0x89, 0x7e,
-	0x25, 0x08, 0xfe, 0x06, 0xd4, 0x64, 0x1e, 0x63, 0x54, 0xe8, 0xe7, 0x48, 0x25, 0x35, 0x85, 0xe8,
-	0x14, 0x98, 0x76, 0xae, 0x90, 0xd2, 0xb6, 0x13, 0x41, 0x6c, 0x18, 0xdb, 0x9e, 0x25, 0x88, 0xcf,
-	0xae, 0xc4, 0x71, 0x2c, 0xbc, 0x6c, 0x08, 0xa9, 0x30, 0xb6, 0xb5, 0x98, 0xdf, 0x57, 0x04, 0xbb,
-	0xb3, 0xc5, 0x3c, 0x2f, 0xe3, 0x18, 0x6c, 0x99, 0xc8, 0x2f, 0xf3, 0x01, 0x2e, 0x4d, 0xa2, 0xb9,
-	0x61, 0xb6, 0x62, 0x31, 0xa1, 0x39, 0x27, 0x5c, 0x59, 0x94, 0xb5, 0xd1, 0x1b, 0xab, 0xef, 0xfd,
-	0xab, 0x01, 0x1a, 0xaa, 0x94, 0x69, 0xce, 0x94, 0xb1, 0x56, 0x96, 0x63, 0xac, 0x5f, 0x82, 0x15,
-	0x75, 0x06, 0x4c, 0x02, 0xff, 0xc7, 0x48, 0x1f, 0x09, 0x8b, 0x4d, 0x49, 0x52, 0x3e, 0x57, 0xcd,
-	0x2d, 0x03, 0x83, 0xa7, 0x60, 0x23, 0xe4, 0x64, 0x40, 0xaf, 0x6c, 0x4e, 0x46, 0x9c, 0x0a, 0x32,
-	0x33, 0x09, 0xea, 0x0a, 0x4e, 0x03, 0x57, 0x27, 0x41, 0xf7, 0x35, 0xc6, 0xd2, 0x10, 0xf8, 0x04,
-	0xd4, 0x05, 0xbd, 0x24, 0x2c, 0x16, 0xc6, 0x09, 0x7f, 0x38, 0x85, 0xfe, 0xca, 0xa4, 0x98, 0x27,
-	0xb5, 0xbf, 0xfc, 0xfb, 0x47, 0x15, 0x2b, 0x69, 0xbf, 0x1c, 0x8d, 0x1b, 0x97, 0xd8, 0x95, 0x05,
-	0x24, 0xf6, 0x0c, 0xd4, 0xcd, 0x89, 0xdf, 0xf8, 0x63, 0x1b, 0x25, 0x5f, 0x00, 0x66, 0x2f, 0xe1,
-	0x85, 0x6e, 0x91, 0x05, 0x6a, 0x03, 0x81, 0x67, 0x60, 0x2d, 0xfd, 0x56, 0x61, 0xdc, 0x11, 0xa1,
-	0xec, 0xeb, 0xc5, 0x6c, 0xc6, 0x6e, 0xd2, 0xc6, 0xca, 0x08, 0x66, 0x09, 0xf0, 0xda, 0x12, 0x05,
-	0xf8, 0xc7, 0xa0, 0x21, 0xbd, 0x3b, 0xdd, 0x7b, 0x19, 0x23, 0xd6, 0x3a, 0x77, 0xac, 0x75, 0xf9,
-	0x34, 0xd9, 0xdd, 0x0e, 0x78, 0x80, 0x63, 0xc1, 0xec, 0xb1, 0x96, 0xdb, 0x6a, 0x14, 0x3b, 0x53,
-	0xfb, 0x7c, 0xc2, 0x98, 0xaf, 0x6c, 0xa4, 0x73, 0xc7, 0xda, 0x94, 0xb0, 0x4e, 0x8e, 0x29, 0xd1,
-	0xfb, 0xf5, 0xc5, 0xf5, 0xfe, 0x6b, 0x50, 0xf7, 0x7b, 0xb6, 0x87, 0xa3, 0x44, 0x2f, 0xda, 0xc8,
-	0x7c, 0x50, 0x9a, 0xbd, 0xaa, 0xc7, 0x2a, 0x87, 0xed, 0xe0, 0xc8, 0x33, 0x02, 0xb0, 0xe2, 0xf7,
-	0x64, 0x0d, 0xbe, 0x01, 0xab, 0xe6, 0x74, 0x1f, 0x35, 0x7f, 0xb0, 0x5b, 0xdd, 0x5f, 0x6f, 0x7f,
-	0x81, 0xa6, 0xce, 0xfd, 0xc5, 0xa9, 0x9d, 0x69, 0xf5, 0x4a, 0x37, 0x32, 0xbc, 0x29, 0x5b, 0x51,
-	0xf0, 0xb8, 0xff, 0xff, 0x08, 0x1e, 0x1b, 0x0b, 0x06, 0x0f, 0xb5, 0x1e, 0x37, 0x05, 0x8f, 0xcd,
-	0x5b, 0x05, 0x8f, 0xad, 0x79, 0xc1, 0x63, 0xa2, 0xdf, 0xb1, 0xe0, 0xf1, 0x60, 0x19, 0xc1, 0x03,
-	0x7e, 0xdf, 0xe0, 0xf1, 0xf0, 0xfb, 0x06, 0x8f, 0xf7, 0x96, 0x1a, 0x3c, 0x4e, 0xb6, 0xc1, 0x83,
-	0xbc, 0xa3, 0xd9, 0xe2, 0x3a, 0x24, 0x7b, 0x7f, 0xbf, 0x0b, 0x36, 0xbf, 0x22, 0x91, 0xa0, 0x81,
-	0xf2, 0xdb, 0x6e, 0x48, 0x1c, 0xf8, 0x6b, 0x50, 0xc5, 0xa3, 0x24, 0x90, 0x7c, 0x82, 0xf0, 0x68,
-	0x86, 0x2b, 0x4d, 0xe0, 0x3a, 0x77, 0x2c, 0x89, 0x83, 0xa7, 0xe0, 0x9e, 0xfa, 0xd6, 0x66, 0x02,
-	0xc7, 0xcf, 0x90, 0xfe, 0xf2, 0x56, 0x92, 0x42, 0x63, 0xd5, 0x56, 0x92, 0x48, 0xa4, 0xe9, 0xb3,
-	0xfa, 0xd0, 0x5d, 0x92, 0x42, 0x21, 0x25, 0x83, 0x3c, 0xdf, 0x9a, 0xb8, 0xf1, 0x48, 0x9d, 0x89,
-	0x4b, 0x33, 0xc8, 0xc6, 0x27, 0x10, 0x6c, 0xf5, 0xb3, 0x57, 0x7a, 0xbd, 0xfe, 0x59, 0x05, 0x3b,
-	0xdf, 0x12, 0xea, 0x7a, 0x82, 0xf4, 0x73, 0xb8, 0x24, 0x1e, 0xcf, 0x50, 0xd6, 0xca, 0x12, 0x95,
-	0xb5, 0x20, 0xe4, 0xdf, 0x5d, 0x4e, 0xc8, 0xbf, 0xfd, 0x89, 0x39, 0xe7, 0x36, 0xb5, 0x5b, 0xbb,
-	0x4d, 0x91, 0x0b, 0xdc, 0x5b, 0xae, 0x0b, 0x1c, 0xfd, 0xe3, 0xbf, 0xb5, 0xca, 0xdf, 0xfe, 0xf3,
-	0x61, 0xe5, 0xf7, 0x8f, 0xcb, 0xfd, 0xa3, 0x14, 0x7e, 0xe7, 0x9a, 0x8f, 0x5f, 0xbd, 0x15, 0xa5,
-	0xe3, 0x07, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x21, 0xe6, 0x7c, 0x8c, 0x1a, 0x00, 0x00,
+	0x19, 0x35, 0x4d, 0x59, 0x3f, 0xab, 0x5f, 0xaf, 0x9c, 0x14, 0xd1, 0xc4, 0xa9, 0xa3, 0x4e, 0x1b,
+	0xdb, 0x6d, 0x96, 0x36, 0x95, 0x56, 0xb1, 0xec, 0x4e, 0x6a, 0x29, 0xb6, 0xe5, 0x89, 0xd2, 0x6a,
+	0x40, 0xd9, 0x71, 0xd2, 0xe9, 0x60, 0x96, 0xe0, 0x12, 0x84, 0x03, 0x61, 0x31, 0x8b, 0x05, 0x29,
+	0xf9, 0xaa, 0x8f, 0xd1, 0xbe, 0x41, 0x6f, 0x7a, 0xdf, 0x99, 0xde, 0xf4, 0x19, 0xfc, 0x02, 0x9d,
+	0xe9, 0x3b, 0xf4, 0x3e, 0xb3, 0xbb, 0x1f, 0x40, 0x90, 0x02, 0x44, 0x50, 0x96, 0x2f, 0x00, 0x62,
+	0x17, 0x7b, 0xce, 0xfe, 0x7e, 0xe7, 0x2c, 0x96, 0x68, 0xc7, 0xf3, 0x65, 0x2f, 0x69, 0x13, 0x97,
+	0x1f, 0x37, 0x62, 0x1e, 0xf0, 0xcf, 0x7d, 0xde, 0xf0, 0x02, 0xce, 0x1b, 0x91, 0xe0, 0xaf, 0x99,
+	0x2b, 0x63, 0x93, 0xa2, 0x91, 0xdf, 0xe8, 0xdf, 0x6f, 0xf0, 0x48, 0xfa, 0x3c, 0x8c, 0x49, 0x24,
+	0xb8, 0xe4, 0x78, 0x49, 0xbd, 0x22, 0x0a, 0x45, 0x7c, 0xbe, 0xf1, 0xb1, 0xc7, 0xb9, 0x17, 0xb0,
+	0x86, 0x7e, 0xd7, 0x4e, 0xba, 0x8d, 0x58, 0x8a, 0xc4, 0x95, 0xa6, 0xec, 0xc6, 0x0d, 0x8f, 0x7b,
+	0x5c, 0x3f, 0x36, 0xd4, 0x13, 0xe4, 0x62, 0x76, 0x22, 0x4d, 0x26, 0x3b, 0x49, 0x4b, 0xde, 0x2d,
+	0xaf, 0x9e, 0x9d, 0x48, 0x16, 0xc6, 0xc3, 0x16, 0x6c, 0xdc, 0x9f, 0xd8, 0xd4, 0x86, 0xcb, 0x85,
+	0xb9, 0x4d, 0x01, 0x89, 0x45, 0x57, 0xdf, 0xaa, 0x43, 0x04, 0x8b, 0xa5, 0xbe, 0x55, 0x87, 0x78,
+	0x22, 0x72, 0xf5, 0x0d, 0x20, 0x93, 0x87, 0xbd, 0x41, 0x03, 0x7d, 0x01, 0xe0, 0x41, 0xb5, 0x3a,
+	0x9c, 0x01, 0x6b, 0x67, 0x0f, 0xd5, 0xeb, 0xea, 0xb9, 0xc7, 0xea, 0x02, 0xc0, 0x6f, 0x27, 0x03,
+	0x82, 0x76, 0x8f, 0xc6, 0x3d, 0xf8, 0x01, 0xd8, 0xc3, 0xc9, 0xb0, 0xb8, 0x47, 0x3b, 0x7c, 0xe0,
+	0x87, 0xde, 0xf0, 0xa9, 0x7a, 0x23, 0xa5, 0x1b, 0xa9, 0x0b, 0x00, 0xdb, 0x15, 0x00, 0x82, 0xba,
+	0xaa, 0x2e, 0xf8, 0xad, 0x0e, 0x14, 0x4c, 0x0a, 0x9f, 0x65, 0xbf, 0x00, 0xdc, 0xaa, 0xd0, 0x3f,
+	0x49, 0x25, 0xdc, 0x01, 0xf4, 0x68, 0x32, 0xa8, 0x4b, 0x93, 0x40, 0xfa, 0xa1, 0x2a, 0xe0, 0xf3,
+	0xd0, 0x24, 0xab, 0xb7, 0xb5, 0xc7, 0x68, 0x87, 0x89, 0xec, 0x77, 0x8a, 0xf5, 0x35, 0xd0, 0x57,
+	0xf5, 0x35, 0x3c, 0xa0, 0xf1, 0xb1, 0xbe, 0x55, 0x1f, 0x0f, 0xfa, 0x26, 0x11, 0xcc, 0xdc, 0x01,
+	0xf4, 0x55, 0xa5, 0x1e, 0x05, 0xb2, 0xe7, 0xf6, 0x98, 0xfb, 0x63, 0xfe, 0x19, 0x08, 0x9e, 0x4f,
+	0x26, 0xd0, 0x05, 0x5d, 0x1e, 0x38, 0x49, 0xe4, 0x09, 0xda, 0x61, 0x67, 0x32, 0x80, 0xea, 0x59,
+	0x85, 0x75, 0xce, 0x5d, 0x1a, 0x38, 0x82, 0x4a, 0x16, 0xf8, 0xc7, 0xbe, 0x1c, 0x4f, 0x03, 0x51,
+	0x6b, 0x32, 0x51, 0xe7, 0x34, 0xa4, 0xc7, 0xbe, 0xeb, 0x74, 0xb9, 0x18, 0x50, 0xd1, 0x71, 0x22,
+	0xc1, 0x4f, 0x4e, 0x8b, 0x73, 0xa7, 0x88, 0x08, 0x1a, 0xa9, 0x0b, 0x00, 0x47, 0x25, 0x00, 0x25,
+	0xa4, 0x22, 0xa4, 0x41, 0x83, 0x85, 0x7d, 0x7e, 0x9a, 0xd3, 0x55, 0x15, 0x18, 0x61, 0xdc, 0xe5,
+	0xe2, 0x98, 0xea, 0x95, 0x37, 0x9a, 0x04, 0xd6, 0xc3, 0xa9, 0x59, 0x75, 0x27, 0x02, 0x2a, 0x59,
+	0xe8, 0x9e, 0x8e, 0x24, 0x2e, 0xdc, 0xce, 0xae, 0x1f, 0x48, 0xbd, 0xc6, 0xa5, 0x8c, 0x1a, 0xed,
+	0xa4, 0xdb, 0x65, 0xa2, 0xd1, 0xdf, 0x82, 0x27, 0x60, 0xfd, 0xe3, 0xbb, 0xb1, 0x06, 0x09, 0x55,
+	0x94, 0x41, 0x42, 0x81, 0xef, 0x9b, 0x6a, 0x7c, 0x2e, 0x0f, 0xbb, 0xbe, 0x07, 0x5c, 0x86, 0xca,
+	0x7b, 0xe3, 0x47, 0x8d, 0x7e, 0x53, 0xff, 0x02, 0xd9, 0x93, 0x73, 0x6c, 0x2e, 0x94, 0x4c, 0x44,
+	0xc2, 0x8f, 0x59, 0x36, 0xad, 0xec, 0x44, 0xd2, 0x44, 0xf6, 0xc0, 0x04, 0xd5, 0x23, 0xd0, 0xec,
+	0x4c, 0x45, 0xf3, 0x7a, 0x20, 0xd5, 0x05, 0xd8, 0xa7, 0x53, 0x61, 0x87, 0x2b, 0x7e, 0x7c, 0xad,
+	0x3f, 0x9a, 0x8e, 0xa7, 0x4d, 0x5d, 0x7d, 0xbb, 0x50, 0x0f, 0x06, 0xb4, 0xab, 0xae, 0x0b, 0x61,
+	0x3b, 0x41, 0xa4, 0x2e, 0xc0, 0x7e, 0x32, 0xbe, 0x5f, 0xe9, 0x24, 0x22, 0xbf, 0xca, 0xcf, 0xbc,
+	0x1f, 0x08, 0x1a, 0x45, 0x99, 0x9e, 0x6e, 0xfe, 0xfd, 0x2a, 0x5a, 0x3d, 0xf0, 0x63, 0xc9, 0x42,
+	0x26, 0xfe, 0x64, 0x6a, 0xc0, 0x1d, 0xf4, 0x21, 0x75, 0x5d, 0x16, 0xc7, 0x4e, 0xc0, 0x3d, 0xcf,
+	0x0f, 0x3d, 0x27, 0x66, 0xa2, 0xef, 0xbb, 0xcc, 0xaa, 0xdd, 0xaa, 0xdd, 0x5e, 0x6c, 0x12, 0xa2,
+	0xec, 0x1b, 0xda, 0x43, 0xf2, 0xdb, 0x27, 0xf2, 0x58, 0xe3, 0x0e, 0x0c, 0xac, 0x65, 0x50, 0xf6,
+	0x0d, 0x5a, 0x90, 0x8b, 0xbf, 0x44, 0x68, 0xb8, 0x66, 0xad, 0xab, 0x9a, 0xd9, 0x1a, 0x65, 0x7b,
+	0x92, 0xbd, 0xb7, 0x73, 0x65, 0x71, 0x17, 0x7d, 0x1a, 0x31, 0xe1, 0xb8, 0x3c, 0x0c, 0x8d, 0xb5,
+	0x38, 0x26, 0x60, 0x1c, 0x3d, 0x9d, 0x4e, 0xfb, 0x54, 0xb2, 0xd8, 0xaa, 0x6b, 0xc2, 0x8f, 0x89,
+	0xe9, 0x3f, 0x49, 0xfb, 0x4f, 0x5e, 0x3c, 0x0f, 0xe5, 0x56, 0xf3, 0x25, 0x0d, 0x12, 0x66, 0xdf,
+	0x8c, 0x98, 0xd8, 0xcb, 0x58, 0x76, 0x35, 0xc9, 0x81, 0xe2, 0xd8, 0x55, 0x14, 0x9b, 0x6f, 0xe7,
+	0xd1, 0xfa, 0xbe, 0x94, 0xd1, 0xf8, 0xf8, 0x3c, 0x46, 0xf3, 0xe9, 0x4e, 0x04, 0x46, 0xe4, 0x57,
+	0x24, 0xcd, 0x28, 0x1e, 0x96, 0x67, 0x22, 0x72, 0xbf, 0x63, 0x6d, 0x7b, 0xce, 0x33, 0x0f, 0xf8,
+	0xaf, 0x35, 0x74, 0x4b, 0xc5, 0x54, 0xbe, 0x13, 0xc7, 0x34, 0xa4, 0x1e, 0x13, 0x4e, 0xcc, 0xa4,
+	0xf4, 0x43, 0x2f, 0x1d, 0x93, 0x6d, 0xa2, 0x36, 0x30, 0x85, 0xb4, 0xaa, 0x71, 0xc3, 0xf6, 0x7f,
+	0x6b, 0xf0, 0x2d, 0x80, 0xdb, 0x37, 0x7b, 0xe7, 0xbd, 0xc6, 0x87, 0x68, 0xc9, 0x98, 0x90, 0xa3,
+	0x5d, 0xc8, 0x9a, 0xd1, 0xb5, 0x7d, 0x4e, 0xf2, 0xce, 0x54, 0x5c, 0xab, 0x2e, 0xb0, 0xa7, 0x0a,
+	0xd8, 0x8b, 0xbd, 0x61, 0x62, 0x6c, 0x46, 0xeb, 0x53, 0xcc, 0xe8, 0x17, 0xa8, 0x3e, 0xa0, 0x5d,
+	0xeb, 0x9a, 0x86, 0x6c, 0x12, 0x15, 0x1a, 0x85, 0x55, 0x67, 0x7d, 0x53, 0xc5, 0xf1, 0x97, 0xa8,
+	0xde, 0x09, 0x22, 0x6b, 0x16, 0xa6, 0x40, 0x05, 0x45, 0x21, 0xea, 0xa9, 0xd6, 0xb0, 0x3d, 0x2d,
+	0x68, 0xb6, 0x82, 0xe0, 0x87, 0x68, 0x46, 0xf9, 0xbd, 0x35, 0xa7, 0xa1, 0x9f, 0x11, 0x95, 0x28,
+	0xc6, 0x1e, 0x06, 0x89, 0xe7, 0x87, 0x2d, 0x9e, 0x08, 0x97, 0xd9, 0x1a, 0x84, 0x1f, 0xa2, 0x39,
+	0x50, 0x2f, 0x0b, 0x69, 0xfc, 0xa7, 0x64, 0x18, 0xa6, 0x25, 0xed, 0x4d, 0x11, 0xb8, 0x85, 0xd6,
+	0x32, 0xe1, 0xd1, 0x61, 0xc5, 0x84, 0xb5, 0xa8, 0x59, 0x6e, 0x93, 0xec, 0xc5, 0x84, 0xce, 0xaf,
+	0x66, 0x05, 0x5b, 0x9a, 0x00, 0xef, 0xa0, 0x19, 0xa5, 0xc9, 0xd6, 0x3c, 0x8c, 0x84, 0x56, 0x70,
+	0x62, 0x14, 0x9c, 0x18, 0x05, 0x27, 0x6a, 0x31, 0x10, 0x55, 0x8a, 0xf4, 0x9b, 0xe4, 0xd9, 0x1b,
+	0x3f, 0xb2, 0x35, 0x06, 0xff, 0x19, 0x2d, 0x6b, 0x2b, 0x73, 0xc0, 0xcb, 0xac, 0x05, 0x4d, 0xf2,
+	0xbb, 0x72, 0x92, 0x11, 0xe7, 0xeb, 0x37, 0xc9, 0xa1, 0x4a, 0x1f, 0x98, 0xb4, 0xbd, 0x14, 0xe5,
+	0x52, 0xf8, 0x19, 0x9a, 0x35, 0xa1, 0x69, 0x2d, 0x69, 0xd6, 0x06, 0xb0, 0x0e, 0xa7, 0x1e, 0x98,
+	0x63, 0x43, 0x6d, 0x0a, 0x93, 0xfe, 0x16, 0x31, 0xc1, 0x68, 0x03, 0x1c, 0xff, 0x80, 0x3e, 0x28,
+	0xdc, 0x52, 0x58, 0xcb, 0xe9, 0xe4, 0x77, 0xab, 0x4c, 0xfe, 0x3a, 0x90, 0x3c, 0x35, 0x1c, 0xba,
+	0xe5, 0xf8, 0x21, 0xaa, 0x07, 0x09, 0xb5, 0x56, 0x34, 0xd3, 0x9d, 0x09, 0x2d, 0x54, 0x3e, 0xda,
+	0xdf, 0x22, 0x07, 0x09, 0xb5, 0x15, 0x0a, 0x37, 0x50, 0x5d, 0xd2, 0xc8, 0x5a, 0xd5, 0xe0, 0x9b,
+	0x44, 0xd2, 0x92, 0x66, 0x1c, 0xd1, 0xc8, 0x56, 0x25, 0x37, 0x43, 0x84, 0x8f, 0xdc, 0x33, 0x92,
+	0xf2, 0x0a, 0x61, 0xe9, 0x46, 0xa6, 0x4f, 0x43, 0x01, 0x30, 0x21, 0x74, 0x97, 0x48, 0xb7, 0x8c,
+	0xd5, 0x8d, 0x74, 0x1f, 0xb2, 0xa5, 0xb1, 0x26, 0xc7, 0x72, 0x36, 0xdf, 0x22, 0x84, 0x5f, 0xfa,
+	0x42, 0x26, 0x34, 0xd8, 0xe7, 0xb1, 0x4c, 0x2b, 0x1c, 0x8d, 0xd5, 0xda, 0x14, 0xb1, 0xba, 0x87,
+	0xe6, 0xe0, 0xf3, 0x01, 0xe2, 0xf5, 0x0e, 0x81, 0x74, 0x71, 0x1b, 0x6d, 0x26, 0xc5, 0xe9, 0x21,
+	0x0f, 0x7c, 0xf7, 0xd4, 0x4e, 0x91, 0x78, 0x1b, 0x5d, 0xd3, 0x1f, 0x13, 0x59, 0x04, 0xe9, 0x54,
+	0xc9, 0xba, 0x57, 0xaf, 0x6c, 0x53, 0x1e, 0x53, 0xb4, 0x6e, 0x3e, 0x08, 0x94, 0x5c, 0xfa, 0x51,
+	0x12, 0x68, 0xb3, 0x03, 0xa9, 0xbc, 0x47, 0xd2, 0x8f, 0x85, 0x32, 0xe1, 0xea, 0x30, 0xf1, 0x6d,
+	0x0e, 0x67, 0xe3, 0xde, 0x99, 0x3c, 0xfc, 0x00, 0xcd, 0xb8, 0x5c, 0xa4, 0xa3, 0xff, 0x4b, 0xe2,
+	0xf2, 0x32, 0xc2, 0x3d, 0x2e, 0x62, 0xe8, 0x99, 0x86, 0xe0, 0x57, 0x68, 0x75, 0x74, 0xaf, 0x19,
+	0x83, 0xac, 0x12, 0x58, 0x56, 0x34, 0xf2, 0x55, 0xc8, 0xe4, 0xc3, 0xc9, 0xe6, 0x89, 0x64, 0x47,
+	0xa3, 0x28, 0x7b, 0x9c, 0x06, 0x7f, 0x8f, 0x86, 0x51, 0xef, 0xb4, 0x69, 0xec, 0xbb, 0xa0, 0x7b,
+	0xf7, 0x26, 0xc9, 0xc6, 0xf3, 0xd0, 0x13, 0x2c, 0x8e, 0x6d, 0x2a, 0x99, 0xf6, 0x36, 0x7b, 0x25,
+	0x03, 0xec, 0x2a, 0x1e, 0xfc, 0x02, 0x2d, 0x64, 0x39, 0xa0, 0x88, 0xdb, 0x93, 0x48, 0x33, 0xb6,
+	0x97, 0x3d, 0x1e, 0xcb, 0x6c, 0xa5, 0xd8, 0x43, 0xa6, 0x54, 0xd3, 0xe7, 0xa7, 0xd3, 0xf4, 0x1d,
+	0x54, 0x7f, 0x3d, 0x90, 0x20, 0x42, 0xb7, 0x89, 0xda, 0xe6, 0x15, 0xa2, 0xc6, 0xea, 0x55, 0x20,
+	0xfc, 0x07, 0x34, 0xa3, 0x76, 0x64, 0xa0, 0xa7, 0xbf, 0x21, 0x2a, 0x51, 0x8c, 0xce, 0x80, 0x59,
+	0xe5, 0x1a, 0xa9, 0xd6, 0x76, 0x2a, 0xed, 0x4b, 0x99, 0x1c, 0x14, 0x4b, 0xfb, 0x93, 0x13, 0xf9,
+	0x38, 0x91, 0xbd, 0x61, 0x13, 0x32, 0x89, 0x6f, 0x1a, 0x5b, 0x32, 0xca, 0x74, 0xab, 0xdc, 0x96,
+	0xf2, 0x86, 0x44, 0xd1, 0x1a, 0xec, 0x61, 0xd4, 0xce, 0x46, 0xa8, 0x25, 0x01, 0x82, 0xb4, 0x3d,
+	0xa5, 0x64, 0x1e, 0x32, 0xa1, 0x57, 0x94, 0xbd, 0xd2, 0x1e, 0x49, 0xe3, 0xbf, 0xa0, 0xd5, 0xb1,
+	0x8f, 0x3c, 0x50, 0xad, 0x2f, 0xc8, 0x58, 0x7e, 0x71, 0x73, 0x0f, 0x54, 0xa1, 0xdc, 0x2a, 0x0a,
+	0xd2, 0xb4, 0x99, 0xee, 0x17, 0x68, 0x39, 0x48, 0x68, 0xae, 0xf9, 0x6b, 0x9a, 0xfc, 0x7e, 0x65,
+	0x3d, 0xcd, 0x1a, 0xbe, 0x18, 0x0c, 0x13, 0x3a, 0x18, 0x63, 0xd1, 0xb5, 0xae, 0xa7, 0xc1, 0x18,
+	0x8b, 0x92, 0x75, 0xb4, 0x17, 0x8b, 0x6e, 0x16, 0x8c, 0xb1, 0xe8, 0xe2, 0xef, 0xd1, 0xca, 0xe8,
+	0x36, 0xd6, 0xc2, 0x9a, 0xa4, 0x59, 0xbe, 0x7d, 0xcd, 0x09, 0xe5, 0xc8, 0x4e, 0xd6, 0x5e, 0x1e,
+	0xd9, 0xc2, 0x6e, 0xfe, 0x67, 0x05, 0x2d, 0xe9, 0xf6, 0x0d, 0xf5, 0xfb, 0x4c, 0xe0, 0xd7, 0x2e,
+	0x27, 0xf0, 0xbf, 0x42, 0xb3, 0xfa, 0xe0, 0x24, 0xdd, 0x0e, 0x7e, 0x46, 0x74, 0xb2, 0x24, 0x2c,
+	0x15, 0xe5, 0x53, 0x5d, 0xdc, 0x06, 0x18, 0xde, 0x43, 0x2b, 0x91, 0x60, 0x5d, 0xff, 0xc4, 0x11,
+	0x6c, 0x20, 0x7c, 0xc9, 0x4a, 0xb7, 0xc6, 0x2d, 0x29, 0xfc, 0xd0, 0x33, 0x5b, 0xe3, 0x65, 0x83,
+	0xb1, 0x0d, 0x04, 0x3f, 0x40, 0x73, 0xd2, 0x3f, 0x66, 0x3c, 0x91, 0x20, 0x68, 0x1f, 0x9d, 0x41,
+	0x7f, 0x0d, 0x1f, 0x1e, 0xbb, 0x33, 0x7f, 0xfb, 0xef, 0xcf, 0x6b, 0x76, 0x5a, 0xfe, 0x72, 0xfc,
+	0x62, 0xd4, 0xae, 0x66, 0xa7, 0xb0, 0xab, 0x03, 0x34, 0x07, 0xc7, 0x64, 0xa0, 0x6d, 0x4d, 0x02,
+	0xe9, 0x73, 0x86, 0xf0, 0xc8, 0x94, 0x18, 0x6e, 0xdf, 0x00, 0x82, 0x0f, 0xd0, 0x42, 0x76, 0xc0,
+	0x07, 0xd2, 0x46, 0x48, 0x96, 0x73, 0x0e, 0x63, 0x2b, 0x2d, 0x63, 0x0f, 0x09, 0xca, 0xcc, 0x6c,
+	0xe1, 0x12, 0xcd, 0xec, 0x17, 0x68, 0x49, 0x29, 0x65, 0x36, 0xf7, 0xca, 0x6f, 0x17, 0xf6, 0xaf,
+	0xd8, 0x8b, 0x2a, 0x37, 0x9d, 0xdd, 0x7d, 0x74, 0x9d, 0x26, 0x92, 0x3b, 0x23, 0x25, 0xd7, 0x75,
+	0x2b, 0x36, 0xce, 0xcc, 0xf3, 0x2e, 0xe7, 0x81, 0x5e, 0x23, 0xfb, 0x57, 0xec, 0x55, 0x05, 0xdb,
+	0xcf, 0x31, 0xa5, 0xde, 0xb9, 0x38, 0xbd, 0x77, 0x7e, 0x83, 0xe6, 0x82, 0xb6, 0xa3, 0x8e, 0x5d,
+	0x41, 0x7b, 0x9b, 0x04, 0x4e, 0x61, 0xcb, 0x47, 0xf5, 0xb1, 0xfe, 0xb2, 0xd9, 0xa7, 0x71, 0x0f,
+	0xc4, 0x74, 0x36, 0x68, 0xab, 0x14, 0x7e, 0x85, 0xe6, 0xe1, 0x48, 0x2c, 0xb6, 0x3e, 0xb8, 0x55,
+	0xbf, 0xbd, 0xd8, 0x7c, 0x44, 0xce, 0x1c, 0x96, 0x15, 0x6f, 0xf8, 0xa1, 0xd4, 0x0b, 0x53, 0x08,
+	0x78, 0x33, 0xb6, 0x22, 0x23, 0x5e, 0x7e, 0x1f, 0x46, 0xbc, 0x32, 0xa5, 0x11, 0xeb, 0xf1, 0x38,
+	0xcf, 0x88, 0x57, 0x2f, 0x64, 0xc4, 0x6b, 0x93, 0x8c, 0x78, 0xac, 0xde, 0x11, 0x23, 0xbe, 0x7e,
+	0x19, 0x46, 0x8c, 0xdf, 0xd5, 0x88, 0x6f, 0xbc, 0xab, 0x11, 0x7f, 0xf8, 0xde, 0x8d, 0xf8, 0x67,
+	0xef, 0xd3, 0x88, 0xad, 0x4b, 0x35, 0xe2, 0x8f, 0xa6, 0x36, 0xe2, 0xdd, 0x75, 0x74, 0x3d, 0xaf,
+	0x2c, 0x8e, 0x3c, 0x8d, 0xd8, 0xe6, 0x3f, 0xaf, 0xa2, 0xd5, 0xaf, 0x59, 0x2c, 0xfd, 0x50, 0x0b,
+	0x55, 0x2b, 0x62, 0x2e, 0xfe, 0x3d, 0xaa, 0xd3, 0x41, 0xea, 0x9c, 0x77, 0x88, 0x3a, 0xc4, 0x2f,
+	0xac, 0x61, 0x0c, 0xb7, 0x7f, 0xc5, 0x56, 0x38, 0xbc, 0x87, 0xae, 0xe9, 0x13, 0x79, 0x70, 0xca,
+	0x5f, 0x13, 0x9d, 0xaa, 0x4a, 0x61, 0xb0, 0x7a, 0xed, 0xb2, 0x58, 0x66, 0xdf, 0x5e, 0x2a, 0x51,
+	0x95, 0x42, 0x23, 0x15, 0x83, 0x3a, 0xe6, 0x01, 0xa3, 0xbc, 0xab, 0x8f, 0x86, 0x2a, 0x33, 0xa8,
+	0xc2, 0xbb, 0x18, 0xad, 0x75, 0x86, 0xaf, 0xcc, 0x78, 0xfd, 0xbb, 0x8e, 0x36, 0xbe, 0x63, 0xbe,
+	0xd7, 0x93, 0xac, 0x93, 0xc3, 0xa5, 0x1b, 0x90, 0x12, 0x2b, 0xa9, 0x5d, 0xa2, 0x95, 0x14, 0xec,
+	0x71, 0xae, 0x5e, 0xce, 0x1e, 0xe7, 0xe2, 0x07, 0x47, 0x39, 0x9d, 0x98, 0xb9, 0xb0, 0x4e, 0x14,
+	0xc5, 0xfc, 0xb5, 0x4b, 0x8d, 0xf9, 0xdd, 0x9d, 0x7f, 0xfd, 0x7f, 0xa6, 0xf6, 0x8f, 0xff, 0x7d,
+	0x52, 0xfb, 0xe1, 0x5e, 0xb5, 0xbf, 0xaa, 0xa3, 0x1f, 0x3d, 0x38, 0x03, 0x6e, 0xcf, 0x6a, 0xe3,
+	0xda, 0xfa, 0x69, 0x00, 0x12, 0x1d, 0x72, 0xa1, 0xe5, 0x1e, 0x00, 0x00,
 }
 
 func (this *ListenerOptions) Equal(that interface{}) bool {
@@ -1193,6 +1315,15 @@ func (this *HttpListenerOptions) Equal(that interface{}) bool {
 	if !this.Buffer.Equal(that1.Buffer) {
 		return false
 	}
+	if !this.DynamicForwardProxy.Equal(that1.DynamicForwardProxy) {
+		return false
+	}
+	if !this.Lua.Equal(that1.Lua) {
+		return false
+	}
+	if !this.Tap.Equal(that1.Tap) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1286,6 +1417,18 @@ func (this *VirtualHostOptions) Equal(that interface{}) bool {
 	if !this.BufferPerRoute.Equal(that1.BufferPerRoute) {
 		return false
 	}
+	if !this.LocalRatelimit.Equal(that1.LocalRatelimit) {
+		return false
+	}
+	if !this.LuaPerRoute.Equal(that1.LuaPerRoute) {
+		return false
+	}
+	if !this.Csrf.Equal(that1.Csrf) {
+		return false
+	}
+	if !this.AccessLogging.Equal(that1.AccessLogging) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -1390,6 +1533,15 @@ func (this *RouteOptions) Equal(that interface{}) bool {
 	if !this.BufferPerRoute.Equal(that1.BufferPerRoute) {
 		return false
 	}
+	if !this.LocalRatelimit.Equal(that1.LocalRatelimit) {
+		return false
+	}
+	if !this.LuaPerRoute.Equal(that1.LuaPerRoute) {
+		return false
+	}
+	if !this.Csrf.Equal(that1.Csrf) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
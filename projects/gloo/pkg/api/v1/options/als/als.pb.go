@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
+	matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	_ "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -26,6 +27,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type ComparisonFilter_Op int32
+
+const (
+	// =
+	ComparisonFilter_EQ ComparisonFilter_Op = 0
+	// >=
+	ComparisonFilter_GE ComparisonFilter_Op = 1
+	// <=
+	ComparisonFilter_LE ComparisonFilter_Op = 2
+)
+
+var ComparisonFilter_Op_name = map[int32]string{
+	0: "EQ",
+	1: "GE",
+	2: "LE",
+}
+
+var ComparisonFilter_Op_value = map[string]int32{
+	"EQ": 0,
+	"GE": 1,
+	"LE": 2,
+}
+
+func (x ComparisonFilter_Op) String() string {
+	return proto.EnumName(ComparisonFilter_Op_name, int32(x))
+}
+
+func (ComparisonFilter_Op) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{6, 0}
+}
+
 // Contains various settings for Envoy's access logging service.
 // See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v2/config/filter/accesslog/v2/accesslog.proto#envoy-api-msg-config-filter-accesslog-v2-accesslog
 type AccessLoggingService struct {
@@ -72,10 +104,12 @@ type AccessLog struct {
 	// Types that are valid to be assigned to OutputDestination:
 	//	*AccessLog_FileSink
 	//	*AccessLog_GrpcService
-	OutputDestination    isAccessLog_OutputDestination `protobuf_oneof:"OutputDestination"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	OutputDestination isAccessLog_OutputDestination `protobuf_oneof:"OutputDestination"`
+	// If set, only requests matching this filter are logged.
+	Filter               *AccessLogFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *AccessLog) Reset()         { *m = AccessLog{} }
@@ -138,6 +172,13 @@ func (m *AccessLog) GetGrpcService() *GrpcService {
 	return nil
 }
 
+func (m *AccessLog) GetFilter() *AccessLogFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AccessLog) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -154,6 +195,7 @@ type FileSink struct {
 	// Types that are valid to be assigned to OutputFormat:
 	//	*FileSink_StringFormat
 	//	*FileSink_JsonFormat
+	//	*FileSink_JsonFields
 	OutputFormat         isFileSink_OutputFormat `protobuf_oneof:"output_format"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
@@ -195,9 +237,13 @@ type FileSink_StringFormat struct {
 type FileSink_JsonFormat struct {
 	JsonFormat *types.Struct `protobuf:"bytes,3,opt,name=json_format,json=jsonFormat,proto3,oneof" json:"json_format,omitempty"`
 }
+type FileSink_JsonFields struct {
+	JsonFields *JsonFields `protobuf:"bytes,4,opt,name=json_fields,json=jsonFields,proto3,oneof" json:"json_fields,omitempty"`
+}
 
 func (*FileSink_StringFormat) isFileSink_OutputFormat() {}
 func (*FileSink_JsonFormat) isFileSink_OutputFormat()   {}
+func (*FileSink_JsonFields) isFileSink_OutputFormat()   {}
 
 func (m *FileSink) GetOutputFormat() isFileSink_OutputFormat {
 	if m != nil {
@@ -227,12 +273,63 @@ func (m *FileSink) GetJsonFormat() *types.Struct {
 	return nil
 }
 
+func (m *FileSink) GetJsonFields() *JsonFields {
+	if x, ok := m.GetOutputFormat().(*FileSink_JsonFields); ok {
+		return x.JsonFields
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*FileSink) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*FileSink_StringFormat)(nil),
 		(*FileSink_JsonFormat)(nil),
+		(*FileSink_JsonFields)(nil),
+	}
+}
+
+// Structured JSON access log fields.
+type JsonFields struct {
+	// Maps the name of each field to its value: an Envoy command operator such as `%RESPONSE_CODE%`,
+	// or text containing command operators, e.g. `%REQ(:METHOD)% %REQ(:PATH)%`.
+	// Unknown command operators are rejected.
+	// https://www.envoyproxy.io/docs/envoy/v1.14.1/configuration/observability/access_log#command-operators
+	Fields               map[string]string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *JsonFields) Reset()         { *m = JsonFields{} }
+func (m *JsonFields) String() string { return proto.CompactTextString(m) }
+func (*JsonFields) ProtoMessage()    {}
+func (*JsonFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{3}
+}
+func (m *JsonFields) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsonFields.Unmarshal(m, b)
+}
+func (m *JsonFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsonFields.Marshal(b, m, deterministic)
+}
+func (m *JsonFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsonFields.Merge(m, src)
+}
+func (m *JsonFields) XXX_Size() int {
+	return xxx_messageInfo_JsonFields.Size(m)
+}
+func (m *JsonFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsonFields.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsonFields proto.InternalMessageInfo
+
+func (m *JsonFields) GetFields() map[string]string {
+	if m != nil {
+		return m.Fields
 	}
+	return nil
 }
 
 type GrpcService struct {
@@ -255,7 +352,7 @@ func (m *GrpcService) Reset()         { *m = GrpcService{} }
 func (m *GrpcService) String() string { return proto.CompactTextString(m) }
 func (*GrpcService) ProtoMessage()    {}
 func (*GrpcService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_510ef0fc4b9989af, []int{3}
+	return fileDescriptor_510ef0fc4b9989af, []int{4}
 }
 func (m *GrpcService) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GrpcService.Unmarshal(m, b)
@@ -335,11 +432,556 @@ func (*GrpcService) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// Filters which requests are access logged.
+// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v2/config/filter/accesslog/v2/accesslog.proto#config-filter-accesslog-v2-accesslogfilter
+type AccessLogFilter struct {
+	// Types that are valid to be assigned to FilterSpecifier:
+	//	*AccessLogFilter_StatusCodeFilter
+	//	*AccessLogFilter_DurationFilter
+	//	*AccessLogFilter_NotHealthCheckFilter
+	//	*AccessLogFilter_RuntimeFilter
+	//	*AccessLogFilter_HeaderFilter
+	//	*AccessLogFilter_AndFilter
+	//	*AccessLogFilter_OrFilter
+	FilterSpecifier      isAccessLogFilter_FilterSpecifier `protobuf_oneof:"filter_specifier"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *AccessLogFilter) Reset()         { *m = AccessLogFilter{} }
+func (m *AccessLogFilter) String() string { return proto.CompactTextString(m) }
+func (*AccessLogFilter) ProtoMessage()    {}
+func (*AccessLogFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{5}
+}
+func (m *AccessLogFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessLogFilter.Unmarshal(m, b)
+}
+func (m *AccessLogFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessLogFilter.Marshal(b, m, deterministic)
+}
+func (m *AccessLogFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessLogFilter.Merge(m, src)
+}
+func (m *AccessLogFilter) XXX_Size() int {
+	return xxx_messageInfo_AccessLogFilter.Size(m)
+}
+func (m *AccessLogFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessLogFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessLogFilter proto.InternalMessageInfo
+
+type isAccessLogFilter_FilterSpecifier interface {
+	isAccessLogFilter_FilterSpecifier()
+	Equal(interface{}) bool
+}
+
+type AccessLogFilter_StatusCodeFilter struct {
+	StatusCodeFilter *StatusCodeFilter `protobuf:"bytes,1,opt,name=status_code_filter,json=statusCodeFilter,proto3,oneof" json:"status_code_filter,omitempty"`
+}
+type AccessLogFilter_DurationFilter struct {
+	DurationFilter *DurationFilter `protobuf:"bytes,2,opt,name=duration_filter,json=durationFilter,proto3,oneof" json:"duration_filter,omitempty"`
+}
+type AccessLogFilter_NotHealthCheckFilter struct {
+	NotHealthCheckFilter *NotHealthCheckFilter `protobuf:"bytes,3,opt,name=not_health_check_filter,json=notHealthCheckFilter,proto3,oneof" json:"not_health_check_filter,omitempty"`
+}
+type AccessLogFilter_RuntimeFilter struct {
+	RuntimeFilter *RuntimeFilter `protobuf:"bytes,4,opt,name=runtime_filter,json=runtimeFilter,proto3,oneof" json:"runtime_filter,omitempty"`
+}
+type AccessLogFilter_HeaderFilter struct {
+	HeaderFilter *HeaderFilter `protobuf:"bytes,5,opt,name=header_filter,json=headerFilter,proto3,oneof" json:"header_filter,omitempty"`
+}
+type AccessLogFilter_AndFilter struct {
+	AndFilter *AndFilter `protobuf:"bytes,6,opt,name=and_filter,json=andFilter,proto3,oneof" json:"and_filter,omitempty"`
+}
+type AccessLogFilter_OrFilter struct {
+	OrFilter *OrFilter `protobuf:"bytes,7,opt,name=or_filter,json=orFilter,proto3,oneof" json:"or_filter,omitempty"`
+}
+
+func (*AccessLogFilter_StatusCodeFilter) isAccessLogFilter_FilterSpecifier()     {}
+func (*AccessLogFilter_DurationFilter) isAccessLogFilter_FilterSpecifier()       {}
+func (*AccessLogFilter_NotHealthCheckFilter) isAccessLogFilter_FilterSpecifier() {}
+func (*AccessLogFilter_RuntimeFilter) isAccessLogFilter_FilterSpecifier()        {}
+func (*AccessLogFilter_HeaderFilter) isAccessLogFilter_FilterSpecifier()         {}
+func (*AccessLogFilter_AndFilter) isAccessLogFilter_FilterSpecifier()            {}
+func (*AccessLogFilter_OrFilter) isAccessLogFilter_FilterSpecifier()             {}
+
+func (m *AccessLogFilter) GetFilterSpecifier() isAccessLogFilter_FilterSpecifier {
+	if m != nil {
+		return m.FilterSpecifier
+	}
+	return nil
+}
+
+func (m *AccessLogFilter) GetStatusCodeFilter() *StatusCodeFilter {
+	if x, ok := m.GetFilterSpecifier().(*AccessLogFilter_StatusCodeFilter); ok {
+		return x.StatusCodeFilter
+	}
+	return nil
+}
+
+func (m *AccessLogFilter) GetDurationFilter() *DurationFilter {
+	if x, ok := m.GetFilterSpecifier().(*AccessLogFilter_DurationFilter); ok {
+		return x.DurationFilter
+	}
+	return nil
+}
+
+func (m *AccessLogFilter) GetNotHealthCheckFilter() *NotHealthCheckFilter {
+	if x, ok := m.GetFilterSpecifier().(*AccessLogFilter_NotHealthCheckFilter); ok {
+		return x.NotHealthCheckFilter
+	}
+	return nil
+}
+
+func (m *AccessLogFilter) GetRuntimeFilter() *RuntimeFilter {
+	if x, ok := m.GetFilterSpecifier().(*AccessLogFilter_RuntimeFilter); ok {
+		return x.RuntimeFilter
+	}
+	return nil
+}
+
+func (m *AccessLogFilter) GetHeaderFilter() *HeaderFilter {
+	if x, ok := m.GetFilterSpecifier().(*AccessLogFilter_HeaderFilter); ok {
+		return x.HeaderFilter
+	}
+	return nil
+}
+
+func (m *AccessLogFilter) GetAndFilter() *AndFilter {
+	if x, ok := m.GetFilterSpecifier().(*AccessLogFilter_AndFilter); ok {
+		return x.AndFilter
+	}
+	return nil
+}
+
+func (m *AccessLogFilter) GetOrFilter() *OrFilter {
+	if x, ok := m.GetFilterSpecifier().(*AccessLogFilter_OrFilter); ok {
+		return x.OrFilter
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AccessLogFilter) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AccessLogFilter_StatusCodeFilter)(nil),
+		(*AccessLogFilter_DurationFilter)(nil),
+		(*AccessLogFilter_NotHealthCheckFilter)(nil),
+		(*AccessLogFilter_RuntimeFilter)(nil),
+		(*AccessLogFilter_HeaderFilter)(nil),
+		(*AccessLogFilter_AndFilter)(nil),
+		(*AccessLogFilter_OrFilter)(nil),
+	}
+}
+
+// Compares a value of the request against a constant.
+type ComparisonFilter struct {
+	// Comparison operator.
+	Op ComparisonFilter_Op `protobuf:"varint,1,opt,name=op,proto3,enum=als.options.gloo.solo.io.ComparisonFilter_Op" json:"op,omitempty"`
+	// The value to compare against.
+	Value uint32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Runtime key which, if set in the Envoy runtime, overrides the value.
+	// Defaults to `access_log.status_code_filter` or `access_log.duration_filter`.
+	RuntimeKey           string   `protobuf:"bytes,3,opt,name=runtime_key,json=runtimeKey,proto3" json:"runtime_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ComparisonFilter) Reset()         { *m = ComparisonFilter{} }
+func (m *ComparisonFilter) String() string { return proto.CompactTextString(m) }
+func (*ComparisonFilter) ProtoMessage()    {}
+func (*ComparisonFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{6}
+}
+func (m *ComparisonFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ComparisonFilter.Unmarshal(m, b)
+}
+func (m *ComparisonFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ComparisonFilter.Marshal(b, m, deterministic)
+}
+func (m *ComparisonFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComparisonFilter.Merge(m, src)
+}
+func (m *ComparisonFilter) XXX_Size() int {
+	return xxx_messageInfo_ComparisonFilter.Size(m)
+}
+func (m *ComparisonFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComparisonFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComparisonFilter proto.InternalMessageInfo
+
+func (m *ComparisonFilter) GetOp() ComparisonFilter_Op {
+	if m != nil {
+		return m.Op
+	}
+	return ComparisonFilter_EQ
+}
+
+func (m *ComparisonFilter) GetValue() uint32 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *ComparisonFilter) GetRuntimeKey() string {
+	if m != nil {
+		return m.RuntimeKey
+	}
+	return ""
+}
+
+// Filters on the response status code.
+type StatusCodeFilter struct {
+	Comparison           *ComparisonFilter `protobuf:"bytes,1,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *StatusCodeFilter) Reset()         { *m = StatusCodeFilter{} }
+func (m *StatusCodeFilter) String() string { return proto.CompactTextString(m) }
+func (*StatusCodeFilter) ProtoMessage()    {}
+func (*StatusCodeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{7}
+}
+func (m *StatusCodeFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusCodeFilter.Unmarshal(m, b)
+}
+func (m *StatusCodeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusCodeFilter.Marshal(b, m, deterministic)
+}
+func (m *StatusCodeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusCodeFilter.Merge(m, src)
+}
+func (m *StatusCodeFilter) XXX_Size() int {
+	return xxx_messageInfo_StatusCodeFilter.Size(m)
+}
+func (m *StatusCodeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusCodeFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusCodeFilter proto.InternalMessageInfo
+
+func (m *StatusCodeFilter) GetComparison() *ComparisonFilter {
+	if m != nil {
+		return m.Comparison
+	}
+	return nil
+}
+
+// Filters on the total request duration, in milliseconds.
+type DurationFilter struct {
+	Comparison           *ComparisonFilter `protobuf:"bytes,1,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DurationFilter) Reset()         { *m = DurationFilter{} }
+func (m *DurationFilter) String() string { return proto.CompactTextString(m) }
+func (*DurationFilter) ProtoMessage()    {}
+func (*DurationFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{8}
+}
+func (m *DurationFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DurationFilter.Unmarshal(m, b)
+}
+func (m *DurationFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DurationFilter.Marshal(b, m, deterministic)
+}
+func (m *DurationFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurationFilter.Merge(m, src)
+}
+func (m *DurationFilter) XXX_Size() int {
+	return xxx_messageInfo_DurationFilter.Size(m)
+}
+func (m *DurationFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurationFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurationFilter proto.InternalMessageInfo
+
+func (m *DurationFilter) GetComparison() *ComparisonFilter {
+	if m != nil {
+		return m.Comparison
+	}
+	return nil
+}
+
+// Filters out requests originating from health checks, as detected by the `healthCheck` listener option.
+type NotHealthCheckFilter struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotHealthCheckFilter) Reset()         { *m = NotHealthCheckFilter{} }
+func (m *NotHealthCheckFilter) String() string { return proto.CompactTextString(m) }
+func (*NotHealthCheckFilter) ProtoMessage()    {}
+func (*NotHealthCheckFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{9}
+}
+func (m *NotHealthCheckFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotHealthCheckFilter.Unmarshal(m, b)
+}
+func (m *NotHealthCheckFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotHealthCheckFilter.Marshal(b, m, deterministic)
+}
+func (m *NotHealthCheckFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotHealthCheckFilter.Merge(m, src)
+}
+func (m *NotHealthCheckFilter) XXX_Size() int {
+	return xxx_messageInfo_NotHealthCheckFilter.Size(m)
+}
+func (m *NotHealthCheckFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotHealthCheckFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotHealthCheckFilter proto.InternalMessageInfo
+
+// Logs a random sample of requests.
+type RuntimeFilter struct {
+	// Percentage of requests to log, defaulting to 100.
+	Percentage *types.FloatValue `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Runtime key which, if set in the Envoy runtime, overrides the percentage.
+	// Defaults to `access_log.runtime_filter`.
+	RuntimeKey string `protobuf:"bytes,2,opt,name=runtime_key,json=runtimeKey,proto3" json:"runtime_key,omitempty"`
+	// By default, sampling is based on the request id, so that all access logs sample the same requests.
+	// Set this to sample requests independently.
+	UseIndependentRandomness bool     `protobuf:"varint,3,opt,name=use_independent_randomness,json=useIndependentRandomness,proto3" json:"use_independent_randomness,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *RuntimeFilter) Reset()         { *m = RuntimeFilter{} }
+func (m *RuntimeFilter) String() string { return proto.CompactTextString(m) }
+func (*RuntimeFilter) ProtoMessage()    {}
+func (*RuntimeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{10}
+}
+func (m *RuntimeFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuntimeFilter.Unmarshal(m, b)
+}
+func (m *RuntimeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuntimeFilter.Marshal(b, m, deterministic)
+}
+func (m *RuntimeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeFilter.Merge(m, src)
+}
+func (m *RuntimeFilter) XXX_Size() int {
+	return xxx_messageInfo_RuntimeFilter.Size(m)
+}
+func (m *RuntimeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeFilter proto.InternalMessageInfo
+
+func (m *RuntimeFilter) GetPercentage() *types.FloatValue {
+	if m != nil {
+		return m.Percentage
+	}
+	return nil
+}
+
+func (m *RuntimeFilter) GetRuntimeKey() string {
+	if m != nil {
+		return m.RuntimeKey
+	}
+	return ""
+}
+
+func (m *RuntimeFilter) GetUseIndependentRandomness() bool {
+	if m != nil {
+		return m.UseIndependentRandomness
+	}
+	return false
+}
+
+// Filters on a request header.
+type HeaderFilter struct {
+	Header               *matchers.HeaderMatcher `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *HeaderFilter) Reset()         { *m = HeaderFilter{} }
+func (m *HeaderFilter) String() string { return proto.CompactTextString(m) }
+func (*HeaderFilter) ProtoMessage()    {}
+func (*HeaderFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{11}
+}
+func (m *HeaderFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeaderFilter.Unmarshal(m, b)
+}
+func (m *HeaderFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeaderFilter.Marshal(b, m, deterministic)
+}
+func (m *HeaderFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderFilter.Merge(m, src)
+}
+func (m *HeaderFilter) XXX_Size() int {
+	return xxx_messageInfo_HeaderFilter.Size(m)
+}
+func (m *HeaderFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderFilter proto.InternalMessageInfo
+
+func (m *HeaderFilter) GetHeader() *matchers.HeaderMatcher {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+// Logs requests matching all of the filters.
+type AndFilter struct {
+	Filters              []*AccessLogFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AndFilter) Reset()         { *m = AndFilter{} }
+func (m *AndFilter) String() string { return proto.CompactTextString(m) }
+func (*AndFilter) ProtoMessage()    {}
+func (*AndFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{12}
+}
+func (m *AndFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AndFilter.Unmarshal(m, b)
+}
+func (m *AndFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AndFilter.Marshal(b, m, deterministic)
+}
+func (m *AndFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AndFilter.Merge(m, src)
+}
+func (m *AndFilter) XXX_Size() int {
+	return xxx_messageInfo_AndFilter.Size(m)
+}
+func (m *AndFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AndFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AndFilter proto.InternalMessageInfo
+
+func (m *AndFilter) GetFilters() []*AccessLogFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+// Logs requests matching any of the filters.
+type OrFilter struct {
+	Filters              []*AccessLogFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *OrFilter) Reset()         { *m = OrFilter{} }
+func (m *OrFilter) String() string { return proto.CompactTextString(m) }
+func (*OrFilter) ProtoMessage()    {}
+func (*OrFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{13}
+}
+func (m *OrFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrFilter.Unmarshal(m, b)
+}
+func (m *OrFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrFilter.Marshal(b, m, deterministic)
+}
+func (m *OrFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrFilter.Merge(m, src)
+}
+func (m *OrFilter) XXX_Size() int {
+	return xxx_messageInfo_OrFilter.Size(m)
+}
+func (m *OrFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrFilter proto.InternalMessageInfo
+
+func (m *OrFilter) GetFilters() []*AccessLogFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+// Access logging options for a virtual host.
+type VirtualHostAccessLogging struct {
+	// Do not write access logs for requests handled by this virtual host.
+	// The listener's access logs filter out the requests whose host matches the domains of this virtual host,
+	// unless the host matches the domains of another virtual host first.
+	Disabled             bool     `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VirtualHostAccessLogging) Reset()         { *m = VirtualHostAccessLogging{} }
+func (m *VirtualHostAccessLogging) String() string { return proto.CompactTextString(m) }
+func (*VirtualHostAccessLogging) ProtoMessage()    {}
+func (*VirtualHostAccessLogging) Descriptor() ([]byte, []int) {
+	return fileDescriptor_510ef0fc4b9989af, []int{14}
+}
+func (m *VirtualHostAccessLogging) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VirtualHostAccessLogging.Unmarshal(m, b)
+}
+func (m *VirtualHostAccessLogging) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VirtualHostAccessLogging.Marshal(b, m, deterministic)
+}
+func (m *VirtualHostAccessLogging) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VirtualHostAccessLogging.Merge(m, src)
+}
+func (m *VirtualHostAccessLogging) XXX_Size() int {
+	return xxx_messageInfo_VirtualHostAccessLogging.Size(m)
+}
+func (m *VirtualHostAccessLogging) XXX_DiscardUnknown() {
+	xxx_messageInfo_VirtualHostAccessLogging.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VirtualHostAccessLogging proto.InternalMessageInfo
+
+func (m *VirtualHostAccessLogging) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("als.options.gloo.solo.io.ComparisonFilter_Op", ComparisonFilter_Op_name, ComparisonFilter_Op_value)
 	proto.RegisterType((*AccessLoggingService)(nil), "als.options.gloo.solo.io.AccessLoggingService")
 	proto.RegisterType((*AccessLog)(nil), "als.options.gloo.solo.io.AccessLog")
 	proto.RegisterType((*FileSink)(nil), "als.options.gloo.solo.io.FileSink")
+	proto.RegisterType((*JsonFields)(nil), "als.options.gloo.solo.io.JsonFields")
+	proto.RegisterMapType((map[string]string)(nil), "als.options.gloo.solo.io.JsonFields.FieldsEntry")
 	proto.RegisterType((*GrpcService)(nil), "als.options.gloo.solo.io.GrpcService")
+	proto.RegisterType((*AccessLogFilter)(nil), "als.options.gloo.solo.io.AccessLogFilter")
+	proto.RegisterType((*ComparisonFilter)(nil), "als.options.gloo.solo.io.ComparisonFilter")
+	proto.RegisterType((*StatusCodeFilter)(nil), "als.options.gloo.solo.io.StatusCodeFilter")
+	proto.RegisterType((*DurationFilter)(nil), "als.options.gloo.solo.io.DurationFilter")
+	proto.RegisterType((*NotHealthCheckFilter)(nil), "als.options.gloo.solo.io.NotHealthCheckFilter")
+	proto.RegisterType((*RuntimeFilter)(nil), "als.options.gloo.solo.io.RuntimeFilter")
+	proto.RegisterType((*HeaderFilter)(nil), "als.options.gloo.solo.io.HeaderFilter")
+	proto.RegisterType((*AndFilter)(nil), "als.options.gloo.solo.io.AndFilter")
+	proto.RegisterType((*OrFilter)(nil), "als.options.gloo.solo.io.OrFilter")
+	proto.RegisterType((*VirtualHostAccessLogging)(nil), "als.options.gloo.solo.io.VirtualHostAccessLogging")
 }
 
 func init() {
@@ -347,42 +989,79 @@ func init() {
 }
 
 var fileDescriptor_510ef0fc4b9989af = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xc7, 0xe3, 0x36, 0x5f, 0xbf, 0x78, 0xdd, 0x08, 0x75, 0x5b, 0x89, 0xb4, 0x82, 0x12, 0x5c,
-	0x55, 0xca, 0x05, 0x1b, 0xca, 0x0d, 0x71, 0xa9, 0x41, 0xc1, 0xaa, 0x2a, 0x90, 0x9c, 0x9e, 0x7a,
-	0xb1, 0x36, 0xce, 0x7a, 0xb3, 0xcd, 0xc6, 0x63, 0x76, 0xd7, 0x55, 0x9f, 0x83, 0xa7, 0xe0, 0xc6,
-	0x95, 0x97, 0xe0, 0x25, 0x78, 0x07, 0xee, 0xc8, 0xbb, 0x76, 0x9b, 0x42, 0x23, 0x71, 0xb0, 0x34,
-	0x3b, 0xf3, 0x9f, 0xdf, 0xce, 0xe8, 0xef, 0x45, 0x11, 0xe3, 0x7a, 0x5e, 0x4d, 0x83, 0x0c, 0x96,
-	0xa1, 0x02, 0x01, 0x2f, 0x38, 0x84, 0x4c, 0x00, 0x84, 0xa5, 0x84, 0x2b, 0x9a, 0x69, 0x65, 0x4f,
-	0xa4, 0xe4, 0xe1, 0xf5, 0xab, 0x10, 0x4a, 0xcd, 0xa1, 0x50, 0x21, 0x11, 0xe6, 0x0b, 0x4a, 0x09,
-	0x1a, 0xf0, 0xa0, 0x0e, 0x9b, 0x52, 0x50, 0xcb, 0x83, 0x9a, 0x14, 0x70, 0x38, 0xd8, 0x63, 0xc0,
-	0xc0, 0x88, 0xc2, 0x3a, 0xb2, 0xfa, 0x03, 0x4c, 0x6f, 0xb4, 0x4d, 0xd2, 0x1b, 0xdd, 0xe4, 0xf6,
-	0xcd, 0xe5, 0x0b, 0xae, 0xdb, 0xab, 0x24, 0xcd, 0x9b, 0xd2, 0x13, 0x06, 0xc0, 0x04, 0x0d, 0xcd,
-	0x69, 0x5a, 0xe5, 0xa1, 0xd2, 0xb2, 0xca, 0x9a, 0x46, 0xff, 0x12, 0xed, 0x9d, 0x66, 0x19, 0x55,
-	0xea, 0x1c, 0x18, 0xe3, 0x05, 0x9b, 0x50, 0x79, 0xcd, 0x33, 0x8a, 0x23, 0x84, 0x88, 0xc9, 0xa7,
-	0x02, 0xd8, 0xc0, 0x19, 0x6e, 0x8e, 0xbc, 0x93, 0xa3, 0x60, 0xdd, 0xa4, 0xc1, 0x2d, 0x23, 0x71,
-	0x49, 0x1b, 0xfa, 0xdf, 0x1c, 0xe4, 0xde, 0x16, 0xf0, 0x29, 0x72, 0x73, 0x2e, 0x68, 0xaa, 0x78,
-	0xb1, 0x18, 0x6c, 0x0c, 0x9d, 0x91, 0x77, 0xe2, 0xaf, 0x07, 0x8e, 0xb9, 0xa0, 0x13, 0x5e, 0x2c,
-	0xe2, 0x4e, 0xd2, 0xcb, 0x9b, 0x18, 0x9f, 0xa1, 0x6d, 0x26, 0xcb, 0x2c, 0x55, 0x76, 0xc8, 0xc1,
-	0xa6, 0xa1, 0x1c, 0xaf, 0xa7, 0x7c, 0x90, 0x65, 0xd6, 0x6c, 0x14, 0x77, 0x12, 0x8f, 0xdd, 0x1d,
-	0xa3, 0x5d, 0xb4, 0xf3, 0xa9, 0xd2, 0x65, 0xa5, 0xdf, 0x53, 0xa5, 0x79, 0x41, 0xea, 0x6e, 0xff,
-	0x8b, 0x83, 0x7a, 0xed, 0xcd, 0x18, 0xa3, 0x6e, 0x49, 0xf4, 0x7c, 0xe0, 0x0c, 0x9d, 0x91, 0x9b,
-	0x98, 0x18, 0x1f, 0xa3, 0xbe, 0xd2, 0x92, 0x17, 0x2c, 0xcd, 0x41, 0x2e, 0x89, 0x36, 0x8b, 0xb8,
-	0x71, 0x27, 0xd9, 0xb6, 0xe9, 0xb1, 0xc9, 0xe2, 0x37, 0xc8, 0xbb, 0x52, 0x50, 0xb4, 0x22, 0x3b,
-	0xe7, 0xe3, 0xc0, 0x3a, 0x11, 0xb4, 0x4e, 0x04, 0x13, 0xe3, 0x44, 0xdc, 0x49, 0x50, 0xad, 0xb6,
-	0xbd, 0xd1, 0x23, 0xd4, 0x07, 0x33, 0x58, 0xd3, 0xed, 0xff, 0xd8, 0x40, 0xde, 0xca, 0x22, 0x78,
-	0x1f, 0xf5, 0x04, 0xb0, 0xb4, 0x20, 0x4b, 0xda, 0xcc, 0xf6, 0xbf, 0x00, 0xf6, 0x91, 0x2c, 0x29,
-	0x7e, 0x89, 0x76, 0x95, 0x26, 0x9a, 0x67, 0x69, 0x26, 0x2a, 0xa5, 0xa9, 0xb4, 0xaa, 0x76, 0xc8,
-	0x1d, 0x5b, 0x7c, 0x67, 0x6b, 0xa6, 0x23, 0x46, 0xcf, 0xc9, 0x6c, 0xc6, 0xeb, 0xed, 0x89, 0x48,
-	0x25, 0xfd, 0x5c, 0x51, 0xa5, 0xd3, 0x39, 0x25, 0x33, 0x2a, 0x55, 0xaa, 0xc1, 0xd8, 0xdf, 0x1d,
-	0x6e, 0x8e, 0xdc, 0xe4, 0xe9, 0x9d, 0x30, 0xb1, 0xba, 0xd8, 0xca, 0x2e, 0xa0, 0xf6, 0xf7, 0x0c,
-	0xf9, 0xf7, 0x48, 0xaa, 0x84, 0x42, 0xd1, 0x3f, 0x51, 0xff, 0x19, 0xd4, 0xe1, 0x2a, 0xca, 0x0a,
-	0xef, 0xb1, 0xce, 0xd1, 0xd1, 0x43, 0x2c, 0x2d, 0x09, 0x17, 0x2b, 0xb0, 0x2d, 0x03, 0x7b, 0xf6,
-	0x37, 0xec, 0xa2, 0x11, 0x1a, 0x5a, 0xd4, 0x47, 0x5e, 0xf3, 0xc7, 0xa4, 0x92, 0xe6, 0xd1, 0xf8,
-	0xfb, 0xaf, 0xae, 0xf3, 0xf5, 0xe7, 0xa1, 0x73, 0xf9, 0xf6, 0xdf, 0x5e, 0x6f, 0xb9, 0x60, 0x0f,
-	0xbc, 0xe0, 0xe9, 0x96, 0xf1, 0xf1, 0xf5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x59, 0x09, 0xd9,
-	0x6f, 0x04, 0x04, 0x00, 0x00,
+	// 1139 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x6e, 0xdb, 0xb6,
+	0x17, 0xb6, 0x9c, 0x7f, 0xf6, 0x71, 0x9c, 0xb8, 0x6c, 0xf0, 0xab, 0xeb, 0x5f, 0x97, 0x66, 0xea,
+	0xba, 0xa5, 0x03, 0x2a, 0x77, 0x19, 0x50, 0x6c, 0x5d, 0x77, 0x11, 0xe7, 0x9f, 0x97, 0xa5, 0x75,
+	0xab, 0x14, 0xbd, 0x08, 0x86, 0x09, 0x8c, 0x44, 0xcb, 0xac, 0x65, 0x51, 0x23, 0xa9, 0xac, 0x79,
+	0x8c, 0xbd, 0xc4, 0x30, 0x60, 0x2f, 0xb0, 0x97, 0xd8, 0x13, 0xec, 0x6e, 0x77, 0xbb, 0x1d, 0xb0,
+	0xfb, 0x41, 0x24, 0xa5, 0xd8, 0x6e, 0x8c, 0x64, 0x40, 0x2f, 0x0c, 0x93, 0x87, 0xdf, 0xf7, 0x91,
+	0x3c, 0xe7, 0x23, 0x45, 0xe8, 0x84, 0x54, 0x0e, 0xd2, 0x53, 0xc7, 0x67, 0xa3, 0xb6, 0x60, 0x11,
+	0x7b, 0x48, 0x59, 0x3b, 0x8c, 0x18, 0x6b, 0x27, 0x9c, 0xbd, 0x21, 0xbe, 0x14, 0xba, 0x87, 0x13,
+	0xda, 0x3e, 0xfb, 0xac, 0xcd, 0x12, 0x49, 0x59, 0x2c, 0xda, 0x38, 0x52, 0x3f, 0x27, 0xe1, 0x4c,
+	0x32, 0xd4, 0xcc, 0x9a, 0x66, 0xc8, 0xc9, 0xe0, 0x4e, 0xa6, 0xe4, 0x50, 0xd6, 0x5a, 0x0b, 0x59,
+	0xc8, 0x14, 0xa8, 0x9d, 0xb5, 0x34, 0xbe, 0x85, 0xc8, 0x5b, 0xa9, 0x83, 0xe4, 0xad, 0x34, 0xb1,
+	0xdb, 0x6a, 0xf2, 0x21, 0x95, 0xf9, 0x54, 0x9c, 0xf4, 0xcd, 0xd0, 0x9d, 0x90, 0xb1, 0x30, 0x22,
+	0x6d, 0xd5, 0x3b, 0x4d, 0xfb, 0x6d, 0x21, 0x79, 0xea, 0xe7, 0xc4, 0xf5, 0xe9, 0xd1, 0x1f, 0x39,
+	0x4e, 0x12, 0xc2, 0xcd, 0xe2, 0x5a, 0x8f, 0x67, 0xef, 0xc6, 0x67, 0x9c, 0xb4, 0x47, 0x58, 0xfa,
+	0x03, 0xc2, 0x45, 0xd1, 0xd0, 0x3c, 0xfb, 0x04, 0xd6, 0xb6, 0x7d, 0x9f, 0x08, 0x71, 0xc4, 0xc2,
+	0x90, 0xc6, 0xe1, 0x31, 0xe1, 0x67, 0xd4, 0x27, 0xa8, 0x03, 0x80, 0x55, 0xdc, 0x8b, 0x58, 0xd8,
+	0xb4, 0x36, 0xe6, 0x36, 0x6b, 0x5b, 0xf7, 0x9c, 0x59, 0x19, 0x70, 0x0a, 0x0d, 0xb7, 0x8a, 0xf3,
+	0xa6, 0xfd, 0xb7, 0x05, 0xd5, 0x62, 0x00, 0x6d, 0x43, 0xb5, 0x4f, 0x23, 0xe2, 0x09, 0x1a, 0x0f,
+	0x9b, 0xe5, 0x0d, 0x6b, 0xb3, 0xb6, 0x65, 0xcf, 0x16, 0xdc, 0xa7, 0x11, 0x39, 0xa6, 0xf1, 0xb0,
+	0x5b, 0x72, 0x2b, 0x7d, 0xd3, 0x46, 0x87, 0xb0, 0x1c, 0xf2, 0xc4, 0xf7, 0x84, 0x5e, 0x64, 0x73,
+	0x4e, 0xa9, 0xdc, 0x9f, 0xad, 0x72, 0xc0, 0x13, 0xdf, 0xec, 0xa8, 0x5b, 0x72, 0x6b, 0xe1, 0x45,
+	0x17, 0x6d, 0xc3, 0x62, 0x9f, 0x46, 0x92, 0xf0, 0xe6, 0xbc, 0x52, 0x79, 0x70, 0x8d, 0xcd, 0xed,
+	0x2b, 0x82, 0x6b, 0x88, 0x9d, 0x9b, 0x70, 0xa3, 0x97, 0xca, 0x24, 0x95, 0xbb, 0x44, 0x48, 0x1a,
+	0xe3, 0x8c, 0x6a, 0xff, 0x61, 0x41, 0x25, 0x5f, 0x3c, 0x42, 0x30, 0x9f, 0x60, 0x39, 0x68, 0x5a,
+	0x1b, 0xd6, 0x66, 0xd5, 0x55, 0x6d, 0x74, 0x1f, 0xea, 0x42, 0x72, 0x1a, 0x87, 0x5e, 0x9f, 0xf1,
+	0x11, 0x96, 0x2a, 0x17, 0xd5, 0x6e, 0xc9, 0x5d, 0xd6, 0xe1, 0x7d, 0x15, 0x45, 0x4f, 0xa0, 0xf6,
+	0x46, 0xb0, 0x38, 0x07, 0xe9, 0xad, 0xde, 0x72, 0xb4, 0x0d, 0x9c, 0xdc, 0x06, 0xce, 0xb1, 0x32,
+	0x49, 0xb7, 0xe4, 0x42, 0x86, 0x36, 0xdc, 0x83, 0x9c, 0x4b, 0x49, 0x14, 0x08, 0xb3, 0xc1, 0x8f,
+	0x66, 0x6f, 0xf0, 0x30, 0xa3, 0x2a, 0x6c, 0x21, 0xa4, 0x7a, 0x9d, 0x55, 0xa8, 0x33, 0xb5, 0x43,
+	0xb3, 0x0c, 0xfb, 0x27, 0x0b, 0xe0, 0x02, 0x8d, 0xba, 0x59, 0x12, 0xd5, 0x1c, 0xda, 0x21, 0x8f,
+	0xae, 0x33, 0x87, 0xa3, 0xff, 0xf6, 0x62, 0xc9, 0xcf, 0x5d, 0xc3, 0x6f, 0x7d, 0x09, 0xb5, 0xb1,
+	0x30, 0x6a, 0xc0, 0xdc, 0x90, 0x9c, 0x9b, 0xbc, 0x65, 0x4d, 0xb4, 0x06, 0x0b, 0x67, 0x38, 0x4a,
+	0x89, 0x4e, 0x97, 0xab, 0x3b, 0x4f, 0xca, 0x5f, 0x58, 0xf6, 0xef, 0x65, 0xa8, 0x8d, 0x15, 0x1a,
+	0xdd, 0x86, 0x4a, 0xc4, 0x42, 0x2f, 0xc6, 0x23, 0x62, 0x04, 0x96, 0x22, 0x16, 0x3e, 0xc7, 0x23,
+	0x82, 0x1e, 0xc1, 0x4d, 0x21, 0xb1, 0xa4, 0xbe, 0xe7, 0x47, 0xa9, 0x90, 0x84, 0x6b, 0x54, 0x5e,
+	0x81, 0x1b, 0x7a, 0x70, 0x47, 0x8f, 0x29, 0x46, 0x17, 0x3e, 0xc4, 0x41, 0x40, 0xb3, 0x0d, 0xe1,
+	0xc8, 0xe3, 0xe4, 0x87, 0x94, 0x08, 0xe9, 0x0d, 0x08, 0x0e, 0x08, 0x17, 0x9e, 0x64, 0xea, 0x78,
+	0xcc, 0x6f, 0xcc, 0x6d, 0x56, 0xdd, 0x0f, 0x2e, 0x80, 0xae, 0xc6, 0x75, 0x35, 0xec, 0x15, 0xcb,
+	0xfc, 0x7f, 0x08, 0xf6, 0x84, 0x92, 0x48, 0x58, 0x2c, 0xc8, 0xb4, 0xd4, 0x82, 0x92, 0x5a, 0x1f,
+	0x97, 0xd2, 0xc0, 0x09, 0xad, 0x23, 0xb8, 0x77, 0x99, 0x96, 0xe4, 0x98, 0x46, 0x63, 0x62, 0x8b,
+	0x4a, 0xec, 0xee, 0xbb, 0x62, 0xaf, 0x0c, 0x50, 0xa9, 0x75, 0xea, 0x50, 0x33, 0x27, 0xca, 0xe3,
+	0xa4, 0x6f, 0xff, 0x35, 0x0f, 0xab, 0x53, 0x96, 0x47, 0x27, 0x80, 0xb2, 0xdc, 0xa4, 0xc2, 0xf3,
+	0x59, 0x40, 0x3c, 0x73, 0x72, 0x2c, 0x65, 0xac, 0x4f, 0x67, 0x17, 0xfd, 0x58, 0x71, 0x76, 0x58,
+	0x40, 0xb4, 0x4e, 0xb7, 0xe4, 0x36, 0xc4, 0x54, 0x0c, 0x1d, 0xc3, 0x6a, 0x90, 0x72, 0x75, 0x7a,
+	0x72, 0x61, 0x7d, 0x3d, 0x6c, 0xce, 0x16, 0xde, 0x35, 0x84, 0x42, 0x76, 0x25, 0x98, 0x88, 0xa0,
+	0x10, 0x6e, 0xc5, 0x4c, 0x15, 0x2a, 0x92, 0x03, 0xcf, 0x1f, 0x10, 0x7f, 0x98, 0x8b, 0xeb, 0xa3,
+	0xe4, 0xcc, 0x16, 0x7f, 0xce, 0xb2, 0xca, 0x45, 0x72, 0xb0, 0x93, 0xd1, 0x8a, 0x29, 0xd6, 0xe2,
+	0x4b, 0xe2, 0xe8, 0x05, 0xac, 0xf0, 0x34, 0x96, 0x74, 0x54, 0x64, 0x45, 0x1f, 0xb7, 0x4f, 0x66,
+	0xeb, 0xbb, 0x1a, 0x5f, 0x08, 0xd7, 0xf9, 0x78, 0x00, 0x3d, 0x83, 0xba, 0x36, 0x45, 0x2e, 0xb8,
+	0xa0, 0x04, 0x3f, 0x9e, 0x2d, 0xa8, 0xbd, 0x51, 0xe8, 0x2d, 0x0f, 0xc6, 0xfa, 0x68, 0x17, 0x00,
+	0xc7, 0x41, 0xae, 0xb5, 0xb8, 0x61, 0x5d, 0x71, 0x93, 0xc7, 0x41, 0x21, 0x54, 0xc5, 0x79, 0x27,
+	0xbb, 0xbd, 0x59, 0xb1, 0xa0, 0xa5, 0xab, 0x6e, 0xef, 0xde, 0xc5, 0x62, 0x2a, 0xcc, 0xb4, 0x3b,
+	0x08, 0x1a, 0x9a, 0xef, 0x89, 0x84, 0xf8, 0xb4, 0x4f, 0x09, 0xb7, 0x7f, 0xb6, 0xa0, 0xb1, 0xc3,
+	0x46, 0x09, 0xe6, 0x54, 0x14, 0xb5, 0xfb, 0x1a, 0xca, 0x2c, 0x51, 0xe6, 0x5a, 0xd9, 0x7a, 0x38,
+	0x7b, 0x92, 0x69, 0x9e, 0xd3, 0x4b, 0xdc, 0x32, 0x4b, 0x26, 0x6f, 0x8a, 0xba, 0xb9, 0x29, 0xd0,
+	0x5d, 0xa8, 0xe5, 0x75, 0xca, 0x6e, 0x96, 0x39, 0x75, 0x31, 0x80, 0x09, 0x7d, 0x4b, 0xce, 0xed,
+	0x3b, 0x50, 0xee, 0x25, 0x68, 0x11, 0xca, 0x7b, 0x2f, 0x1b, 0xa5, 0xec, 0xff, 0x60, 0xaf, 0x61,
+	0x65, 0xff, 0x47, 0x7b, 0x8d, 0xb2, 0xfd, 0x3d, 0x34, 0xa6, 0xcd, 0x8c, 0x0e, 0x01, 0xfc, 0x62,
+	0x0d, 0x57, 0x1f, 0x86, 0xe9, 0xf5, 0xba, 0x63, 0x6c, 0xfb, 0x3b, 0x58, 0x99, 0xf4, 0xf4, 0x7b,
+	0x55, 0xff, 0x1f, 0xac, 0x5d, 0x66, 0x6a, 0xfb, 0x57, 0x0b, 0xea, 0x13, 0x6e, 0x44, 0x5f, 0x01,
+	0x24, 0x84, 0xfb, 0x24, 0x96, 0x38, 0x24, 0x66, 0xd6, 0xff, 0xbf, 0xf3, 0xd5, 0xd9, 0x8f, 0x18,
+	0x96, 0xaf, 0xb3, 0xbc, 0xba, 0x63, 0xf0, 0xe9, 0x1c, 0x97, 0xa7, 0x73, 0x8c, 0x9e, 0x42, 0x2b,
+	0x15, 0xc4, 0xa3, 0x71, 0x40, 0x12, 0x12, 0x07, 0x24, 0x96, 0x1e, 0xc7, 0x71, 0xc0, 0x46, 0x31,
+	0x11, 0x42, 0xd5, 0xa4, 0xe2, 0x36, 0x53, 0x41, 0xbe, 0xb9, 0x00, 0xb8, 0xc5, 0xb8, 0xfd, 0x12,
+	0x96, 0xc7, 0x9d, 0x9e, 0x7d, 0xc2, 0xb5, 0xd3, 0xcd, 0x3a, 0x1f, 0x38, 0xc5, 0xe3, 0x26, 0x7b,
+	0xf3, 0x5c, 0x76, 0x46, 0x9e, 0x69, 0x80, 0x6b, 0x88, 0xf6, 0x0b, 0xa8, 0x16, 0x86, 0x47, 0x3b,
+	0xb0, 0xa4, 0x0d, 0x9a, 0x7f, 0xce, 0xfe, 0xc3, 0x9b, 0x20, 0x67, 0xda, 0x3d, 0xa8, 0xf4, 0xf8,
+	0xfb, 0x14, 0x7c, 0x0c, 0xcd, 0xd7, 0x94, 0xcb, 0x14, 0x47, 0x5d, 0x26, 0xe4, 0xc4, 0x63, 0x0d,
+	0xb5, 0xa0, 0x12, 0x50, 0x81, 0x4f, 0x23, 0x12, 0xa8, 0x1c, 0x54, 0xdc, 0xa2, 0xdf, 0xd9, 0xff,
+	0xed, 0x9f, 0x79, 0xeb, 0x97, 0x3f, 0xd7, 0xad, 0x93, 0xa7, 0xd7, 0x7b, 0xfc, 0x26, 0xc3, 0xf0,
+	0x92, 0x07, 0xf0, 0xe9, 0xa2, 0xaa, 0xfa, 0xe7, 0xff, 0x0e, 0x00, 0x8b, 0x7d, 0x11, 0x70, 0x43,
+	0x0b, 0x00, 0x00,
 }
 
 func (this *AccessLoggingService) Equal(that interface{}) bool {
@@ -445,6 +1124,9 @@ func (this *AccessLog) Equal(that interface{}) bool {
 	} else if !this.OutputDestination.Equal(that1.OutputDestination) {
 		return false
 	}
+	if !this.Filter.Equal(that1.Filter) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -582,14 +1264,14 @@ func (this *FileSink_JsonFormat) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *GrpcService) Equal(that interface{}) bool {
+func (this *FileSink_JsonFields) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GrpcService)
+	that1, ok := that.(*FileSink_JsonFields)
 	if !ok {
-		that2, ok := that.(GrpcService)
+		that2, ok := that.(FileSink_JsonFields)
 		if ok {
 			that1 = &that2
 		} else {
@@ -601,12 +1283,68 @@ func (this *GrpcService) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.LogName != that1.LogName {
+	if !this.JsonFields.Equal(that1.JsonFields) {
 		return false
 	}
-	if that1.ServiceRef == nil {
-		if this.ServiceRef != nil {
-			return false
+	return true
+}
+func (this *JsonFields) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JsonFields)
+	if !ok {
+		that2, ok := that.(JsonFields)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *GrpcService) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GrpcService)
+	if !ok {
+		that2, ok := that.(GrpcService)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LogName != that1.LogName {
+		return false
+	}
+	if that1.ServiceRef == nil {
+		if this.ServiceRef != nil {
+			return false
 		}
 	} else if this.ServiceRef == nil {
 		return false
@@ -666,3 +1404,466 @@ func (this *GrpcService_StaticClusterName) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *AccessLogFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessLogFilter)
+	if !ok {
+		that2, ok := that.(AccessLogFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.FilterSpecifier == nil {
+		if this.FilterSpecifier != nil {
+			return false
+		}
+	} else if this.FilterSpecifier == nil {
+		return false
+	} else if !this.FilterSpecifier.Equal(that1.FilterSpecifier) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AccessLogFilter_StatusCodeFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessLogFilter_StatusCodeFilter)
+	if !ok {
+		that2, ok := that.(AccessLogFilter_StatusCodeFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.StatusCodeFilter.Equal(that1.StatusCodeFilter) {
+		return false
+	}
+	return true
+}
+func (this *AccessLogFilter_DurationFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessLogFilter_DurationFilter)
+	if !ok {
+		that2, ok := that.(AccessLogFilter_DurationFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DurationFilter.Equal(that1.DurationFilter) {
+		return false
+	}
+	return true
+}
+func (this *AccessLogFilter_NotHealthCheckFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessLogFilter_NotHealthCheckFilter)
+	if !ok {
+		that2, ok := that.(AccessLogFilter_NotHealthCheckFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.NotHealthCheckFilter.Equal(that1.NotHealthCheckFilter) {
+		return false
+	}
+	return true
+}
+func (this *AccessLogFilter_RuntimeFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessLogFilter_RuntimeFilter)
+	if !ok {
+		that2, ok := that.(AccessLogFilter_RuntimeFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RuntimeFilter.Equal(that1.RuntimeFilter) {
+		return false
+	}
+	return true
+}
+func (this *AccessLogFilter_HeaderFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessLogFilter_HeaderFilter)
+	if !ok {
+		that2, ok := that.(AccessLogFilter_HeaderFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HeaderFilter.Equal(that1.HeaderFilter) {
+		return false
+	}
+	return true
+}
+func (this *AccessLogFilter_AndFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessLogFilter_AndFilter)
+	if !ok {
+		that2, ok := that.(AccessLogFilter_AndFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.AndFilter.Equal(that1.AndFilter) {
+		return false
+	}
+	return true
+}
+func (this *AccessLogFilter_OrFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessLogFilter_OrFilter)
+	if !ok {
+		that2, ok := that.(AccessLogFilter_OrFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.OrFilter.Equal(that1.OrFilter) {
+		return false
+	}
+	return true
+}
+func (this *ComparisonFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ComparisonFilter)
+	if !ok {
+		that2, ok := that.(ComparisonFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Op != that1.Op {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.RuntimeKey != that1.RuntimeKey {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *StatusCodeFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StatusCodeFilter)
+	if !ok {
+		that2, ok := that.(StatusCodeFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Comparison.Equal(that1.Comparison) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *DurationFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DurationFilter)
+	if !ok {
+		that2, ok := that.(DurationFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Comparison.Equal(that1.Comparison) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *NotHealthCheckFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NotHealthCheckFilter)
+	if !ok {
+		that2, ok := that.(NotHealthCheckFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *RuntimeFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RuntimeFilter)
+	if !ok {
+		that2, ok := that.(RuntimeFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Percentage.Equal(that1.Percentage) {
+		return false
+	}
+	if this.RuntimeKey != that1.RuntimeKey {
+		return false
+	}
+	if this.UseIndependentRandomness != that1.UseIndependentRandomness {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *HeaderFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HeaderFilter)
+	if !ok {
+		that2, ok := that.(HeaderFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Header.Equal(that1.Header) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *AndFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AndFilter)
+	if !ok {
+		that2, ok := that.(AndFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Filters) != len(that1.Filters) {
+		return false
+	}
+	for i := range this.Filters {
+		if !this.Filters[i].Equal(that1.Filters[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *OrFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OrFilter)
+	if !ok {
+		that2, ok := that.(OrFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Filters) != len(that1.Filters) {
+		return false
+	}
+	for i := range this.Filters {
+		if !this.Filters[i].Equal(that1.Filters[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VirtualHostAccessLogging) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VirtualHostAccessLogging)
+	if !ok {
+		that2, ok := that.(VirtualHostAccessLogging)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Disabled != that1.Disabled {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
@@ -50,7 +50,19 @@ type UpstreamSpec struct {
 	// Is this consul service connect enabled.
 	ConnectEnabled bool `protobuf:"varint,4,opt,name=connect_enabled,json=connectEnabled,proto3" json:"connect_enabled,omitempty"`
 	// The data centers in which the service instance represented by this upstream is registered.
-	DataCenters          []string `protobuf:"bytes,5,rep,name=data_centers,json=dataCenters,proto3" json:"data_centers,omitempty"`
+	DataCenters []string `protobuf:"bytes,5,rep,name=data_centers,json=dataCenters,proto3" json:"data_centers,omitempty"`
+	// If true, only the service instances whose Consul health checks (including the checks of the node they are
+	// registered on) are all passing are included as endpoints of this upstream. By default, the health of the
+	// instances is ignored.
+	PassingOnly bool `protobuf:"varint,8,opt,name=passing_only,json=passingOnly,proto3" json:"passing_only,omitempty"`
+	// Only used together with `passing_only`. If true, the service instances whose Consul health checks are in the
+	// warning state are included as well. Their endpoints get the warning weight the instances were registered with
+	// in Consul, so that they receive less traffic than passing instances. Implies `use_service_weights`.
+	IncludeWarning bool `protobuf:"varint,9,opt,name=include_warning,json=includeWarning,proto3" json:"include_warning,omitempty"`
+	// If true, the weights the service instances were registered with in Consul are used as the load balancing
+	// weights of their endpoints: the passing weight for instances with passing health checks, and the warning weight
+	// for instances in the warning state.
+	UseServiceWeights    bool     `protobuf:"varint,10,opt,name=use_service_weights,json=useServiceWeights,proto3" json:"use_service_weights,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -129,6 +141,27 @@ func (m *UpstreamSpec) GetDataCenters() []string {
 	return nil
 }
 
+func (m *UpstreamSpec) GetPassingOnly() bool {
+	if m != nil {
+		return m.PassingOnly
+	}
+	return false
+}
+
+func (m *UpstreamSpec) GetIncludeWarning() bool {
+	if m != nil {
+		return m.IncludeWarning
+	}
+	return false
+}
+
+func (m *UpstreamSpec) GetUseServiceWeights() bool {
+	if m != nil {
+		return m.UseServiceWeights
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*UpstreamSpec)(nil), "consul.options.gloo.solo.io.UpstreamSpec")
 }
@@ -138,28 +171,32 @@ func init() {
 }
 
 var fileDescriptor_3c5077911f8bc0ad = []byte{
-	// 334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0x4e, 0xf3, 0x30,
-	0x14, 0x86, 0x95, 0xb6, 0x5f, 0x3f, 0x70, 0x0b, 0x48, 0x11, 0x43, 0x54, 0x24, 0x48, 0x61, 0xa0,
-	0x0b, 0xb1, 0xf8, 0xd9, 0x91, 0xf8, 0x91, 0x60, 0x61, 0x68, 0x61, 0x61, 0xa9, 0x1c, 0xf7, 0xc8,
-	0x18, 0x12, 0x1f, 0x2b, 0x76, 0xab, 0x5e, 0x12, 0x03, 0x17, 0xc0, 0xf5, 0x70, 0x0f, 0xec, 0xc8,
-	0x76, 0x0a, 0x1d, 0x2a, 0xc1, 0x94, 0xf8, 0xd1, 0x73, 0x7c, 0xce, 0x9b, 0x13, 0x72, 0x23, 0xa4,
-	0x7d, 0x9a, 0xe6, 0x19, 0xc7, 0x92, 0x1a, 0x2c, 0xf0, 0x48, 0x22, 0x15, 0x05, 0x22, 0xd5, 0x15,
-	0x3e, 0x03, 0xb7, 0x26, 0x9c, 0x98, 0x96, 0x74, 0x76, 0x4c, 0x51, 0x5b, 0x89, 0xca, 0x50, 0x8e,
-	0xca, 0x4c, 0x8b, 0xfa, 0x91, 0xe9, 0x0a, 0x2d, 0xc6, 0x3b, 0xf5, 0xa9, 0x76, 0x32, 0x57, 0x97,
-	0xb9, 0x2b, 0x33, 0x89, 0xbd, 0x6d, 0x81, 0x02, 0xbd, 0x47, 0xdd, 0x5b, 0x28, 0xe9, 0xc5, 0x30,
-	0xb7, 0x01, 0xc2, 0xdc, 0xd6, 0xec, 0xec, 0xf7, 0xee, 0x06, 0xaa, 0x99, 0xe4, 0x30, 0x36, 0x1a,
-	0x78, 0xa8, 0xda, 0x7f, 0x6b, 0x90, 0xee, 0x83, 0x36, 0xb6, 0x02, 0x56, 0x8e, 0x34, 0xf0, 0xb8,
-	0x4f, 0xba, 0x0b, 0x4d, 0xb1, 0x12, 0x92, 0x28, 0x8d, 0x06, 0xeb, 0xc3, 0x4e, 0xcd, 0xee, 0x58,
-	0x09, 0xcb, 0x8a, 0x65, 0xc2, 0x24, 0x8d, 0xb4, 0xb9, 0xa4, 0xdc, 0x33, 0x61, 0xe2, 0x3d, 0xd2,
-	0x31, 0xd3, 0xdc, 0x80, 0x0d, 0x46, 0xdb, 0x1b, 0x24, 0x20, 0x2f, 0x1c, 0x90, 0x0d, 0xa9, 0x8c,
-	0x65, 0x6a, 0x71, 0xc9, 0x7f, 0xaf, 0x74, 0x17, 0xd0, 0x4b, 0x57, 0x3f, 0x8d, 0xdc, 0xc8, 0x49,
-	0x33, 0x8d, 0x06, 0x9d, 0x93, 0xfe, 0xca, 0x2f, 0x95, 0x8d, 0x82, 0xe9, 0x42, 0x7c, 0xcf, 0xe2,
-	0x13, 0x1d, 0x92, 0x2d, 0x8e, 0x4a, 0x01, 0xb7, 0x63, 0x50, 0x2c, 0x2f, 0x60, 0x92, 0xb4, 0xd2,
-	0x68, 0xb0, 0x36, 0xdc, 0xac, 0xf1, 0x75, 0xa0, 0x2e, 0xd7, 0x84, 0x59, 0x36, 0xe6, 0xa0, 0x2c,
-	0x54, 0x26, 0xf9, 0x17, 0x72, 0x39, 0x76, 0x19, 0xd0, 0xc5, 0xed, 0xfb, 0x67, 0x2b, 0x7a, 0xfd,
-	0xd8, 0x8d, 0x1e, 0xcf, 0xff, 0xb6, 0x7f, 0xfd, 0x22, 0x56, 0xff, 0x03, 0x79, 0xdb, 0x2f, 0xe0,
-	0xf4, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x35, 0x9e, 0xf1, 0x87, 0x49, 0x02, 0x00, 0x00,
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x6e, 0xd4, 0x30,
+	0x10, 0xc7, 0x15, 0xb6, 0x94, 0xd6, 0x09, 0x20, 0x02, 0x07, 0xab, 0x48, 0x90, 0xc2, 0x81, 0xbd,
+	0x90, 0x88, 0x8f, 0x3b, 0x12, 0x1f, 0x12, 0x5c, 0x40, 0x6a, 0x41, 0x95, 0xb8, 0x44, 0x8e, 0x77,
+	0xe4, 0x1a, 0x12, 0x8f, 0x95, 0x71, 0xda, 0xee, 0x1b, 0xed, 0x23, 0xf0, 0x3c, 0xbc, 0x03, 0x77,
+	0xe4, 0x8f, 0xa0, 0x3d, 0xac, 0x44, 0x4f, 0x89, 0x7f, 0xf9, 0xcd, 0x8c, 0xff, 0xb1, 0xd9, 0x47,
+	0xa5, 0xdd, 0xf9, 0xd4, 0xd5, 0x12, 0x87, 0x86, 0xb0, 0xc7, 0xe7, 0x1a, 0x1b, 0xd5, 0x23, 0x36,
+	0x76, 0xc4, 0x1f, 0x20, 0x1d, 0xc5, 0x95, 0xb0, 0xba, 0xb9, 0x78, 0xd1, 0xa0, 0x75, 0x1a, 0x0d,
+	0x35, 0x12, 0x0d, 0x4d, 0x7d, 0x7a, 0xd4, 0x76, 0x44, 0x87, 0xe5, 0xc3, 0xb4, 0x4a, 0x4e, 0xed,
+	0xeb, 0x6a, 0xdf, 0xb2, 0xd6, 0x78, 0xf4, 0x40, 0xa1, 0xc2, 0xe0, 0x35, 0xfe, 0x2d, 0x96, 0x1c,
+	0x95, 0x70, 0xe5, 0x22, 0x84, 0x2b, 0x97, 0xd8, 0xeb, 0xff, 0x4f, 0x27, 0x18, 0x2f, 0xb4, 0x84,
+	0x96, 0x2c, 0xc8, 0x58, 0xf5, 0x64, 0xb3, 0x60, 0xc5, 0x37, 0x4b, 0x6e, 0x04, 0x31, 0x9c, 0x5a,
+	0x90, 0xe5, 0x31, 0x2b, 0x66, 0xcd, 0x88, 0x01, 0x78, 0x56, 0x65, 0xcb, 0xc3, 0x93, 0x3c, 0xb1,
+	0xcf, 0x62, 0x80, 0x6d, 0xc5, 0x09, 0x45, 0xfc, 0x46, 0xb5, 0xd8, 0x52, 0xbe, 0x0a, 0x45, 0xe5,
+	0x63, 0x96, 0xd3, 0xd4, 0x11, 0xb8, 0x68, 0xec, 0x07, 0x83, 0x45, 0x14, 0x84, 0xa7, 0xec, 0xb6,
+	0x36, 0xe4, 0x84, 0x99, 0x9b, 0xdc, 0x0a, 0x4a, 0x31, 0xc3, 0x20, 0xbd, 0x67, 0xc5, 0xf6, 0x96,
+	0xf9, 0xa2, 0xca, 0x96, 0xf9, 0xcb, 0xe3, 0x9d, 0x7f, 0xaa, 0x3e, 0x8d, 0xa6, 0x0f, 0xf1, 0x6f,
+	0x2f, 0x21, 0xd1, 0x33, 0x76, 0x57, 0xa2, 0x31, 0x20, 0x5d, 0x0b, 0x46, 0x74, 0x3d, 0xac, 0xf8,
+	0x5e, 0x95, 0x2d, 0x0f, 0x4e, 0xee, 0x24, 0xfc, 0x21, 0x52, 0x9f, 0x6b, 0x25, 0x9c, 0x68, 0x25,
+	0x18, 0x07, 0x23, 0xf1, 0x9b, 0x31, 0x97, 0x67, 0xef, 0x22, 0xf2, 0x8a, 0x15, 0x44, 0xda, 0xa8,
+	0x16, 0x4d, 0xbf, 0xe6, 0x07, 0xa1, 0x51, 0x9e, 0xd8, 0x17, 0xd3, 0xaf, 0xfd, 0x38, 0x6d, 0x64,
+	0x3f, 0xad, 0xa0, 0xbd, 0x14, 0xa3, 0xd1, 0x46, 0xf1, 0xc3, 0x38, 0x2e, 0xe1, 0xb3, 0x48, 0xcb,
+	0x9a, 0xdd, 0x9f, 0x08, 0xda, 0x39, 0xe1, 0x25, 0x68, 0x75, 0xee, 0x88, 0xb3, 0x20, 0xdf, 0x9b,
+	0x08, 0x52, 0xa2, 0xb3, 0xf8, 0xe1, 0xed, 0xa7, 0x5f, 0x7f, 0xf6, 0xb2, 0xcd, 0xef, 0x47, 0xd9,
+	0xf7, 0x37, 0xd7, 0xbb, 0x7b, 0xf6, 0xa7, 0xda, 0x7d, 0xff, 0xba, 0xfd, 0x70, 0xf8, 0xaf, 0xfe,
+	0x0e, 0x00, 0x52, 0x46, 0x22, 0x91, 0xc5, 0x02, 0x00, 0x00,
 }
 
 func (this *UpstreamSpec) Equal(that interface{}) bool {
@@ -222,6 +259,15 @@ func (this *UpstreamSpec) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.PassingOnly != that1.PassingOnly {
+		return false
+	}
+	if this.IncludeWarning != that1.IncludeWarning {
+		return false
+	}
+	if this.UseServiceWeights != that1.UseServiceWeights {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -93,5 +93,20 @@ func (m *UpstreamSpec) Hash(hasher hash.Hash64) (uint64, error) {
 
 	}
 
+	err = binary.Write(hasher, binary.LittleEndian, m.GetPassingOnly())
+	if err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetIncludeWarning())
+	if err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetUseServiceWeights())
+	if err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
@@ -12,6 +12,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
 	matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	dynamic_forward_proxy "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -711,6 +712,7 @@ type RouteAction struct {
 	//	*RouteAction_Single
 	//	*RouteAction_Multi
 	//	*RouteAction_UpstreamGroup
+	//	*RouteAction_DynamicForwardProxy
 	Destination          isRouteAction_Destination `protobuf_oneof:"destination"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -755,10 +757,14 @@ type RouteAction_Multi struct {
 type RouteAction_UpstreamGroup struct {
 	UpstreamGroup *core.ResourceRef `protobuf:"bytes,3,opt,name=upstream_group,json=upstreamGroup,proto3,oneof" json:"upstream_group,omitempty"`
 }
+type RouteAction_DynamicForwardProxy struct {
+	DynamicForwardProxy *dynamic_forward_proxy.PerRouteConfig `protobuf:"bytes,4,opt,name=dynamic_forward_proxy,json=dynamicForwardProxy,proto3,oneof" json:"dynamic_forward_proxy,omitempty"`
+}
 
-func (*RouteAction_Single) isRouteAction_Destination()        {}
-func (*RouteAction_Multi) isRouteAction_Destination()         {}
-func (*RouteAction_UpstreamGroup) isRouteAction_Destination() {}
+func (*RouteAction_Single) isRouteAction_Destination()              {}
+func (*RouteAction_Multi) isRouteAction_Destination()               {}
+func (*RouteAction_UpstreamGroup) isRouteAction_Destination()       {}
+func (*RouteAction_DynamicForwardProxy) isRouteAction_Destination() {}
 
 func (m *RouteAction) GetDestination() isRouteAction_Destination {
 	if m != nil {
@@ -788,12 +794,20 @@ func (m *RouteAction) GetUpstreamGroup() *core.ResourceRef {
 	return nil
 }
 
+func (m *RouteAction) GetDynamicForwardProxy() *dynamic_forward_proxy.PerRouteConfig {
+	if x, ok := m.GetDestination().(*RouteAction_DynamicForwardProxy); ok {
+		return x.DynamicForwardProxy
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*RouteAction) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*RouteAction_Single)(nil),
 		(*RouteAction_Multi)(nil),
 		(*RouteAction_UpstreamGroup)(nil),
+		(*RouteAction_DynamicForwardProxy)(nil),
 	}
 }
 
@@ -1378,105 +1392,108 @@ func init() {
 }
 
 var fileDescriptor_c6a47f72e9923590 = []byte{
-	// 1558 bytes of a gzipped FileDescriptorProto
+	// 1607 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
-	0x11, 0xd6, 0x88, 0x14, 0x45, 0x16, 0x49, 0xfd, 0xb4, 0x65, 0x79, 0xac, 0x58, 0xb2, 0x3c, 0x86,
-	0x6d, 0x21, 0x3f, 0x64, 0x2c, 0x1b, 0xb6, 0x23, 0x03, 0x89, 0x45, 0x89, 0x36, 0x03, 0x5b, 0x3f,
-	0x69, 0xc9, 0x0a, 0xec, 0xcb, 0x60, 0x38, 0x6c, 0x52, 0x13, 0x53, 0xec, 0x49, 0x77, 0x8f, 0x7e,
-	0xae, 0x7e, 0x84, 0x3c, 0x40, 0xce, 0x39, 0x04, 0x39, 0xfb, 0x90, 0x07, 0xc8, 0x25, 0xd7, 0xbd,
-	0x2c, 0xe0, 0x05, 0xf6, 0x0d, 0xb4, 0xc0, 0xde, 0xf6, 0xb0, 0xe8, 0x9e, 0x9e, 0xe1, 0x0c, 0x45,
-	0xca, 0x36, 0xe0, 0xc3, 0xde, 0xa6, 0xab, 0xbe, 0xaa, 0xae, 0xae, 0xfa, 0xba, 0xba, 0x48, 0x78,
-	0xd2, 0xf1, 0xc4, 0x61, 0xd0, 0xac, 0xb8, 0xf4, 0xa8, 0xca, 0x69, 0x97, 0xfe, 0xce, 0xa3, 0xd5,
-	0x4e, 0x97, 0xd2, 0xaa, 0xcf, 0xe8, 0xdf, 0x88, 0x2b, 0x78, 0xb8, 0x72, 0x7c, 0xaf, 0x7a, 0x7c,
-	0x5f, 0x0a, 0x4f, 0xcf, 0x2a, 0x3e, 0xa3, 0x82, 0xa2, 0x92, 0x54, 0x54, 0xa4, 0x4d, 0xc5, 0xa3,
-	0x0b, 0x4b, 0x1d, 0x4a, 0x3b, 0x5d, 0x52, 0x55, 0xba, 0x66, 0xd0, 0xae, 0x9e, 0x30, 0xc7, 0xf7,
-	0x09, 0xe3, 0x21, 0x7a, 0xe1, 0xc6, 0xa0, 0x9e, 0x0b, 0x16, 0xb8, 0x42, 0x6b, 0xe7, 0x3a, 0xb4,
-	0x43, 0xd5, 0x67, 0x55, 0x7e, 0x69, 0x29, 0x22, 0xa7, 0x22, 0x14, 0x92, 0xd3, 0x08, 0xb9, 0xa4,
-	0x82, 0x7c, 0xe7, 0x89, 0x28, 0xa4, 0x23, 0x22, 0x9c, 0x96, 0x23, 0x9c, 0x68, 0x9f, 0x41, 0x3d,
-	0x17, 0x8e, 0x08, 0xa2, 0x28, 0xae, 0x0f, 0x6a, 0x19, 0x69, 0x8f, 0x72, 0x1c, 0xad, 0xb5, 0xfe,
-	0xf6, 0xe8, 0xac, 0x70, 0xde, 0xd5, 0xa0, 0xbb, 0x97, 0x80, 0x82, 0x26, 0x27, 0x91, 0xb3, 0x7b,
-	0xa3, 0x71, 0xd4, 0x17, 0x1e, 0xed, 0x45, 0x01, 0x3f, 0x1a, 0x0d, 0x74, 0x29, 0x23, 0xd5, 0x23,
-	0x47, 0xb8, 0x87, 0x84, 0xf1, 0xf8, 0x23, 0xb4, 0xb3, 0xbe, 0x35, 0x60, 0x62, 0x57, 0x16, 0x0b,
-	0x3d, 0x84, 0x42, 0xd7, 0xe3, 0x82, 0xf4, 0x08, 0xe3, 0xe6, 0xf8, 0x72, 0x66, 0xa5, 0xb8, 0x3a,
-	0x5f, 0x49, 0x96, 0xae, 0xf2, 0x4a, 0xab, 0x71, 0x1f, 0x88, 0x5e, 0x42, 0x2e, 0x4c, 0x9c, 0x99,
-	0x5b, 0x36, 0x56, 0x8a, 0xab, 0x73, 0x15, 0xb9, 0x5d, 0x6c, 0xb2, 0xa7, 0x74, 0xb5, 0xc5, 0x0f,
-	0x3f, 0x66, 0x8d, 0xff, 0x7d, 0xbc, 0x39, 0xf6, 0xc3, 0xc7, 0x9b, 0xb3, 0x82, 0x70, 0xd1, 0xf2,
-	0xda, 0xed, 0x35, 0xcb, 0xeb, 0xf4, 0x28, 0x23, 0x16, 0xd6, 0x2e, 0xd0, 0x13, 0xc8, 0x47, 0x55,
-	0x32, 0x27, 0x95, 0xbb, 0xf9, 0xb4, 0xbb, 0x2d, 0xad, 0xad, 0x65, 0xa5, 0x33, 0x1c, 0xa3, 0xd7,
-	0xae, 0xbe, 0x3f, 0xcf, 0x66, 0x61, 0xdc, 0x3f, 0x7d, 0x7f, 0x9e, 0x2d, 0xa0, 0x49, 0x49, 0x3f,
-	0x8f, 0x70, 0xeb, 0x3c, 0x03, 0xf9, 0x28, 0x6a, 0x84, 0x20, 0xdb, 0x73, 0x8e, 0x88, 0x69, 0x2c,
-	0x1b, 0x2b, 0x05, 0xac, 0xbe, 0xd1, 0x2d, 0x28, 0x35, 0xbd, 0x5e, 0xcb, 0x76, 0x5a, 0x2d, 0x46,
-	0xb8, 0x3c, 0xb7, 0xd4, 0x15, 0xa5, 0x6c, 0x3d, 0x14, 0xa1, 0x5f, 0x41, 0x41, 0x41, 0x7c, 0xca,
-	0x84, 0x99, 0x59, 0x36, 0x56, 0xca, 0x38, 0x2f, 0x05, 0xbb, 0x94, 0x09, 0xb4, 0x0e, 0xe5, 0x43,
-	0x21, 0x7c, 0x3b, 0x4a, 0x88, 0x99, 0x55, 0x61, 0x2f, 0xa4, 0x13, 0xd7, 0x10, 0xc2, 0x8f, 0xc2,
-	0x68, 0x8c, 0xe1, 0xd2, 0x61, 0x62, 0x8d, 0xfe, 0x08, 0x25, 0xe1, 0x26, 0x3c, 0x4c, 0x28, 0x0f,
-	0xd7, 0xd3, 0x1e, 0xf6, 0xdd, 0xa4, 0x83, 0xa2, 0xe8, 0x2f, 0xd1, 0x73, 0x40, 0x9c, 0x77, 0x6d,
-	0x97, 0xf6, 0xda, 0x5e, 0x27, 0x60, 0x8e, 0x62, 0x85, 0x99, 0x53, 0x05, 0xbc, 0x96, 0xf6, 0xb2,
-	0xc7, 0xbb, 0x1b, 0x0a, 0x86, 0x67, 0x79, 0xf4, 0x19, 0x59, 0xa0, 0x1a, 0x4c, 0x07, 0x9c, 0xd8,
-	0xea, 0xe6, 0xda, 0x8a, 0x1c, 0xba, 0x06, 0x0b, 0x95, 0xf0, 0x4a, 0x56, 0xa2, 0x2b, 0x59, 0xa9,
-	0x51, 0xda, 0x3d, 0x70, 0xba, 0x01, 0xc1, 0xe5, 0x80, 0x13, 0x45, 0x9f, 0x5d, 0x75, 0xd5, 0x1f,
-	0xc3, 0xa4, 0xa6, 0xa5, 0x99, 0x57, 0xb6, 0x8b, 0xc3, 0x19, 0xb4, 0x13, 0x82, 0x70, 0x84, 0x46,
-	0x7f, 0x48, 0x54, 0xbe, 0xa0, 0x2c, 0xaf, 0x5d, 0xd8, 0x75, 0x4f, 0x35, 0x82, 0x5a, 0x56, 0x72,
-	0xa9, 0x5f, 0xfa, 0xda, 0x14, 0x94, 0x22, 0xb7, 0xfb, 0x67, 0x3e, 0xb1, 0xfe, 0x69, 0x40, 0x31,
-	0x91, 0x2e, 0xb4, 0x0a, 0x05, 0x99, 0xdf, 0x43, 0xca, 0x05, 0x37, 0x0d, 0x95, 0x96, 0xab, 0x17,
-	0x92, 0xdb, 0xa0, 0x5c, 0xe0, 0xbc, 0x08, 0x3f, 0x38, 0x5a, 0x1b, 0x3c, 0xc7, 0xf2, 0xc8, 0x72,
-	0x5c, 0x38, 0xca, 0x4d, 0x28, 0x4a, 0x3a, 0xdb, 0x3e, 0x23, 0x6d, 0xef, 0x54, 0x31, 0xa6, 0x80,
-	0x41, 0x8a, 0x76, 0x95, 0xc4, 0xfa, 0x87, 0x01, 0x93, 0x7a, 0xcb, 0xa1, 0x9c, 0x7c, 0x0a, 0xc5,
-	0x16, 0xe1, 0xc2, 0xeb, 0xa9, 0xc2, 0x28, 0x4a, 0x5e, 0xe0, 0x03, 0xa6, 0x81, 0x20, 0xeb, 0xae,
-	0x04, 0xe0, 0x24, 0x1a, 0x3d, 0x02, 0xe8, 0xb3, 0x41, 0x6d, 0x7e, 0x09, 0x0b, 0x0a, 0x31, 0x0b,
-	0xac, 0x7f, 0x1b, 0x50, 0x6a, 0xa4, 0x69, 0x59, 0x3e, 0xf6, 0x98, 0x08, 0x9c, 0x6e, 0x2a, 0x75,
-	0x03, 0x71, 0x1c, 0x84, 0x10, 0x95, 0xbe, 0xd2, 0x71, 0x7f, 0xc1, 0xd1, 0xd3, 0x7e, 0x0a, 0xc3,
-	0x13, 0xdc, 0x1a, 0x7d, 0x27, 0xbe, 0x3c, 0x87, 0xdf, 0x19, 0x50, 0x4c, 0xec, 0x3d, 0x34, 0x8f,
-	0x26, 0x4c, 0xb6, 0xe8, 0x91, 0xe3, 0xf5, 0xc2, 0x76, 0x56, 0xc0, 0xd1, 0x12, 0xfd, 0x06, 0x72,
-	0x4c, 0x26, 0x90, 0x9b, 0x19, 0x75, 0xa8, 0x2b, 0x43, 0x92, 0x8b, 0x35, 0x24, 0xc9, 0x85, 0xec,
-	0x30, 0x2e, 0x24, 0xc2, 0xb8, 0x94, 0xd6, 0xb9, 0x2f, 0xa2, 0xb5, 0xf5, 0xdf, 0x0c, 0x4c, 0xa8,
-	0x40, 0xd0, 0x9f, 0x20, 0x1f, 0x35, 0x6d, 0x5d, 0x84, 0xdb, 0x95, 0xb8, 0x8b, 0xab, 0xf6, 0x98,
-	0x8a, 0x67, 0x2b, 0x54, 0xe1, 0xd8, 0x48, 0x76, 0x18, 0x75, 0x16, 0xdb, 0x71, 0x3f, 0x8b, 0x51,
-	0xb2, 0xc3, 0xb0, 0xfe, 0x12, 0xbd, 0x80, 0x69, 0x46, 0x5a, 0x1e, 0x23, 0xae, 0x88, 0x5c, 0x84,
-	0xc4, 0xba, 0x31, 0xe0, 0x42, 0x83, 0x62, 0x2f, 0x53, 0x2c, 0x25, 0x41, 0x6f, 0x61, 0x5e, 0xbb,
-	0x61, 0x84, 0xfb, 0xb4, 0xc7, 0xe3, 0x90, 0xc2, 0xcc, 0x5a, 0x69, 0x7f, 0x9b, 0x0a, 0x8b, 0x35,
-	0x34, 0xf6, 0x3a, 0xd7, 0x1a, 0x22, 0x47, 0x0f, 0xfb, 0x65, 0x9a, 0x18, 0xd6, 0x83, 0xd5, 0xf9,
-	0xbe, 0x62, 0x81, 0x62, 0xca, 0x4d, 0xf6, 0x29, 0x57, 0xcb, 0x43, 0x2e, 0x3c, 0x90, 0xf5, 0x7f,
-	0x03, 0x8a, 0x89, 0x94, 0xa2, 0x07, 0x90, 0xe3, 0x5e, 0xaf, 0xd3, 0x0d, 0x29, 0x7a, 0x21, 0xfb,
-	0x9b, 0xfd, 0x2b, 0xdc, 0x18, 0xc3, 0x1a, 0x8a, 0x1e, 0xc1, 0xc4, 0x51, 0xd0, 0x15, 0x9e, 0xae,
-	0xd8, 0xd2, 0x40, 0xa1, 0xa5, 0x2a, 0x6d, 0x18, 0xc2, 0x51, 0x0d, 0xa6, 0x02, 0x9f, 0x0b, 0x46,
-	0x9c, 0x23, 0xbb, 0xc3, 0x68, 0xe0, 0xeb, 0x7a, 0x5d, 0x4f, 0xbf, 0xa6, 0x98, 0x70, 0x1a, 0x30,
-	0x97, 0x60, 0xd2, 0x6e, 0x8c, 0xe1, 0x72, 0x64, 0xf2, 0x42, 0x5a, 0xd4, 0xca, 0xa9, 0x2e, 0x64,
-	0x7d, 0x33, 0x0e, 0xc5, 0xc4, 0x5e, 0xe8, 0x31, 0xe4, 0x23, 0xbc, 0x09, 0x9f, 0x76, 0x1e, 0x83,
-	0xd1, 0x33, 0xc8, 0xbe, 0x0b, 0x9a, 0xc4, 0x2c, 0x2a, 0xa3, 0x5f, 0xa7, 0x8f, 0xf4, 0x32, 0x68,
-	0x12, 0xd6, 0x23, 0x82, 0xf0, 0x3d, 0xc2, 0x8e, 0x3d, 0x97, 0xa4, 0x8f, 0xa7, 0x2c, 0xd1, 0x33,
-	0xc8, 0xb9, 0xb4, 0xc7, 0x83, 0xae, 0x59, 0x52, 0x3e, 0xee, 0xa6, 0x7d, 0x6c, 0x28, 0xdd, 0x50,
-	0x7b, 0x6d, 0x87, 0x1a, 0x30, 0x93, 0x38, 0x9b, 0xcd, 0x7d, 0xe2, 0xea, 0x14, 0x2f, 0x8e, 0x2c,
-	0xcb, 0x9e, 0x4f, 0x5c, 0x3c, 0xdd, 0x4a, 0x0b, 0xd0, 0x6f, 0x21, 0x17, 0xce, 0x6b, 0x3a, 0xc3,
-	0x73, 0x03, 0xad, 0x56, 0xe9, 0xb0, 0xc6, 0xd4, 0x50, 0x7a, 0x5f, 0x21, 0x9f, 0x2b, 0x02, 0x37,
-	0x2e, 0x3b, 0x35, 0xba, 0x0f, 0x19, 0x46, 0xda, 0x31, 0x6b, 0x46, 0xe5, 0x58, 0x4f, 0x44, 0x12,
-	0x2b, 0x99, 0xa9, 0x86, 0x95, 0x71, 0x35, 0xac, 0xa8, 0x6f, 0x4b, 0x80, 0x39, 0x2a, 0x31, 0x72,
-	0x08, 0xe2, 0xa1, 0xd4, 0x4e, 0x34, 0xd1, 0xa2, 0x96, 0x6d, 0xcb, 0x5e, 0x8a, 0x20, 0x2b, 0x9c,
-	0x4e, 0xd4, 0x48, 0xd5, 0xb7, 0x34, 0x93, 0x17, 0xc1, 0x76, 0x49, 0x4f, 0xc8, 0xde, 0x94, 0x51,
-	0xba, 0xa2, 0x94, 0x6d, 0x84, 0x22, 0xeb, 0x27, 0x03, 0xca, 0xaf, 0x93, 0xb4, 0x42, 0x75, 0x28,
-	0x25, 0x52, 0x10, 0x35, 0xb4, 0x81, 0xb7, 0xe1, 0xaf, 0xc4, 0xeb, 0x1c, 0x0a, 0xd2, 0x4a, 0x04,
-	0x89, 0x53, 0x66, 0xbf, 0x94, 0xb1, 0x73, 0x31, 0x1c, 0x3b, 0x83, 0xce, 0xfb, 0xf3, 0xec, 0x2c,
-	0x9a, 0x4e, 0x5f, 0x3a, 0x6e, 0xbd, 0x81, 0x99, 0xc1, 0x4b, 0xfa, 0x95, 0x12, 0x60, 0xfd, 0xc7,
-	0x80, 0x2b, 0x43, 0x50, 0x83, 0xc3, 0xc3, 0xa7, 0x9a, 0x4d, 0x7a, 0x78, 0x98, 0x87, 0xdc, 0x89,
-	0xf2, 0xa9, 0xa9, 0xa3, 0x57, 0xa8, 0xd6, 0xef, 0xad, 0x21, 0xcd, 0x57, 0x3e, 0x19, 0xee, 0x60,
-	0xa7, 0xb5, 0x3e, 0x64, 0x60, 0x2a, 0xfd, 0x40, 0xa0, 0xdb, 0x50, 0x96, 0xa3, 0x85, 0x1d, 0xbd,
-	0x12, 0x9a, 0x78, 0x25, 0x29, 0x8c, 0xa0, 0xe8, 0x0e, 0x94, 0x7d, 0x47, 0x1c, 0xf6, 0x41, 0x6a,
-	0x44, 0x97, 0x53, 0xb4, 0x14, 0xc7, 0xb0, 0x7b, 0x30, 0x15, 0x0e, 0x0b, 0x36, 0x23, 0x27, 0xcc,
-	0x13, 0x44, 0xbd, 0x02, 0x12, 0x57, 0x0e, 0xe5, 0x38, 0x14, 0xa3, 0x03, 0x28, 0xc7, 0x8f, 0x8f,
-	0x4b, 0x5b, 0x44, 0x9d, 0x68, 0x6a, 0xf5, 0xfe, 0x65, 0x4f, 0x59, 0xbc, 0x8c, 0xde, 0x9c, 0x0d,
-	0xda, 0x22, 0xb8, 0xc4, 0x12, 0x2b, 0x74, 0x07, 0xa6, 0xe4, 0x58, 0xcf, 0xfb, 0x81, 0xca, 0x37,
-	0x2d, 0x8f, 0xd5, 0xef, 0x03, 0x1e, 0xc7, 0xa9, 0x26, 0x1b, 0xe6, 0xf9, 0xf6, 0xdf, 0x03, 0xc2,
-	0xce, 0x14, 0x7b, 0xf3, 0x72, 0xb2, 0x61, 0x9e, 0xff, 0x17, 0x29, 0xb1, 0x4e, 0x60, 0x6e, 0xd8,
-	0x6e, 0xe8, 0x2a, 0xcc, 0x6e, 0xed, 0x1c, 0xd4, 0x37, 0xed, 0xdd, 0x3a, 0xde, 0x5a, 0xdf, 0xae,
-	0x6f, 0xef, 0xbf, 0x7a, 0x33, 0x33, 0x86, 0x0a, 0x30, 0xf1, 0x7c, 0xe7, 0xf5, 0xf6, 0xe6, 0x8c,
-	0x81, 0xca, 0x50, 0xd8, 0xab, 0xd7, 0xed, 0x9d, 0xfd, 0x46, 0x1d, 0xcf, 0x8c, 0xa3, 0x79, 0x40,
-	0xfb, 0xf5, 0xad, 0xdd, 0x1d, 0xbc, 0x8e, 0xdf, 0xd8, 0xb8, 0xbe, 0xf9, 0x67, 0x5c, 0xdf, 0xd8,
-	0x9f, 0xc9, 0x48, 0x79, 0xec, 0xa2, 0x2f, 0xcf, 0xd6, 0x4c, 0x98, 0xd7, 0x89, 0x56, 0x89, 0x52,
-	0x5d, 0xd1, 0x6b, 0x7b, 0x84, 0x59, 0x35, 0x98, 0x1b, 0xf6, 0x14, 0x4b, 0xba, 0xe8, 0x4b, 0x68,
-	0x84, 0x74, 0xd1, 0xf7, 0x09, 0x41, 0xb6, 0x49, 0x5b, 0x67, 0xfa, 0xc7, 0x94, 0xfa, 0xae, 0xad,
-	0xc9, 0xab, 0xf8, 0xaf, 0xef, 0x97, 0x8c, 0xb7, 0xbf, 0xff, 0xbc, 0x3f, 0x12, 0xfc, 0x77, 0x1d,
-	0xfd, 0x03, 0xb6, 0x99, 0x53, 0x4f, 0xf1, 0x83, 0x9f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x12,
-	0x11, 0xd9, 0x83, 0x10, 0x00, 0x00,
+	0x11, 0xd6, 0x90, 0x14, 0x45, 0x16, 0x49, 0xfd, 0xb4, 0x25, 0xed, 0x48, 0xf1, 0xca, 0xda, 0x31,
+	0x76, 0x57, 0xc8, 0x0f, 0x19, 0x6b, 0x17, 0xde, 0x8d, 0x0c, 0x24, 0x16, 0x25, 0xda, 0x0c, 0x6c,
+	0xfd, 0xa4, 0x25, 0x2b, 0xb0, 0x0f, 0x19, 0x0c, 0x67, 0x9a, 0xd4, 0xc4, 0x24, 0x7b, 0xd2, 0xdd,
+	0xa3, 0x9f, 0xab, 0x1f, 0x21, 0x0f, 0x90, 0x73, 0x0e, 0x49, 0xce, 0x3e, 0xe4, 0x01, 0xf2, 0x04,
+	0xc9, 0xcd, 0x01, 0xf2, 0x06, 0x0e, 0x10, 0x20, 0xc7, 0xa0, 0x7b, 0x7a, 0xc8, 0x19, 0x6a, 0x24,
+	0xd9, 0x80, 0x0f, 0xb9, 0x75, 0x57, 0x7d, 0x55, 0x53, 0x5d, 0xf5, 0x75, 0x75, 0x91, 0xf0, 0x7d,
+	0xcf, 0x17, 0xa7, 0x61, 0xa7, 0xee, 0xd2, 0x41, 0x83, 0xd3, 0x3e, 0xfd, 0x89, 0x4f, 0x1b, 0xbd,
+	0x3e, 0xa5, 0x8d, 0x80, 0xd1, 0xdf, 0x12, 0x57, 0xf0, 0x68, 0xe7, 0x04, 0x7e, 0xe3, 0xec, 0x81,
+	0x14, 0x5e, 0x5c, 0xd6, 0x03, 0x46, 0x05, 0x45, 0x55, 0xa9, 0xa8, 0x4b, 0x9b, 0xba, 0x4f, 0x57,
+	0xd7, 0x7a, 0x94, 0xf6, 0xfa, 0xa4, 0xa1, 0x74, 0x9d, 0xb0, 0xdb, 0x38, 0x67, 0x4e, 0x10, 0x10,
+	0xc6, 0x23, 0xf4, 0xea, 0xdd, 0x49, 0x3d, 0x17, 0x2c, 0x74, 0x85, 0xd6, 0x2e, 0xf6, 0x68, 0x8f,
+	0xaa, 0x65, 0x43, 0xae, 0xb4, 0x14, 0x91, 0x0b, 0x11, 0x09, 0xc9, 0x45, 0x8c, 0x5c, 0x53, 0x41,
+	0xbe, 0xf6, 0x45, 0x1c, 0xd2, 0x80, 0x08, 0xc7, 0x73, 0x84, 0x13, 0x7f, 0x67, 0x52, 0xcf, 0x85,
+	0x23, 0xc2, 0x38, 0x8a, 0x95, 0x49, 0x2d, 0x23, 0xdd, 0xeb, 0x1c, 0xc7, 0x7b, 0xad, 0xbf, 0x7f,
+	0x7d, 0x56, 0x38, 0xef, 0x6b, 0xd0, 0x57, 0x37, 0x80, 0xc2, 0x0e, 0x27, 0xb1, 0xb3, 0xaf, 0xaf,
+	0xc7, 0xd1, 0x40, 0xf8, 0x74, 0x18, 0x07, 0x7c, 0x74, 0x2b, 0xb0, 0xe1, 0x5d, 0x0e, 0x9d, 0x81,
+	0xef, 0xda, 0x5d, 0xca, 0xce, 0x1d, 0xe6, 0xd9, 0xaa, 0x46, 0xd9, 0x52, 0xed, 0xf4, 0xe1, 0xf5,
+	0x4e, 0x5d, 0xca, 0x48, 0x63, 0xe0, 0x08, 0xf7, 0x94, 0x30, 0x3e, 0x5a, 0x44, 0x76, 0xd6, 0x3f,
+	0x0c, 0x98, 0x3e, 0x94, 0x7e, 0xd0, 0xb7, 0x50, 0xee, 0xfb, 0x5c, 0x90, 0x21, 0x61, 0xdc, 0xcc,
+	0xad, 0xe7, 0x37, 0x2a, 0x9b, 0xcb, 0xf5, 0x24, 0x1f, 0xea, 0xcf, 0xb5, 0x1a, 0x8f, 0x81, 0xe8,
+	0x19, 0x14, 0xa3, 0x6a, 0x98, 0xc5, 0x75, 0x63, 0xa3, 0xb2, 0xb9, 0x58, 0x97, 0x9f, 0x1b, 0x99,
+	0x1c, 0x29, 0x5d, 0xf3, 0xf3, 0xb7, 0xff, 0x29, 0x18, 0x7f, 0x7b, 0x77, 0x6f, 0xea, 0xdf, 0xef,
+	0xee, 0x2d, 0x08, 0xc2, 0x85, 0xe7, 0x77, 0xbb, 0x5b, 0x96, 0xdf, 0x1b, 0x52, 0x46, 0x2c, 0xac,
+	0x5d, 0xa0, 0xef, 0xa1, 0x14, 0x97, 0xde, 0x9c, 0x51, 0xee, 0x96, 0xd3, 0xee, 0xf6, 0xb4, 0xb6,
+	0x59, 0x90, 0xce, 0xf0, 0x08, 0xbd, 0xb5, 0xf0, 0xe6, 0x7d, 0xa1, 0x06, 0xb9, 0xe0, 0x02, 0xcd,
+	0xc8, 0xac, 0xf8, 0x84, 0x5b, 0xef, 0xf3, 0x50, 0x8a, 0x23, 0x46, 0x08, 0x0a, 0x43, 0x67, 0x40,
+	0x4c, 0x63, 0xdd, 0xd8, 0x28, 0x63, 0xb5, 0x46, 0x5f, 0x40, 0xb5, 0xe3, 0x0f, 0x3d, 0xdb, 0xf1,
+	0x3c, 0x46, 0xb8, 0x3c, 0xb3, 0xd4, 0x55, 0xa4, 0x6c, 0x3b, 0x12, 0xa1, 0x1f, 0x40, 0x59, 0x41,
+	0x02, 0xca, 0x84, 0x99, 0x5f, 0x37, 0x36, 0x6a, 0xb8, 0x24, 0x05, 0x87, 0x94, 0x09, 0xb4, 0x0d,
+	0xb5, 0x53, 0x21, 0x02, 0x3b, 0x4e, 0x86, 0x59, 0x50, 0x21, 0xaf, 0xa6, 0x93, 0xd6, 0x16, 0x22,
+	0x88, 0xc3, 0x68, 0x4f, 0xe1, 0xea, 0x69, 0x62, 0x8f, 0x7e, 0x0e, 0x55, 0xe1, 0x26, 0x3c, 0x4c,
+	0x2b, 0x0f, 0x2b, 0x69, 0x0f, 0xc7, 0x6e, 0xd2, 0x41, 0x45, 0x8c, 0xb7, 0xe8, 0x09, 0x20, 0xce,
+	0xfb, 0xb6, 0x4b, 0x87, 0x5d, 0xbf, 0x17, 0x32, 0x47, 0xb1, 0xc7, 0x2c, 0xaa, 0xe2, 0x7d, 0x96,
+	0xf6, 0x72, 0xc4, 0xfb, 0x3b, 0x0a, 0x86, 0x17, 0x78, 0xbc, 0x8c, 0x2d, 0x50, 0x13, 0xe6, 0x42,
+	0x4e, 0x22, 0x42, 0xd9, 0x8a, 0x18, 0x3a, 0xff, 0xab, 0xf5, 0xe8, 0x8e, 0xd7, 0xe3, 0x3b, 0x5e,
+	0x6f, 0x52, 0xda, 0x3f, 0x71, 0xfa, 0x21, 0xc1, 0xb5, 0x90, 0x13, 0x45, 0x9d, 0x43, 0xa9, 0x43,
+	0xdf, 0xc1, 0x8c, 0xa6, 0xaf, 0x59, 0x52, 0xb6, 0x9f, 0x67, 0xb3, 0xe7, 0x20, 0x02, 0xe1, 0x18,
+	0x8d, 0x7e, 0x96, 0xa8, 0x7a, 0x59, 0x59, 0x7e, 0x76, 0xe5, 0xab, 0x47, 0xaa, 0xb3, 0x34, 0x0b,
+	0x92, 0x47, 0xe3, 0xb2, 0x37, 0x67, 0xa1, 0x1a, 0xbb, 0x3d, 0xbe, 0x0c, 0x88, 0xf5, 0x07, 0x03,
+	0x2a, 0x89, 0x74, 0xa1, 0x4d, 0x28, 0xcb, 0xfc, 0x9e, 0x52, 0x2e, 0xb8, 0x69, 0xa8, 0xb4, 0x2c,
+	0x5d, 0x49, 0x6e, 0x9b, 0x72, 0x81, 0x4b, 0x22, 0x5a, 0x70, 0xb4, 0x35, 0x79, 0x8e, 0xf5, 0x6b,
+	0xcb, 0x71, 0xe5, 0x28, 0xf7, 0xa0, 0x22, 0xa9, 0x6c, 0x07, 0x8c, 0x74, 0xfd, 0x0b, 0xc5, 0x98,
+	0x32, 0x06, 0x29, 0x3a, 0x54, 0x12, 0xeb, 0xf7, 0x06, 0xcc, 0xe8, 0x4f, 0x66, 0x72, 0xf2, 0x11,
+	0x54, 0x3c, 0xc2, 0x85, 0x3f, 0x54, 0x85, 0x31, 0x73, 0x59, 0x7c, 0xc0, 0x34, 0x14, 0x64, 0xdb,
+	0x95, 0x00, 0x9c, 0x44, 0xa3, 0x87, 0x00, 0x63, 0x36, 0x98, 0xf9, 0x38, 0x95, 0xd9, 0x2c, 0x28,
+	0x8f, 0x58, 0x60, 0xfd, 0xc9, 0x80, 0x6a, 0x3b, 0x4d, 0xcb, 0xda, 0x99, 0xcf, 0x44, 0xe8, 0xf4,
+	0x53, 0xa9, 0x9b, 0x88, 0xe3, 0x24, 0x82, 0xa8, 0xf4, 0x55, 0xcf, 0xc6, 0x1b, 0x8e, 0x1e, 0x8d,
+	0x53, 0x18, 0x9d, 0xe0, 0x8b, 0xeb, 0xef, 0xc4, 0xc7, 0xe7, 0xf0, 0x9f, 0x06, 0x54, 0x12, 0xdf,
+	0xce, 0xcc, 0xa3, 0x09, 0x33, 0x1e, 0x1d, 0x38, 0xfe, 0x30, 0x6a, 0x65, 0x65, 0x1c, 0x6f, 0xd1,
+	0x8f, 0xa0, 0xc8, 0x64, 0x02, 0xb9, 0x99, 0x57, 0x87, 0xba, 0x93, 0x91, 0x5c, 0xac, 0x21, 0x49,
+	0x2e, 0x14, 0xb2, 0xb8, 0x90, 0x08, 0xe3, 0x46, 0x5a, 0x17, 0x3f, 0x8a, 0xd6, 0xd6, 0x5f, 0xf3,
+	0x30, 0xad, 0x02, 0x41, 0xbf, 0x80, 0x52, 0xdc, 0xb0, 0x75, 0x11, 0xee, 0xd7, 0x63, 0x41, 0xd4,
+	0x1a, 0x53, 0xf1, 0xec, 0x45, 0x2a, 0x3c, 0x32, 0x92, 0x1d, 0x46, 0x9d, 0xc5, 0x76, 0xdc, 0x0f,
+	0x62, 0x94, 0xec, 0x30, 0x6c, 0xbc, 0x45, 0x4f, 0x61, 0x8e, 0x11, 0xcf, 0x67, 0xc4, 0x15, 0xb1,
+	0x8b, 0x88, 0x58, 0x77, 0x27, 0x5c, 0x68, 0xd0, 0xc8, 0xcb, 0x2c, 0x4b, 0x49, 0xd0, 0x2b, 0x58,
+	0xd6, 0x6e, 0x18, 0xe1, 0x01, 0x1d, 0xf2, 0x51, 0x48, 0x51, 0x66, 0xad, 0xb4, 0xbf, 0x5d, 0x85,
+	0xc5, 0x1a, 0x3a, 0xf2, 0xba, 0xe8, 0x65, 0xc8, 0xd1, 0xb7, 0xe3, 0x32, 0x4d, 0x67, 0xf5, 0x60,
+	0x75, 0xbe, 0x4f, 0x58, 0xa0, 0x11, 0xe5, 0x66, 0xc6, 0x94, 0x6b, 0x96, 0xa0, 0x18, 0x1d, 0xc8,
+	0xfa, 0x73, 0x0e, 0x2a, 0x89, 0x94, 0xa2, 0x6f, 0xa0, 0xc8, 0xfd, 0x61, 0xaf, 0x1f, 0x51, 0xf4,
+	0x4a, 0xf6, 0x77, 0xc7, 0x57, 0xb8, 0x3d, 0x85, 0x35, 0x14, 0x3d, 0x84, 0xe9, 0x41, 0xd8, 0x17,
+	0xbe, 0xae, 0xd8, 0xda, 0x44, 0xa1, 0xa5, 0x2a, 0x6d, 0x18, 0xc1, 0x51, 0x13, 0x66, 0xc3, 0x80,
+	0x0b, 0x46, 0x9c, 0x81, 0xdd, 0x63, 0x34, 0x0c, 0x74, 0xbd, 0x56, 0xd2, 0x2f, 0x29, 0x26, 0x9c,
+	0x86, 0xcc, 0x25, 0x98, 0x74, 0xdb, 0x53, 0xb8, 0x16, 0x9b, 0x3c, 0x95, 0x16, 0xe8, 0x37, 0xb0,
+	0x94, 0x39, 0x6b, 0xe8, 0x52, 0x6d, 0xd4, 0xbd, 0x6e, 0x50, 0x8f, 0x87, 0x9a, 0x54, 0x5c, 0x87,
+	0x84, 0xa9, 0x93, 0x47, 0x9d, 0xa5, 0x3d, 0x85, 0xef, 0x68, 0x47, 0x4f, 0x22, 0x3f, 0xea, 0xbd,
+	0x68, 0xd6, 0x52, 0x5d, 0xce, 0xfa, 0x7b, 0x0e, 0x2a, 0x89, 0xb3, 0xa0, 0xef, 0xa0, 0x14, 0xc7,
+	0x63, 0xc2, 0xed, 0xc1, 0x8f, 0xc0, 0xe8, 0x31, 0x14, 0x5e, 0x87, 0x1d, 0x62, 0x56, 0x94, 0xd1,
+	0x0f, 0xd3, 0xa1, 0x3d, 0x0b, 0x3b, 0x84, 0x0d, 0x89, 0x20, 0xfc, 0x88, 0xb0, 0x33, 0xdf, 0x25,
+	0xe9, 0xf4, 0x29, 0x4b, 0xf4, 0x18, 0x8a, 0x2e, 0x1d, 0xf2, 0xb0, 0x6f, 0x56, 0x95, 0x8f, 0xaf,
+	0xd2, 0x3e, 0x76, 0x94, 0x2e, 0xd3, 0x5e, 0xdb, 0xa1, 0x36, 0xcc, 0x27, 0xce, 0x66, 0xf3, 0x80,
+	0xb8, 0x66, 0x2e, 0xeb, 0x3d, 0x4c, 0x98, 0x1f, 0x05, 0xc4, 0xc5, 0x73, 0x5e, 0x5a, 0x80, 0x7e,
+	0x0c, 0xc5, 0x68, 0xc0, 0xd4, 0x15, 0x5c, 0x9c, 0x68, 0xe5, 0x4a, 0x87, 0x35, 0xa6, 0x89, 0xd2,
+	0xdf, 0x15, 0xf2, 0x39, 0x24, 0x70, 0xf7, 0xa6, 0x53, 0xa3, 0x07, 0x90, 0x67, 0xa4, 0x6b, 0x1a,
+	0xb7, 0xe4, 0x58, 0x4f, 0x5b, 0x12, 0x2b, 0x99, 0xaf, 0x86, 0xa1, 0x9c, 0x1a, 0x86, 0xd4, 0xda,
+	0x12, 0x60, 0x5e, 0x97, 0x18, 0x39, 0x64, 0xf1, 0x48, 0x6a, 0x27, 0x9a, 0x74, 0x45, 0xcb, 0xf6,
+	0x65, 0xaf, 0x46, 0x50, 0x10, 0x4e, 0x2f, 0x6e, 0xd4, 0x6a, 0x2d, 0xcd, 0xe4, 0x45, 0xb3, 0x5d,
+	0x32, 0x14, 0x84, 0x45, 0xbd, 0xba, 0x8c, 0x2b, 0x52, 0xb6, 0x13, 0x89, 0xac, 0xff, 0x1a, 0x50,
+	0x7b, 0x91, 0xa2, 0x6d, 0x0b, 0xaa, 0x89, 0x14, 0xc4, 0x0d, 0x73, 0xe2, 0xed, 0xf9, 0x35, 0xf1,
+	0x7b, 0xa7, 0x82, 0x78, 0x89, 0x20, 0x71, 0xca, 0xec, 0xff, 0x65, 0xa4, 0x5d, 0x79, 0xf3, 0xbe,
+	0xb0, 0x04, 0xb9, 0xb0, 0x87, 0xe6, 0xd2, 0x17, 0x9a, 0x5b, 0x2f, 0x61, 0x7e, 0xb2, 0x01, 0x7c,
+	0xa2, 0xc3, 0x5b, 0x7f, 0x31, 0xe0, 0x4e, 0x06, 0x6a, 0x72, 0x30, 0xb9, 0xad, 0x91, 0xa5, 0x07,
+	0x93, 0x65, 0x28, 0x9e, 0x2b, 0x9f, 0x9a, 0x36, 0x7a, 0x87, 0x9a, 0xe3, 0xbe, 0x9d, 0xd7, 0x9d,
+	0xe5, 0xb6, 0x70, 0x27, 0xbb, 0xb8, 0xf5, 0x36, 0x0f, 0xb3, 0xe9, 0xc7, 0x07, 0xdd, 0x87, 0x9a,
+	0x1c, 0x5b, 0xec, 0xf8, 0x05, 0xd2, 0xa4, 0xab, 0x4a, 0x61, 0x0c, 0x45, 0x5f, 0x42, 0x2d, 0x70,
+	0xc4, 0xe9, 0x18, 0xa4, 0xc6, 0x7f, 0x39, 0xa1, 0x4b, 0xf1, 0x08, 0xf6, 0x35, 0xcc, 0x46, 0x83,
+	0x88, 0xcd, 0xc8, 0x39, 0xf3, 0x05, 0x31, 0xa7, 0x35, 0xae, 0x16, 0xc9, 0x71, 0x24, 0x46, 0x27,
+	0x50, 0x1b, 0x3d, 0x6c, 0x2e, 0xf5, 0x88, 0x3a, 0xd1, 0xec, 0xe6, 0x83, 0x9b, 0x9e, 0xc9, 0xd1,
+	0x36, 0x7e, 0xcf, 0x76, 0xa8, 0x47, 0x70, 0x95, 0x25, 0x76, 0xe8, 0x4b, 0x98, 0x95, 0x3f, 0x19,
+	0xf8, 0x38, 0x50, 0xd9, 0x84, 0x4b, 0x58, 0xfd, 0xf6, 0xe0, 0xa3, 0x38, 0xd5, 0xd4, 0xc4, 0xfc,
+	0xc0, 0xfe, 0x5d, 0x48, 0xd8, 0xa5, 0x62, 0x6e, 0x49, 0x4e, 0x4d, 0xcc, 0x0f, 0x7e, 0x25, 0x25,
+	0xd6, 0x39, 0x2c, 0x66, 0x7d, 0x0d, 0x2d, 0xc1, 0xc2, 0xde, 0xc1, 0x49, 0x6b, 0xd7, 0x3e, 0x6c,
+	0xe1, 0xbd, 0xed, 0xfd, 0xd6, 0xfe, 0xf1, 0xf3, 0x97, 0xf3, 0x53, 0xa8, 0x0c, 0xd3, 0x4f, 0x0e,
+	0x5e, 0xec, 0xef, 0xce, 0x1b, 0xa8, 0x06, 0xe5, 0xa3, 0x56, 0xcb, 0x3e, 0x38, 0x6e, 0xb7, 0xf0,
+	0x7c, 0x0e, 0x2d, 0x03, 0x3a, 0x6e, 0xed, 0x1d, 0x1e, 0xe0, 0x6d, 0xfc, 0xd2, 0xc6, 0xad, 0xdd,
+	0x5f, 0xe2, 0xd6, 0xce, 0xf1, 0x7c, 0x5e, 0xca, 0x47, 0x2e, 0xc6, 0xf2, 0x42, 0xd3, 0x84, 0x65,
+	0x9d, 0x68, 0x95, 0x28, 0xd5, 0x11, 0xfd, 0xae, 0x4f, 0x98, 0xd5, 0x84, 0xc5, 0xac, 0x67, 0x5e,
+	0xd2, 0x45, 0x5f, 0x40, 0x23, 0xa2, 0x4b, 0xb4, 0x93, 0x8d, 0xa2, 0x43, 0xbd, 0x4b, 0xfd, 0x43,
+	0x4d, 0xad, 0x9b, 0x5b, 0xf2, 0x1a, 0xfe, 0xf1, 0x5f, 0x6b, 0xc6, 0xab, 0x9f, 0x7e, 0xd8, 0xbf,
+	0x1e, 0xc1, 0xeb, 0x9e, 0xfe, 0x61, 0xdc, 0x29, 0xaa, 0x67, 0xfe, 0x9b, 0xff, 0x0d, 0x00, 0x6f,
+	0x3e, 0x0f, 0xe8, 0x30, 0x11, 0x00, 0x00,
 }
 
 func (this *Proxy) Equal(that interface{}) bool {
@@ -2009,6 +2026,30 @@ func (this *RouteAction_UpstreamGroup) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RouteAction_DynamicForwardProxy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteAction_DynamicForwardProxy)
+	if !ok {
+		that2, ok := that.(RouteAction_DynamicForwardProxy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DynamicForwardProxy.Equal(that1.DynamicForwardProxy) {
+		return false
+	}
+	return true
+}
 func (this *Destination) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
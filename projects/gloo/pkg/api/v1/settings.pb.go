@@ -897,10 +897,15 @@ type Settings_ConsulConfiguration struct {
 	WaitTime *types.Duration `protobuf:"bytes,11,opt,name=wait_time,json=waitTime,proto3" json:"wait_time,omitempty"`
 	// Enable Service Discovery via Consul with this field
 	// set to empty struct `{}` to enable with defaults
-	ServiceDiscovery     *Settings_ConsulConfiguration_ServiceDiscoveryOptions `protobuf:"bytes,12,opt,name=service_discovery,json=serviceDiscovery,proto3" json:"service_discovery,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
-	XXX_unrecognized     []byte                                                `json:"-"`
-	XXX_sizecache        int32                                                 `json:"-"`
+	ServiceDiscovery *Settings_ConsulConfiguration_ServiceDiscoveryOptions `protobuf:"bytes,12,opt,name=service_discovery,json=serviceDiscovery,proto3" json:"service_discovery,omitempty"`
+	// The name of the static cluster of the proxies that points at the SDS server serving the Consul Connect
+	// certificates. Required to originate Consul Connect mTLS to upstreams with `connectEnabled`, which are
+	// rejected if it is not set. The proxies installed with the `global.consulConnect.enabled` helm value
+	// name it `gateway_proxy_sds`.
+	ConnectSdsClusterName string   `protobuf:"bytes,16,opt,name=connect_sds_cluster_name,json=connectSdsClusterName,proto3" json:"connect_sds_cluster_name,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *Settings_ConsulConfiguration) Reset()         { *m = Settings_ConsulConfiguration{} }
@@ -1033,6 +1038,13 @@ func (m *Settings_ConsulConfiguration) GetServiceDiscovery() *Settings_ConsulCon
 	return nil
 }
 
+func (m *Settings_ConsulConfiguration) GetConnectSdsClusterName() string {
+	if m != nil {
+		return m.ConnectSdsClusterName
+	}
+	return ""
+}
+
 // service discovery options for Consul
 type Settings_ConsulConfiguration_ServiceDiscoveryOptions struct {
 	// Use this parameter to restrict the data centers that will be considered when discovering and routing to
@@ -1081,10 +1093,18 @@ func (m *Settings_ConsulConfiguration_ServiceDiscoveryOptions) GetDataCenters()
 // Provides overrides for the default configuration parameters used to interact with Kubernetes.
 type Settings_KubernetesConfiguration struct {
 	// Rate limits for the kubernetes clients
-	RateLimits           *Settings_KubernetesConfiguration_RateLimits `protobuf:"bytes,1,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	RateLimits *Settings_KubernetesConfiguration_RateLimits `protobuf:"bytes,1,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	// Populate the locality of the endpoints of kubernetes upstreams from the topology labels
+	// (`topology.kubernetes.io/region` and `topology.kubernetes.io/zone`, or their deprecated
+	// `failure-domain.beta.kubernetes.io` equivalents) of the nodes their pods are scheduled on.
+	// The subzone is read from the `topology.gloo.solo.io/subzone` label.
+	// Requires a ClusterRole that allows to list and watch nodes, which the helm chart only grants when
+	// `global.glooRbac.namespaced` is false. Without it, a warning is logged and the locality of the endpoints
+	// is not populated.
+	EndpointLocalityFromNodeLabels bool     `protobuf:"varint,2,opt,name=endpoint_locality_from_node_labels,json=endpointLocalityFromNodeLabels,proto3" json:"endpoint_locality_from_node_labels,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{} `json:"-"`
+	XXX_unrecognized               []byte   `json:"-"`
+	XXX_sizecache                  int32    `json:"-"`
 }
 
 func (m *Settings_KubernetesConfiguration) Reset()         { *m = Settings_KubernetesConfiguration{} }
@@ -1118,6 +1138,13 @@ func (m *Settings_KubernetesConfiguration) GetRateLimits() *Settings_KubernetesC
 	return nil
 }
 
+func (m *Settings_KubernetesConfiguration) GetEndpointLocalityFromNodeLabels() bool {
+	if m != nil {
+		return m.EndpointLocalityFromNodeLabels
+	}
+	return false
+}
+
 type Settings_KubernetesConfiguration_RateLimits struct {
 	// The maximum queries-per-second Gloo can make to the Kubernetes API Server.
 	QPS float32 `protobuf:"fixed32,1,opt,name=QPS,proto3" json:"QPS,omitempty"`
@@ -1593,149 +1620,153 @@ func init() {
 }
 
 var fileDescriptor_bd7533c2495e1752 = []byte{
-	// 2271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x53, 0x23, 0xc7,
-	0x19, 0x5e, 0xb1, 0xec, 0x22, 0xbd, 0xe2, 0x43, 0x34, 0x18, 0x06, 0xc1, 0x02, 0x26, 0x71, 0xb2,
-	0x76, 0xca, 0x92, 0xb3, 0x76, 0x1c, 0xc7, 0x1f, 0x71, 0x21, 0x01, 0x86, 0xc0, 0x3a, 0x78, 0x84,
-	0x77, 0xab, 0x5c, 0xa9, 0x4c, 0xb5, 0x66, 0x5a, 0xa2, 0xa3, 0xd1, 0xf4, 0x54, 0x77, 0x4b, 0x20,
-	0xdf, 0xe2, 0x7b, 0x4e, 0xa9, 0xfc, 0x87, 0x54, 0xf9, 0x0f, 0xe4, 0x27, 0x24, 0xc7, 0x5c, 0x72,
-	0x8b, 0x0f, 0xf9, 0x07, 0x4e, 0x55, 0xee, 0xa9, 0xfe, 0x98, 0x0f, 0x09, 0xc4, 0xe2, 0x8b, 0x6a,
-	0xba, 0xdf, 0xe7, 0x79, 0x7a, 0xe6, 0xed, 0x7e, 0x3f, 0x5a, 0xf0, 0x51, 0x97, 0xca, 0xcb, 0x41,
-	0xbb, 0xe6, 0xb3, 0x7e, 0x5d, 0xb0, 0x90, 0xbd, 0x4d, 0x59, 0xbd, 0x1b, 0x32, 0x56, 0x8f, 0x39,
-	0xfb, 0x03, 0xf1, 0xa5, 0x30, 0x23, 0x1c, 0xd3, 0xfa, 0xf0, 0xe7, 0x75, 0x41, 0xa4, 0xa4, 0x51,
-	0x57, 0xd4, 0x62, 0xce, 0x24, 0x43, 0xf3, 0xca, 0x56, 0x53, 0xb4, 0x1a, 0x65, 0xd5, 0xd5, 0x2e,
-	0xeb, 0x32, 0x6d, 0xa8, 0xab, 0x27, 0x83, 0xa9, 0x22, 0x72, 0x2d, 0xcd, 0x24, 0xb9, 0x96, 0x76,
-	0x6e, 0x5b, 0xaf, 0xd4, 0xa3, 0x32, 0xd1, 0xed, 0x13, 0x89, 0x03, 0x2c, 0xb1, 0xb5, 0x6f, 0x4d,
-	0xda, 0x85, 0xc4, 0x72, 0x20, 0xa6, 0xb1, 0x93, 0xb1, 0xb5, 0xbf, 0x35, 0xfd, 0xfd, 0xc9, 0xb5,
-	0x24, 0x91, 0xa0, 0x2c, 0x4a, 0xb4, 0x8e, 0xee, 0xc0, 0x46, 0x92, 0xf0, 0x98, 0x53, 0x41, 0xea,
-	0x2c, 0x96, 0x8a, 0x53, 0xe7, 0x58, 0x92, 0x90, 0xf6, 0xa9, 0xcc, 0x9e, 0xac, 0xce, 0xe1, 0x0f,
-	0xd2, 0x21, 0xd7, 0x12, 0x0f, 0xe4, 0xa5, 0x7d, 0x23, 0xf5, 0x68, 0x65, 0x3e, 0xfe, 0x61, 0xaf,
-	0xd3, 0xc6, 0xbe, 0xfe, 0xb1, 0xec, 0x3b, 0x36, 0xce, 0xa7, 0xdc, 0x1f, 0x50, 0xe9, 0xb5, 0x39,
-	0xc1, 0x3d, 0xc2, 0x13, 0x4f, 0x76, 0x19, 0xeb, 0x86, 0xa4, 0xae, 0x47, 0xed, 0x41, 0xa7, 0x1e,
-	0x0c, 0x38, 0x56, 0xda, 0xd3, 0xec, 0x57, 0x1c, 0xc7, 0x31, 0xe1, 0xd6, 0x7b, 0x7b, 0x7f, 0x7a,
-	0x02, 0xc5, 0x96, 0x3d, 0x12, 0xa8, 0x0e, 0x2b, 0x01, 0x15, 0x3e, 0x1b, 0x12, 0x3e, 0xf2, 0x22,
-	0xdc, 0x27, 0x22, 0xc6, 0x3e, 0x71, 0x0a, 0xbb, 0x85, 0xa7, 0x25, 0x17, 0xa5, 0xa6, 0xcf, 0x13,
-	0x0b, 0x7a, 0x13, 0x2a, 0x57, 0x58, 0xfa, 0x97, 0x19, 0x58, 0x38, 0x33, 0xbb, 0x0f, 0x9f, 0x96,
-	0xdc, 0x25, 0x3d, 0x9f, 0x22, 0x05, 0xc2, 0xe0, 0xf4, 0x06, 0x6d, 0xc2, 0x23, 0x22, 0x89, 0xf0,
-	0x7c, 0x16, 0x75, 0x68, 0xd7, 0x13, 0x6c, 0xc0, 0x7d, 0xe2, 0xcc, 0xee, 0x16, 0x9e, 0x96, 0x9f,
-	0xbd, 0x51, 0xcb, 0x9f, 0xc5, 0x5a, 0xf2, 0x56, 0xb5, 0xd3, 0x94, 0xd6, 0xe4, 0x81, 0x38, 0x7e,
-	0xe0, 0xae, 0x65, 0x42, 0x4d, 0xad, 0xd3, 0xd2, 0x32, 0xe8, 0x2b, 0x58, 0x0f, 0x28, 0x27, 0xbe,
-	0x64, 0x7c, 0x34, 0xb1, 0xc2, 0x23, 0xbd, 0xc2, 0xee, 0x94, 0x15, 0x0e, 0x12, 0xd6, 0xf1, 0x03,
-	0xf7, 0xb5, 0x54, 0x62, 0x4c, 0xfb, 0x14, 0x2a, 0x3e, 0x8b, 0xc4, 0x20, 0xf4, 0x7a, 0xc3, 0x44,
-	0xf4, 0x35, 0x2d, 0xba, 0x33, 0x45, 0xb4, 0xa9, 0xe1, 0xa7, 0xc3, 0xe3, 0x07, 0xee, 0xa2, 0x6f,
-	0x9f, 0xad, 0x58, 0x30, 0xe6, 0x0b, 0x41, 0x7c, 0x4e, 0x64, 0x22, 0xfa, 0x58, 0x8b, 0x3e, 0x7d,
-	0xa5, 0x2f, 0x5a, 0x9a, 0x25, 0x8e, 0x0b, 0x79, 0x77, 0x98, 0x49, 0xbb, 0xca, 0x97, 0xb0, 0x32,
-	0xc4, 0x83, 0x50, 0x4e, 0x2c, 0x30, 0xa7, 0x17, 0xf8, 0xd1, 0x94, 0x05, 0x5e, 0x28, 0x46, 0xa6,
-	0xbd, 0x3c, 0xcc, 0xc6, 0xb7, 0x79, 0x79, 0x5c, 0xba, 0x78, 0x4f, 0x2f, 0x17, 0x72, 0x5e, 0x1e,
-	0xd3, 0xee, 0x41, 0x35, 0xe7, 0x18, 0xcc, 0x25, 0xed, 0x60, 0x3f, 0x95, 0x2f, 0x69, 0xf9, 0x9f,
-	0xbd, 0xfa, 0x98, 0xe8, 0x8d, 0xeb, 0xe3, 0x58, 0x1c, 0xcf, 0xb8, 0x39, 0x4f, 0xef, 0x5b, 0x3d,
-	0xbb, 0xd8, 0xef, 0x61, 0x23, 0xfb, 0x90, 0xc9, 0xb5, 0xe0, 0x9e, 0x9f, 0x32, 0xe3, 0x66, 0xde,
-	0x98, 0xd0, 0xff, 0x1d, 0x6c, 0x64, 0x47, 0x66, 0x52, 0x7f, 0xfd, 0x7e, 0x67, 0x67, 0xc6, 0x5d,
-	0x4b, 0xce, 0xce, 0x84, 0xfa, 0xc7, 0x30, 0xcf, 0x49, 0x87, 0x13, 0x71, 0xe9, 0xa9, 0x4c, 0xe6,
-	0xcc, 0x6b, 0xc1, 0x8d, 0x9a, 0x89, 0xf7, 0x5a, 0x12, 0xef, 0xb5, 0x03, 0x9b, 0x0f, 0xdc, 0xb2,
-	0x85, 0xbb, 0x58, 0x12, 0xb4, 0x01, 0xc5, 0x80, 0x0c, 0xbd, 0x3e, 0x0b, 0x88, 0xb3, 0xb0, 0x5b,
-	0x78, 0x5a, 0x74, 0xe7, 0x02, 0x32, 0x7c, 0xce, 0x02, 0x82, 0x1c, 0x98, 0x0b, 0x69, 0xd4, 0x23,
-	0x3c, 0x70, 0x96, 0x8d, 0xc5, 0x0e, 0xd1, 0xa7, 0x30, 0xd7, 0x8b, 0xb0, 0xa4, 0x43, 0xe2, 0xa0,
-	0xbb, 0x23, 0xd6, 0xa0, 0x7e, 0x6b, 0x92, 0x9c, 0x9b, 0xb0, 0xd0, 0x21, 0x94, 0xd2, 0x24, 0xe2,
-	0xac, 0x68, 0x89, 0x9f, 0x4e, 0xf5, 0xb0, 0xc5, 0x25, 0x22, 0x19, 0x13, 0xbd, 0x0d, 0xb3, 0x8a,
-	0xe4, 0x38, 0xc9, 0x27, 0xe7, 0x15, 0x3e, 0x0b, 0x19, 0x4b, 0x38, 0x1a, 0x86, 0xde, 0x87, 0xb9,
-	0x2e, 0x96, 0xe4, 0x0a, 0x8f, 0x9c, 0x0d, 0xcd, 0xd8, 0x9a, 0x60, 0x18, 0x63, 0xfa, 0xb6, 0x16,
-	0x8c, 0x1a, 0xf0, 0xd8, 0xf8, 0xde, 0x59, 0xd5, 0xb4, 0xb7, 0xee, 0xdc, 0x2c, 0x73, 0xe8, 0x12,
-	0x67, 0x5b, 0x26, 0xfa, 0x1c, 0x20, 0x3b, 0x7f, 0xce, 0x9a, 0xd6, 0xa9, 0xdd, 0xf3, 0x00, 0x27,
-	0x5a, 0x39, 0x05, 0xf4, 0x01, 0x40, 0x56, 0x00, 0x9d, 0x8a, 0xd6, 0x73, 0xc6, 0xf5, 0x0e, 0x53,
-	0xbb, 0x9b, 0xc3, 0xa2, 0xe7, 0x50, 0x4a, 0x2b, 0x9e, 0x53, 0xd5, 0xc4, 0x7a, 0x2d, 0xab, 0x81,
-	0xb6, 0x20, 0x4d, 0xbe, 0x1a, 0x1f, 0x52, 0x9f, 0x24, 0x6f, 0xe8, 0x66, 0x0a, 0xa8, 0x05, 0x95,
-	0x74, 0xe0, 0x09, 0xc2, 0x87, 0x84, 0x3b, 0x9b, 0x36, 0x75, 0xbd, 0x52, 0xd5, 0xca, 0x2d, 0xa5,
-	0xc0, 0x96, 0x16, 0x40, 0xbf, 0x84, 0x59, 0x55, 0x0b, 0x9d, 0x2d, 0x9b, 0xa2, 0x74, 0x61, 0xbc,
-	0x5b, 0x43, 0x13, 0xd0, 0x47, 0x30, 0x67, 0xab, 0xb0, 0xf3, 0x44, 0x73, 0x5f, 0xaf, 0x65, 0xc5,
-	0x76, 0x0a, 0x33, 0x61, 0xa0, 0x0f, 0xa0, 0x98, 0x34, 0x2f, 0xce, 0xa2, 0x66, 0xaf, 0xd5, 0x7c,
-	0xc6, 0x49, 0x4a, 0x79, 0x6e, 0xad, 0x8d, 0xd9, 0xbf, 0x7f, 0xb7, 0xf3, 0xc0, 0x4d, 0xd1, 0xe8,
-	0x14, 0x1e, 0x9b, 0xb6, 0xc6, 0x59, 0xd2, 0xbc, 0xd5, 0x71, 0x5e, 0x4b, 0xdb, 0x1a, 0x4f, 0xfe,
-	0xf6, 0xbf, 0xd9, 0x82, 0x62, 0xfe, 0xf7, 0xbb, 0x9d, 0x65, 0x49, 0x84, 0x0c, 0x68, 0xa7, 0xf3,
-	0xe1, 0x1e, 0xed, 0x46, 0x8c, 0x93, 0x3d, 0xd7, 0x4a, 0x54, 0x2b, 0xb0, 0x38, 0x5e, 0xe9, 0xaa,
-	0x2b, 0xb0, 0x7c, 0x23, 0xdf, 0x57, 0xbf, 0x9d, 0x81, 0xf9, 0x7c, 0x92, 0x46, 0xab, 0xf0, 0x48,
-	0xb2, 0x1e, 0x89, 0x6c, 0x99, 0x36, 0x03, 0x15, 0xc5, 0x38, 0x08, 0x38, 0x11, 0xaa, 0x20, 0xab,
-	0xf9, 0x64, 0x88, 0xd6, 0x61, 0xce, 0xc7, 0x9e, 0x4f, 0xb8, 0x74, 0x1e, 0x6a, 0xcb, 0x63, 0x1f,
-	0x37, 0x09, 0x97, 0xd6, 0x10, 0x63, 0x79, 0xa9, 0x0b, 0xb2, 0x36, 0x9c, 0x63, 0x79, 0x89, 0x76,
-	0xa0, 0xec, 0x87, 0x94, 0x44, 0xd2, 0xb0, 0x1e, 0x69, 0x23, 0x98, 0x29, 0xcd, 0x7c, 0x02, 0x76,
-	0xe4, 0xf5, 0xc8, 0x48, 0x57, 0xb0, 0x92, 0x5b, 0x32, 0x33, 0xa7, 0x64, 0x84, 0x7e, 0x02, 0x4b,
-	0x32, 0x14, 0xf6, 0x94, 0xe8, 0x56, 0x41, 0x17, 0xa1, 0x92, 0xbb, 0x20, 0x43, 0x61, 0xb6, 0x5e,
-	0x35, 0x0a, 0xe8, 0x7d, 0x28, 0xd2, 0x48, 0x10, 0x7f, 0xc0, 0x93, 0x52, 0x52, 0xbd, 0x91, 0xce,
-	0x1a, 0x8c, 0x85, 0x2f, 0x70, 0x38, 0x20, 0x6e, 0x8a, 0x55, 0xc9, 0x8c, 0x33, 0x66, 0x16, 0x2f,
-	0x99, 0x8f, 0x55, 0xe3, 0x53, 0x32, 0xaa, 0xbe, 0x01, 0xc5, 0x24, 0x97, 0x8e, 0xc1, 0x0a, 0xe3,
-	0xb0, 0x35, 0x58, 0xbd, 0xad, 0x7c, 0x54, 0xdf, 0x84, 0x52, 0x9a, 0xea, 0xd1, 0x96, 0xca, 0x5e,
-	0x76, 0x60, 0x05, 0xb2, 0x89, 0xea, 0xbf, 0x0b, 0xb0, 0x38, 0x9e, 0xf7, 0xd0, 0x3e, 0x3c, 0xf1,
-	0xc3, 0x81, 0x90, 0x84, 0x7b, 0x34, 0xea, 0x2a, 0xe7, 0x7b, 0x31, 0x67, 0xd7, 0x23, 0x2f, 0xd9,
-	0x19, 0x23, 0x52, 0xb5, 0xa0, 0x13, 0x83, 0x39, 0x57, 0x90, 0x7d, 0xbb, 0x59, 0x4d, 0xd8, 0xb6,
-	0xc9, 0xd3, 0x53, 0xb1, 0xcc, 0x23, 0x1c, 0x4e, 0x68, 0x98, 0xdd, 0xdd, 0xb4, 0xa8, 0x43, 0x0b,
-	0x9a, 0x26, 0x42, 0xa3, 0x5b, 0x45, 0x1e, 0x8e, 0x89, 0x9c, 0x44, 0x37, 0x45, 0xaa, 0x7f, 0x29,
-	0x40, 0x65, 0x32, 0x29, 0xa3, 0xdf, 0x40, 0xb1, 0x13, 0x08, 0x53, 0x46, 0xd4, 0xc7, 0x2c, 0x3e,
-	0xab, 0xdf, 0x33, 0x9f, 0xd7, 0x8e, 0x02, 0xa1, 0xca, 0x8d, 0x3b, 0xd7, 0x31, 0x0f, 0x7b, 0xbf,
-	0x80, 0x39, 0x3b, 0x87, 0x16, 0xa0, 0xd4, 0x38, 0xdb, 0x6f, 0x9e, 0x9e, 0x9d, 0xb4, 0x2e, 0x2a,
-	0x0f, 0xd4, 0xf0, 0xe5, 0xf1, 0xc9, 0xc5, 0xa1, 0x1e, 0x16, 0xd0, 0x3c, 0x14, 0x0f, 0x4e, 0x5a,
-	0xfb, 0x8d, 0xb3, 0xc3, 0x83, 0xca, 0x4c, 0xf5, 0x9f, 0x8f, 0x60, 0xe5, 0x96, 0x0c, 0x8c, 0xb6,
-	0xb2, 0x00, 0xd0, 0x6e, 0x6e, 0xcc, 0x38, 0x85, 0x2c, 0x08, 0x5e, 0x87, 0xf9, 0x4b, 0x29, 0xe3,
-	0xd4, 0x01, 0x0b, 0xda, 0x01, 0x65, 0x35, 0x97, 0x78, 0x6d, 0x07, 0xca, 0x41, 0x24, 0x52, 0xc4,
-	0xa2, 0x39, 0xf5, 0x41, 0x24, 0x12, 0xc0, 0x29, 0xac, 0x2a, 0x40, 0xcc, 0xc2, 0x90, 0x46, 0x5d,
-	0xe3, 0xda, 0x21, 0x0e, 0x6d, 0x2e, 0xb8, 0xa3, 0x12, 0xa3, 0x20, 0x12, 0xe7, 0x86, 0x75, 0x62,
-	0x49, 0x68, 0x1b, 0x40, 0xa5, 0x14, 0x5f, 0xa7, 0x2d, 0xbb, 0xa9, 0xb9, 0x19, 0x54, 0x85, 0xe2,
-	0x40, 0xa8, 0x5d, 0xe9, 0x13, 0xbb, 0x5b, 0xe9, 0x58, 0xd9, 0x62, 0x2c, 0xc4, 0x15, 0xe3, 0x81,
-	0x8d, 0xdc, 0x74, 0x9c, 0x65, 0x87, 0x47, 0xf9, 0xec, 0x60, 0x42, 0xbd, 0x43, 0x43, 0x62, 0xa3,
-	0xf5, 0xb1, 0x8f, 0x8f, 0x68, 0x48, 0xf2, 0x39, 0x60, 0x6e, 0x2c, 0x07, 0x6c, 0x42, 0x49, 0x05,
-	0xbf, 0xe1, 0x14, 0xcd, 0x22, 0x6a, 0x42, 0xb3, 0x36, 0xa0, 0xd8, 0x23, 0x23, 0x63, 0xb3, 0x01,
-	0xd8, 0x23, 0x23, 0x6d, 0x3a, 0x83, 0xd5, 0x24, 0x4e, 0x3d, 0xd1, 0xa3, 0xb1, 0x37, 0x24, 0x9c,
-	0x76, 0x46, 0xb6, 0xbf, 0xba, 0x2b, 0xbe, 0x51, 0xc2, 0x6b, 0xf5, 0x68, 0xfc, 0x42, 0xb3, 0xd0,
-	0xfb, 0x50, 0xba, 0xc2, 0x54, 0x7a, 0x92, 0xf6, 0x89, 0x53, 0x7e, 0x95, 0x9f, 0x8b, 0x0a, 0x7b,
-	0x41, 0xfb, 0x04, 0x31, 0x58, 0x16, 0xa6, 0x96, 0x79, 0x59, 0x03, 0x62, 0x3a, 0xa6, 0xc6, 0xfd,
-	0xab, 0x7a, 0x52, 0x0f, 0x6f, 0xf4, 0x26, 0x15, 0x31, 0x61, 0xa8, 0x7e, 0x0c, 0xeb, 0x53, 0xc0,
-	0xea, 0xe8, 0xa9, 0x7d, 0xf5, 0xcc, 0xc6, 0xaa, 0xd3, 0xa9, 0xee, 0x4b, 0x65, 0x35, 0xd7, 0x34,
-	0x53, 0xd5, 0x6f, 0x0b, 0xb0, 0x3e, 0xa5, 0x1b, 0x40, 0x5f, 0x41, 0x59, 0x95, 0x4d, 0x4f, 0xd7,
-	0x4d, 0x73, 0xb6, 0xcb, 0xcf, 0x7e, 0xf5, 0xc3, 0x5a, 0x8a, 0x9a, 0xea, 0x01, 0xcf, 0xb4, 0x80,
-	0x0b, 0x3c, 0x7d, 0xae, 0xbe, 0x07, 0x90, 0x59, 0x50, 0x05, 0x1e, 0x7e, 0x71, 0xde, 0xd2, 0x2b,
-	0xcc, 0xb8, 0xea, 0x51, 0x1d, 0xa6, 0xf6, 0x80, 0x0b, 0xa9, 0xcf, 0xe7, 0x82, 0x6b, 0x06, 0x1f,
-	0xae, 0x7d, 0xf3, 0xfd, 0xec, 0x2c, 0xcc, 0x08, 0xf9, 0xcd, 0xf7, 0xb3, 0x80, 0x8a, 0xc9, 0x1f,
-	0x0c, 0x8d, 0x25, 0x58, 0x18, 0xbb, 0x84, 0xa9, 0x89, 0xb1, 0xfb, 0x42, 0x63, 0x19, 0x96, 0x26,
-	0xfa, 0xe2, 0xbd, 0x3f, 0x17, 0xa1, 0x9c, 0x6b, 0xe1, 0xd0, 0x1e, 0x2c, 0x5c, 0x07, 0xc2, 0x6b,
-	0xd3, 0x28, 0xd0, 0xa1, 0x68, 0x73, 0x66, 0xf9, 0x3a, 0x10, 0x0d, 0x1a, 0x05, 0x2a, 0x16, 0xd1,
-	0x3b, 0xb0, 0x3a, 0xc4, 0x21, 0x0d, 0xf4, 0xb7, 0xe5, 0xa0, 0x26, 0x8a, 0x50, 0x66, 0x4b, 0x19,
-	0xcf, 0xa1, 0x32, 0x71, 0x9d, 0x36, 0x39, 0xb0, 0xfc, 0x6c, 0x6f, 0xdc, 0x93, 0x4d, 0x83, 0x6a,
-	0x18, 0x90, 0x71, 0xa2, 0xbb, 0xe4, 0x8f, 0xcd, 0x0a, 0xf4, 0x25, 0x6c, 0x90, 0x28, 0x88, 0x19,
-	0x8d, 0xa4, 0xf0, 0xae, 0x30, 0xef, 0xab, 0x7c, 0xa0, 0xce, 0x28, 0x1b, 0x48, 0x7b, 0xb9, 0xbd,
-	0xe3, 0x98, 0xae, 0xa7, 0xdc, 0x97, 0x86, 0x7a, 0x61, 0x98, 0xe8, 0x10, 0xca, 0xf8, 0x4a, 0x78,
-	0xb6, 0x01, 0xb2, 0x77, 0xd8, 0x1f, 0x4f, 0x6d, 0x77, 0x6b, 0xfb, 0x2f, 0x5b, 0xc9, 0x89, 0x04,
-	0x7c, 0x25, 0x12, 0x17, 0x62, 0x78, 0x8d, 0x46, 0xda, 0x09, 0xc9, 0xa5, 0x38, 0x66, 0x21, 0xf5,
-	0x47, 0xf6, 0xaa, 0xf9, 0xf6, 0x74, 0xc1, 0x13, 0x43, 0x33, 0x9f, 0x7d, 0xae, 0x49, 0xee, 0x0a,
-	0xbd, 0x39, 0x89, 0x8e, 0x60, 0x27, 0xa0, 0x02, 0xb7, 0x43, 0xe2, 0xe5, 0xee, 0x6f, 0x01, 0x11,
-	0x92, 0x46, 0xd8, 0xbc, 0xfd, 0x9c, 0xbe, 0x4b, 0x3c, 0xb1, 0xb0, 0xec, 0x60, 0x1e, 0xe4, 0x40,
-	0xe8, 0x00, 0x2a, 0x89, 0x4e, 0x97, 0xc7, 0xbe, 0x77, 0x45, 0xda, 0xf7, 0xe8, 0x04, 0x16, 0x2d,
-	0xe7, 0x33, 0x1e, 0xfb, 0x2f, 0x49, 0x1b, 0xf9, 0xb0, 0x9b, 0xa8, 0x98, 0x32, 0xd7, 0xc5, 0xbc,
-	0x8d, 0xbb, 0xc4, 0xf3, 0x59, 0x18, 0x12, 0x5f, 0x2d, 0x65, 0xef, 0x92, 0x77, 0xa9, 0x26, 0xaf,
-	0xaa, 0xab, 0xe0, 0x67, 0x46, 0xa1, 0x99, 0x0a, 0xa0, 0x2f, 0x60, 0x8d, 0x93, 0x2e, 0xb9, 0xf6,
-	0xfa, 0xf8, 0x5a, 0x2d, 0xd3, 0xe5, 0xb8, 0xef, 0x09, 0xfa, 0x75, 0x72, 0x75, 0xdc, 0xba, 0x21,
-	0xfd, 0xe5, 0x49, 0x24, 0xdf, 0x7d, 0x66, 0xc4, 0x57, 0x34, 0xf7, 0x39, 0xbe, 0x3e, 0x37, 0xcc,
-	0x16, 0xfd, 0x9a, 0x54, 0xcf, 0x00, 0xb2, 0x2d, 0x44, 0xbf, 0x86, 0x4d, 0x12, 0xe9, 0x8f, 0xf0,
-	0x39, 0x09, 0x48, 0x24, 0x29, 0x0e, 0x45, 0x92, 0xbe, 0x4c, 0x03, 0x52, 0x74, 0x37, 0x0c, 0xa4,
-	0x99, 0x21, 0x6c, 0xbe, 0x19, 0x55, 0xff, 0x51, 0x80, 0x95, 0x5b, 0x36, 0x10, 0xbd, 0xa7, 0x5e,
-	0x3c, 0x0e, 0xb1, 0xaf, 0xba, 0x01, 0x73, 0x2c, 0x38, 0x1b, 0xa8, 0xeb, 0x89, 0x91, 0x5c, 0xb5,
-	0x56, 0xcb, 0x75, 0xb5, 0x0d, 0x7d, 0x02, 0x9b, 0x63, 0x68, 0x8f, 0x13, 0x11, 0xb3, 0x48, 0x28,
-	0xa7, 0x06, 0xc4, 0x26, 0x04, 0x87, 0xe6, 0x38, 0xae, 0x05, 0x34, 0x55, 0x45, 0x9f, 0x4e, 0x6f,
-	0xb3, 0x60, 0x64, 0x2b, 0xda, 0xad, 0xf4, 0x06, 0x0b, 0x46, 0x7b, 0x7f, 0x7c, 0x04, 0x8b, 0xe3,
-	0xd7, 0x34, 0xf5, 0x19, 0xb9, 0xa0, 0xb7, 0xbd, 0x65, 0x2e, 0x43, 0xe4, 0x52, 0x82, 0x69, 0x31,
-	0x75, 0xe0, 0x7f, 0x0e, 0x90, 0xcd, 0xdb, 0x90, 0xaf, 0xdd, 0x75, 0x1d, 0xac, 0xbd, 0x48, 0xe1,
-	0x69, 0x6c, 0x65, 0x0a, 0xe8, 0x18, 0x5e, 0xe7, 0x04, 0x07, 0x9e, 0xbd, 0x33, 0x0a, 0xaf, 0xc3,
-	0x59, 0xdf, 0xc3, 0x61, 0x98, 0xff, 0x47, 0x6c, 0xd6, 0x1c, 0x7d, 0x05, 0xb4, 0xe2, 0xe2, 0x88,
-	0xb3, 0xfe, 0x7e, 0x18, 0xe6, 0xfe, 0x1f, 0x3b, 0x82, 0x6d, 0x1c, 0x6a, 0x09, 0xc1, 0xb8, 0xb4,
-	0x5e, 0x92, 0x7a, 0xff, 0xed, 0xf6, 0xa8, 0xf8, 0x2f, 0xea, 0x36, 0xa6, 0x6a, 0x90, 0x2d, 0xc6,
-	0xa5, 0xf6, 0xd5, 0x85, 0x82, 0x99, 0x8d, 0xaa, 0xfe, 0x6b, 0x06, 0x96, 0x6f, 0xbc, 0x33, 0xfa,
-	0x14, 0xb6, 0x4c, 0x28, 0x4c, 0xf1, 0x99, 0x49, 0x95, 0x1b, 0x1a, 0xf3, 0xe2, 0x36, 0xc7, 0x7d,
-	0x02, 0x9b, 0x39, 0xea, 0x15, 0x69, 0x5f, 0x32, 0xd6, 0xf3, 0x54, 0x5b, 0x9f, 0xbb, 0x49, 0x38,
-	0x19, 0xe4, 0xa5, 0x41, 0x5c, 0x84, 0x42, 0xdf, 0x10, 0x3e, 0x82, 0xea, 0x14, 0xba, 0xea, 0xc6,
-	0x4d, 0xd3, 0xb2, 0x7e, 0x1b, 0x5b, 0xdd, 0x1f, 0x9a, 0xb0, 0x6d, 0x2e, 0x4b, 0x9e, 0xda, 0xa8,
-	0xfc, 0x27, 0x74, 0x30, 0x0d, 0xd5, 0x6d, 0x41, 0xbb, 0xc6, 0xdd, 0x34, 0x28, 0x95, 0xc1, 0xb2,
-	0x6f, 0x38, 0x32, 0x10, 0xf4, 0x29, 0x2c, 0x58, 0xff, 0x62, 0xdf, 0x27, 0xb1, 0xb4, 0xd9, 0xef,
-	0xae, 0x0c, 0x30, 0x6f, 0x08, 0xfb, 0x1a, 0xdf, 0xf8, 0x50, 0x5d, 0xe3, 0xfe, 0xfa, 0x9f, 0xed,
-	0xc2, 0x57, 0xef, 0xdc, 0xef, 0x0f, 0xf7, 0xb8, 0xd7, 0xb5, 0xff, 0xdd, 0xb6, 0x1f, 0x6b, 0xf5,
-	0x77, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x67, 0x6e, 0x3a, 0xd5, 0xab, 0x17, 0x00, 0x00,
+	// 2329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xdb, 0x72, 0x23, 0x47,
+	0x19, 0x5e, 0x79, 0xbd, 0xb6, 0xf4, 0xcb, 0x07, 0xb9, 0xed, 0x5d, 0x8f, 0x65, 0xaf, 0xd7, 0x31,
+	0x04, 0x36, 0xa1, 0x22, 0x85, 0x4d, 0x48, 0x42, 0x0e, 0xa4, 0x2c, 0xd9, 0x8e, 0x1d, 0x7b, 0x17,
+	0x67, 0xe4, 0xec, 0x56, 0xa5, 0x28, 0xa6, 0x5a, 0x33, 0x2d, 0xb9, 0xd1, 0x68, 0x7a, 0xaa, 0xbb,
+	0x25, 0x5b, 0xb9, 0x83, 0x4b, 0x2e, 0xb8, 0xa1, 0x78, 0x07, 0xaa, 0x78, 0x01, 0x1e, 0x01, 0x5e,
+	0x80, 0x3b, 0x72, 0xc1, 0x1b, 0x40, 0x15, 0xc5, 0x2d, 0xd5, 0x87, 0x39, 0x48, 0xb6, 0xd6, 0xce,
+	0x8d, 0x6a, 0xba, 0xff, 0xef, 0xfb, 0x7a, 0xe6, 0xef, 0xfe, 0x0f, 0x2d, 0xf8, 0xa4, 0x4b, 0xe5,
+	0xc5, 0xa0, 0x5d, 0xf3, 0x59, 0xbf, 0x2e, 0x58, 0xc8, 0xde, 0xa1, 0xac, 0xde, 0x0d, 0x19, 0xab,
+	0xc7, 0x9c, 0xfd, 0x86, 0xf8, 0x52, 0x98, 0x11, 0x8e, 0x69, 0x7d, 0xf8, 0xd3, 0xba, 0x20, 0x52,
+	0xd2, 0xa8, 0x2b, 0x6a, 0x31, 0x67, 0x92, 0xa1, 0x05, 0x65, 0xab, 0x29, 0x5a, 0x8d, 0xb2, 0xea,
+	0x5a, 0x97, 0x75, 0x99, 0x36, 0xd4, 0xd5, 0x93, 0xc1, 0x54, 0x11, 0xb9, 0x92, 0x66, 0x92, 0x5c,
+	0x49, 0x3b, 0xb7, 0xad, 0x57, 0xea, 0x51, 0x99, 0xe8, 0xf6, 0x89, 0xc4, 0x01, 0x96, 0xd8, 0xda,
+	0xb7, 0x26, 0xed, 0x42, 0x62, 0x39, 0x10, 0xd3, 0xd8, 0xc9, 0xd8, 0xda, 0xdf, 0x9e, 0xfe, 0xfe,
+	0xe4, 0x4a, 0x92, 0x48, 0x50, 0x16, 0x25, 0x5a, 0x87, 0xaf, 0xc1, 0x46, 0x92, 0xf0, 0x98, 0x53,
+	0x41, 0xea, 0x2c, 0x96, 0x8a, 0x53, 0xe7, 0x58, 0x92, 0x90, 0xf6, 0xa9, 0xcc, 0x9e, 0xac, 0xce,
+	0xc1, 0xf7, 0xd2, 0x21, 0x57, 0x12, 0x0f, 0xe4, 0x85, 0x7d, 0x23, 0xf5, 0x68, 0x65, 0x3e, 0xfd,
+	0x7e, 0xaf, 0xd3, 0xc6, 0xbe, 0xfe, 0xb1, 0xec, 0xd7, 0x6c, 0x9c, 0x4f, 0xb9, 0x3f, 0xa0, 0xd2,
+	0x6b, 0x73, 0x82, 0x7b, 0x84, 0x27, 0x9e, 0xec, 0x32, 0xd6, 0x0d, 0x49, 0x5d, 0x8f, 0xda, 0x83,
+	0x4e, 0x3d, 0x18, 0x70, 0xac, 0xb4, 0xa7, 0xd9, 0x2f, 0x39, 0x8e, 0x63, 0xc2, 0xad, 0xf7, 0x76,
+	0xff, 0xb0, 0x0d, 0xc5, 0x96, 0x3d, 0x12, 0xa8, 0x0e, 0xab, 0x01, 0x15, 0x3e, 0x1b, 0x12, 0x3e,
+	0xf2, 0x22, 0xdc, 0x27, 0x22, 0xc6, 0x3e, 0x71, 0x0a, 0x3b, 0x85, 0xa7, 0x25, 0x17, 0xa5, 0xa6,
+	0x17, 0x89, 0x05, 0xbd, 0x05, 0x95, 0x4b, 0x2c, 0xfd, 0x8b, 0x0c, 0x2c, 0x9c, 0x99, 0x9d, 0xfb,
+	0x4f, 0x4b, 0xee, 0xb2, 0x9e, 0x4f, 0x91, 0x02, 0x61, 0x70, 0x7a, 0x83, 0x36, 0xe1, 0x11, 0x91,
+	0x44, 0x78, 0x3e, 0x8b, 0x3a, 0xb4, 0xeb, 0x09, 0x36, 0xe0, 0x3e, 0x71, 0x66, 0x77, 0x0a, 0x4f,
+	0xcb, 0xcf, 0xde, 0xac, 0xe5, 0xcf, 0x62, 0x2d, 0x79, 0xab, 0xda, 0x49, 0x4a, 0x6b, 0xf2, 0x40,
+	0x1c, 0xdd, 0x73, 0x1f, 0x65, 0x42, 0x4d, 0xad, 0xd3, 0xd2, 0x32, 0xe8, 0x1b, 0x58, 0x0f, 0x28,
+	0x27, 0xbe, 0x64, 0x7c, 0x34, 0xb1, 0xc2, 0x03, 0xbd, 0xc2, 0xce, 0x94, 0x15, 0xf6, 0x13, 0xd6,
+	0xd1, 0x3d, 0xf7, 0x61, 0x2a, 0x31, 0xa6, 0x7d, 0x02, 0x15, 0x9f, 0x45, 0x62, 0x10, 0x7a, 0xbd,
+	0x61, 0x22, 0xfa, 0x50, 0x8b, 0x3e, 0x99, 0x22, 0xda, 0xd4, 0xf0, 0x93, 0xe1, 0xd1, 0x3d, 0x77,
+	0xc9, 0xb7, 0xcf, 0x56, 0x2c, 0x18, 0xf3, 0x85, 0x20, 0x3e, 0x27, 0x32, 0x11, 0x9d, 0xd3, 0xa2,
+	0x4f, 0x6f, 0xf5, 0x45, 0x4b, 0xb3, 0xc4, 0x51, 0x21, 0xef, 0x0e, 0x33, 0x69, 0x57, 0xf9, 0x1a,
+	0x56, 0x87, 0x78, 0x10, 0xca, 0x89, 0x05, 0xe6, 0xf5, 0x02, 0x3f, 0x98, 0xb2, 0xc0, 0x4b, 0xc5,
+	0xc8, 0xb4, 0x57, 0x86, 0xd9, 0xf8, 0x26, 0x2f, 0x8f, 0x4b, 0x17, 0xef, 0xe8, 0xe5, 0x42, 0xce,
+	0xcb, 0x63, 0xda, 0x3d, 0xa8, 0xe6, 0x1c, 0x83, 0xb9, 0xa4, 0x1d, 0xec, 0xa7, 0xf2, 0x25, 0x2d,
+	0xff, 0x93, 0xdb, 0x8f, 0x89, 0xde, 0xb8, 0x3e, 0x8e, 0xc5, 0xd1, 0x8c, 0x9b, 0xf3, 0xf4, 0x9e,
+	0xd5, 0xb3, 0x8b, 0xfd, 0x1a, 0x36, 0xb2, 0x0f, 0x99, 0x5c, 0x0b, 0xee, 0xf8, 0x29, 0x33, 0x6e,
+	0xe6, 0x8d, 0x09, 0xfd, 0x5f, 0xc1, 0x46, 0x76, 0x64, 0x26, 0xf5, 0xd7, 0xef, 0x76, 0x76, 0x66,
+	0xdc, 0x47, 0xc9, 0xd9, 0x99, 0x50, 0xff, 0x14, 0x16, 0x38, 0xe9, 0x70, 0x22, 0x2e, 0x3c, 0x95,
+	0xc9, 0x9c, 0x05, 0x2d, 0xb8, 0x51, 0x33, 0xf1, 0x5e, 0x4b, 0xe2, 0xbd, 0xb6, 0x6f, 0xf3, 0x81,
+	0x5b, 0xb6, 0x70, 0x17, 0x4b, 0x82, 0x36, 0xa0, 0x18, 0x90, 0xa1, 0xd7, 0x67, 0x01, 0x71, 0x16,
+	0x77, 0x0a, 0x4f, 0x8b, 0xee, 0x7c, 0x40, 0x86, 0xcf, 0x59, 0x40, 0x90, 0x03, 0xf3, 0x21, 0x8d,
+	0x7a, 0x84, 0x07, 0xce, 0x8a, 0xb1, 0xd8, 0x21, 0xfa, 0x1c, 0xe6, 0x7b, 0x11, 0x96, 0x74, 0x48,
+	0x1c, 0xf4, 0xfa, 0x88, 0x35, 0xa8, 0x5f, 0x9a, 0x24, 0xe7, 0x26, 0x2c, 0x74, 0x00, 0xa5, 0x34,
+	0x89, 0x38, 0xab, 0x5a, 0xe2, 0xc7, 0x53, 0x3d, 0x6c, 0x71, 0x89, 0x48, 0xc6, 0x44, 0xef, 0xc0,
+	0xac, 0x22, 0x39, 0x4e, 0xf2, 0xc9, 0x79, 0x85, 0x2f, 0x42, 0xc6, 0x12, 0x8e, 0x86, 0xa1, 0x0f,
+	0x60, 0xbe, 0x8b, 0x25, 0xb9, 0xc4, 0x23, 0x67, 0x43, 0x33, 0xb6, 0x26, 0x18, 0xc6, 0x98, 0xbe,
+	0xad, 0x05, 0xa3, 0x06, 0xcc, 0x19, 0xdf, 0x3b, 0x6b, 0x9a, 0xf6, 0xf6, 0x6b, 0x37, 0xcb, 0x1c,
+	0xba, 0xc4, 0xd9, 0x96, 0x89, 0x5e, 0x00, 0x64, 0xe7, 0xcf, 0x79, 0xa4, 0x75, 0x6a, 0x77, 0x3c,
+	0xc0, 0x89, 0x56, 0x4e, 0x01, 0x7d, 0x04, 0x90, 0x15, 0x40, 0xa7, 0xa2, 0xf5, 0x9c, 0x71, 0xbd,
+	0x83, 0xd4, 0xee, 0xe6, 0xb0, 0xe8, 0x39, 0x94, 0xd2, 0x8a, 0xe7, 0x54, 0x35, 0xb1, 0x5e, 0x4b,
+	0x67, 0x6a, 0xb6, 0x20, 0x4d, 0xbe, 0x1a, 0x1f, 0x52, 0x9f, 0x24, 0x6f, 0xe8, 0x66, 0x0a, 0xa8,
+	0x05, 0x95, 0x74, 0xe0, 0x09, 0xc2, 0x87, 0x84, 0x3b, 0x9b, 0x36, 0x75, 0xdd, 0xaa, 0x6a, 0xe5,
+	0x96, 0x53, 0x60, 0x4b, 0x0b, 0xa0, 0x0f, 0x61, 0x56, 0xd5, 0x42, 0x67, 0xcb, 0xa6, 0x28, 0x35,
+	0xb8, 0x45, 0x43, 0x13, 0xd0, 0x27, 0x30, 0x6f, 0xab, 0xb0, 0xf3, 0x58, 0x73, 0xdf, 0xa8, 0x65,
+	0xc5, 0x76, 0x0a, 0x33, 0x61, 0xa0, 0x8f, 0xa0, 0x98, 0x34, 0x2f, 0xce, 0x92, 0x66, 0x3f, 0xaa,
+	0xf9, 0x8c, 0x93, 0x94, 0xf2, 0xdc, 0x5a, 0x1b, 0xb3, 0x7f, 0xfb, 0xee, 0xc9, 0x3d, 0x37, 0x45,
+	0xa3, 0x13, 0x98, 0x33, 0x6d, 0x8d, 0xb3, 0xac, 0x79, 0x6b, 0xe3, 0xbc, 0x96, 0xb6, 0x35, 0x1e,
+	0xff, 0xf5, 0xbf, 0xb3, 0x05, 0xc5, 0xfc, 0xcf, 0x77, 0x4f, 0x56, 0x24, 0x11, 0x32, 0xa0, 0x9d,
+	0xce, 0xc7, 0xbb, 0xb4, 0x1b, 0x31, 0x4e, 0x76, 0x5d, 0x2b, 0x51, 0xad, 0xc0, 0xd2, 0x78, 0xa5,
+	0xab, 0xae, 0xc2, 0xca, 0xb5, 0x7c, 0x5f, 0xfd, 0xcb, 0x0c, 0x2c, 0xe4, 0x93, 0x34, 0x5a, 0x83,
+	0x07, 0x92, 0xf5, 0x48, 0x64, 0xcb, 0xb4, 0x19, 0xa8, 0x28, 0xc6, 0x41, 0xc0, 0x89, 0x50, 0x05,
+	0x59, 0xcd, 0x27, 0x43, 0xb4, 0x0e, 0xf3, 0x3e, 0xf6, 0x7c, 0xc2, 0xa5, 0x73, 0x5f, 0x5b, 0xe6,
+	0x7c, 0xdc, 0x24, 0x5c, 0x5a, 0x43, 0x8c, 0xe5, 0x85, 0x33, 0x9b, 0x18, 0xce, 0xb0, 0xbc, 0x40,
+	0x4f, 0xa0, 0xec, 0x87, 0x94, 0x44, 0xd2, 0xb0, 0x1e, 0x68, 0x23, 0x98, 0x29, 0xcd, 0x7c, 0x0c,
+	0x76, 0xe4, 0xf5, 0xc8, 0x48, 0x57, 0xb0, 0x92, 0x5b, 0x32, 0x33, 0x27, 0x64, 0x84, 0x7e, 0x04,
+	0xcb, 0x32, 0x14, 0xf6, 0x94, 0xe8, 0x56, 0x41, 0x17, 0xa1, 0x92, 0xbb, 0x28, 0x43, 0x61, 0xb6,
+	0x5e, 0x35, 0x0a, 0xe8, 0x03, 0x28, 0xd2, 0x48, 0x10, 0x7f, 0xc0, 0x93, 0x52, 0x52, 0xbd, 0x96,
+	0xce, 0x1a, 0x8c, 0x85, 0x2f, 0x71, 0x38, 0x20, 0x6e, 0x8a, 0x55, 0xc9, 0x8c, 0x33, 0x66, 0x16,
+	0x2f, 0x99, 0x8f, 0x55, 0xe3, 0x13, 0x32, 0xaa, 0xbe, 0x09, 0xc5, 0x24, 0x97, 0x8e, 0xc1, 0x0a,
+	0xe3, 0xb0, 0x47, 0xb0, 0x76, 0x53, 0xf9, 0xa8, 0xbe, 0x05, 0xa5, 0x34, 0xd5, 0xa3, 0x2d, 0x95,
+	0xbd, 0xec, 0xc0, 0x0a, 0x64, 0x13, 0xd5, 0x7f, 0x16, 0x60, 0x69, 0x3c, 0xef, 0xa1, 0x3d, 0x78,
+	0xec, 0x87, 0x03, 0x21, 0x09, 0xf7, 0x68, 0xd4, 0x55, 0xce, 0xf7, 0x62, 0xce, 0xae, 0x46, 0x5e,
+	0xb2, 0x33, 0x46, 0xa4, 0x6a, 0x41, 0xc7, 0x06, 0x73, 0xa6, 0x20, 0x7b, 0x76, 0xb3, 0x9a, 0xb0,
+	0x6d, 0x93, 0xa7, 0xa7, 0x62, 0x99, 0x47, 0x38, 0x9c, 0xd0, 0x30, 0xbb, 0xbb, 0x69, 0x51, 0x07,
+	0x16, 0x34, 0x4d, 0x84, 0x46, 0x37, 0x8a, 0xdc, 0x1f, 0x13, 0x39, 0x8e, 0xae, 0x8b, 0x54, 0xff,
+	0x54, 0x80, 0xca, 0x64, 0x52, 0x46, 0x5f, 0x42, 0xb1, 0x13, 0x08, 0x53, 0x46, 0xd4, 0xc7, 0x2c,
+	0x3d, 0xab, 0xdf, 0x31, 0x9f, 0xd7, 0x0e, 0x03, 0xa1, 0xca, 0x8d, 0x3b, 0xdf, 0x31, 0x0f, 0xbb,
+	0x3f, 0x83, 0x79, 0x3b, 0x87, 0x16, 0xa1, 0xd4, 0x38, 0xdd, 0x6b, 0x9e, 0x9c, 0x1e, 0xb7, 0xce,
+	0x2b, 0xf7, 0xd4, 0xf0, 0xd5, 0xd1, 0xf1, 0xf9, 0x81, 0x1e, 0x16, 0xd0, 0x02, 0x14, 0xf7, 0x8f,
+	0x5b, 0x7b, 0x8d, 0xd3, 0x83, 0xfd, 0xca, 0x4c, 0xf5, 0xf7, 0x73, 0xb0, 0x7a, 0x43, 0x06, 0x46,
+	0x5b, 0x59, 0x00, 0x68, 0x37, 0x37, 0x66, 0x9c, 0x42, 0x16, 0x04, 0x6f, 0xc0, 0xc2, 0x85, 0x94,
+	0x71, 0xea, 0x80, 0x45, 0xed, 0x80, 0xb2, 0x9a, 0x4b, 0xbc, 0xf6, 0x04, 0xca, 0x41, 0x24, 0x52,
+	0xc4, 0x92, 0x39, 0xf5, 0x41, 0x24, 0x12, 0xc0, 0x09, 0xac, 0x29, 0x40, 0xcc, 0xc2, 0x90, 0x46,
+	0x5d, 0xe3, 0xda, 0x21, 0x0e, 0x9d, 0xe5, 0xdb, 0x2a, 0x31, 0x0a, 0x22, 0x71, 0x66, 0x58, 0xc7,
+	0x96, 0x84, 0xb6, 0x01, 0x54, 0x4a, 0xf1, 0x75, 0xda, 0xb2, 0x9b, 0x9a, 0x9b, 0x41, 0x55, 0x28,
+	0x0e, 0x84, 0xda, 0x95, 0x3e, 0xb1, 0xbb, 0x95, 0x8e, 0x95, 0x2d, 0xc6, 0x42, 0x5c, 0x32, 0x1e,
+	0xd8, 0xc8, 0x4d, 0xc7, 0x59, 0x76, 0x78, 0x90, 0xcf, 0x0e, 0x26, 0xd4, 0x3b, 0x34, 0x24, 0x36,
+	0x5a, 0xe7, 0x7c, 0x7c, 0x48, 0x43, 0x92, 0xcf, 0x01, 0xf3, 0x63, 0x39, 0x60, 0x13, 0x4a, 0x2a,
+	0xf8, 0x0d, 0xa7, 0x68, 0x16, 0x51, 0x13, 0x9a, 0xb5, 0x01, 0xc5, 0x1e, 0x19, 0x19, 0x9b, 0x0d,
+	0xc0, 0x1e, 0x19, 0x69, 0xd3, 0x29, 0xac, 0x25, 0x71, 0xea, 0x89, 0x1e, 0x8d, 0xbd, 0x21, 0xe1,
+	0xb4, 0x33, 0x72, 0xe0, 0xd6, 0xf8, 0x46, 0x09, 0xaf, 0xd5, 0xa3, 0xf1, 0x4b, 0xcd, 0x42, 0x1f,
+	0x40, 0xe9, 0x12, 0x53, 0xe9, 0x49, 0xda, 0x27, 0x4e, 0xf9, 0x36, 0x3f, 0x17, 0x15, 0xf6, 0x9c,
+	0xf6, 0x09, 0x62, 0xb0, 0x22, 0x4c, 0x2d, 0xf3, 0xb2, 0x06, 0xc4, 0x74, 0x4c, 0x8d, 0xbb, 0x57,
+	0xf5, 0xa4, 0x1e, 0x5e, 0xeb, 0x4d, 0x2a, 0x62, 0xc2, 0x80, 0x3e, 0x04, 0xc7, 0x67, 0x51, 0x44,
+	0x54, 0xc3, 0x17, 0x08, 0x2f, 0x49, 0x03, 0x7a, 0xfb, 0x2a, 0xda, 0x43, 0x0f, 0xad, 0xbd, 0x15,
+	0x88, 0xa6, 0xb1, 0xaa, 0x1c, 0x58, 0xfd, 0x14, 0xd6, 0xa7, 0xac, 0xa2, 0xce, 0xac, 0x3a, 0x10,
+	0x9e, 0x39, 0x11, 0xea, 0x58, 0xab, 0x8b, 0x56, 0x59, 0xcd, 0x35, 0xcd, 0x54, 0xf5, 0x7f, 0x05,
+	0x58, 0x9f, 0xd2, 0x46, 0xa0, 0x6f, 0xa0, 0xac, 0xea, 0xad, 0xa7, 0x0b, 0xae, 0x09, 0x8a, 0xf2,
+	0xb3, 0x9f, 0x7f, 0xbf, 0x5e, 0xa4, 0xa6, 0x9a, 0xc7, 0x53, 0x2d, 0xe0, 0x02, 0x4f, 0x9f, 0xd1,
+	0x97, 0xb0, 0x4b, 0xa2, 0x20, 0x66, 0x34, 0x92, 0x5e, 0xc8, 0x7c, 0x1c, 0x52, 0x39, 0xf2, 0x3a,
+	0x9c, 0xf5, 0xbd, 0x88, 0x05, 0xc4, 0x0b, 0x71, 0x9b, 0x84, 0x26, 0x55, 0x15, 0xdd, 0xed, 0x04,
+	0x79, 0x6a, 0x81, 0x87, 0x9c, 0xf5, 0x5f, 0xb0, 0x80, 0x9c, 0x6a, 0x54, 0xf5, 0x7d, 0x80, 0x6c,
+	0x15, 0x54, 0x81, 0xfb, 0x5f, 0x9d, 0xb5, 0xf4, 0xdb, 0xce, 0xb8, 0xea, 0x51, 0x9d, 0xe8, 0xf6,
+	0x80, 0x0b, 0xa9, 0xe5, 0x16, 0x5d, 0x33, 0xf8, 0x18, 0xfd, 0xee, 0xdf, 0xb3, 0x4b, 0x30, 0x23,
+	0x24, 0x2a, 0x26, 0xff, 0x70, 0x34, 0x96, 0x61, 0x71, 0xec, 0x16, 0xa8, 0x26, 0xc6, 0x2e, 0x2c,
+	0x8d, 0x15, 0x58, 0x9e, 0x68, 0xcc, 0x77, 0xff, 0x58, 0x84, 0x72, 0xae, 0x87, 0x44, 0xbb, 0xb0,
+	0x78, 0x15, 0x08, 0xaf, 0x4d, 0xa3, 0x40, 0xe7, 0x02, 0x9b, 0xb4, 0xcb, 0x57, 0x81, 0x68, 0xd0,
+	0x28, 0x50, 0xc9, 0x00, 0xbd, 0x0b, 0x6b, 0x43, 0x1c, 0xd2, 0x40, 0xfb, 0x28, 0x07, 0x35, 0x61,
+	0x8c, 0x32, 0x5b, 0xca, 0x78, 0x0e, 0x95, 0x89, 0xfb, 0xbc, 0x49, 0xc2, 0xe5, 0x67, 0xbb, 0xe3,
+	0x3b, 0xd2, 0x34, 0xa8, 0x86, 0x01, 0x99, 0xcd, 0x70, 0x97, 0xfd, 0xb1, 0x59, 0x81, 0xbe, 0x86,
+	0x8d, 0xc4, 0xab, 0xc2, 0xbb, 0xc4, 0xbc, 0xaf, 0x12, 0x92, 0x0a, 0x12, 0x36, 0x90, 0xce, 0xec,
+	0x6d, 0x71, 0xb2, 0x9e, 0x72, 0x5f, 0x19, 0xea, 0xb9, 0x61, 0xa2, 0x03, 0x28, 0xe3, 0x4b, 0xe1,
+	0xd9, 0x0e, 0xcc, 0x5e, 0xa2, 0x7f, 0x38, 0xb5, 0xdf, 0xae, 0xed, 0xbd, 0x6a, 0xd9, 0x47, 0x17,
+	0xf0, 0xa5, 0x48, 0x5c, 0x88, 0xe1, 0x21, 0x8d, 0xb4, 0x13, 0x92, 0x5b, 0x79, 0xcc, 0x42, 0xea,
+	0x8f, 0xec, 0x5d, 0xf7, 0x9d, 0xe9, 0x82, 0xc7, 0x86, 0x66, 0x3e, 0xfb, 0x4c, 0x93, 0xdc, 0x55,
+	0x7a, 0x7d, 0x12, 0x1d, 0xc2, 0x93, 0x80, 0x0a, 0xdc, 0x0e, 0x89, 0x97, 0xbb, 0x40, 0x06, 0x44,
+	0x48, 0x1a, 0x61, 0xf3, 0xf6, 0xf3, 0xfa, 0xf4, 0x3d, 0xb6, 0xb0, 0xec, 0x80, 0xef, 0xe7, 0x40,
+	0x68, 0x1f, 0x2a, 0x89, 0x4e, 0x97, 0xc7, 0xbe, 0x77, 0x49, 0xda, 0x77, 0x68, 0x45, 0x96, 0x2c,
+	0xe7, 0x0b, 0x1e, 0xfb, 0xaf, 0x48, 0x1b, 0xf9, 0xb0, 0x93, 0xa8, 0x98, 0x3a, 0xdb, 0xc5, 0xbc,
+	0x8d, 0xbb, 0xc4, 0xf3, 0x59, 0x18, 0x12, 0x5f, 0x2d, 0xe5, 0x94, 0x6e, 0x55, 0x4d, 0x5e, 0x55,
+	0x97, 0xe1, 0x2f, 0x8c, 0x42, 0x33, 0x15, 0x40, 0x5f, 0xc1, 0x23, 0x4e, 0xba, 0xe4, 0xca, 0xeb,
+	0xe3, 0x2b, 0xb5, 0x4c, 0x97, 0xe3, 0xbe, 0x27, 0xe8, 0xb7, 0xc9, 0xdd, 0x75, 0xeb, 0x9a, 0xf4,
+	0xd7, 0xc7, 0x91, 0x7c, 0xef, 0x99, 0x11, 0x5f, 0xd5, 0xdc, 0xe7, 0xf8, 0xea, 0xcc, 0x30, 0x5b,
+	0xf4, 0x5b, 0x52, 0x3d, 0x05, 0xc8, 0xb6, 0x10, 0xfd, 0x02, 0x36, 0x49, 0xa4, 0x3f, 0xc2, 0xe7,
+	0x24, 0x20, 0x91, 0xa4, 0x38, 0x14, 0x49, 0xfe, 0x34, 0x1d, 0x50, 0xd1, 0xdd, 0x30, 0x90, 0x66,
+	0x86, 0xb0, 0x79, 0x6b, 0x54, 0xfd, 0x7b, 0x01, 0x56, 0x6f, 0xd8, 0x40, 0xf4, 0xbe, 0x7a, 0xf1,
+	0x38, 0xc4, 0xbe, 0x6a, 0x47, 0xcc, 0xb1, 0xe0, 0x6c, 0xa0, 0xee, 0x47, 0x46, 0x72, 0xcd, 0x5a,
+	0x2d, 0xd7, 0xd5, 0x36, 0xf4, 0x19, 0x6c, 0x8e, 0xa1, 0x3d, 0x4e, 0x44, 0xcc, 0x22, 0xa1, 0x9c,
+	0x1a, 0x10, 0x9b, 0x0c, 0x1c, 0x9a, 0xe3, 0xb8, 0x16, 0xd0, 0x54, 0x2d, 0xc5, 0x74, 0x7a, 0x9b,
+	0x05, 0x23, 0x5b, 0x52, 0x6f, 0xa4, 0x37, 0x58, 0x30, 0xda, 0xfd, 0xed, 0x03, 0x58, 0x1a, 0xbf,
+	0x27, 0xaa, 0xcf, 0xc8, 0x05, 0xbd, 0x6d, 0x6e, 0x73, 0x19, 0x22, 0x97, 0x12, 0x4c, 0x8f, 0xab,
+	0x03, 0xff, 0x05, 0x40, 0x36, 0xef, 0xdc, 0xbf, 0xe9, 0x42, 0x38, 0xbe, 0x4e, 0xed, 0x65, 0x0a,
+	0x4f, 0x63, 0x2b, 0x53, 0x40, 0x47, 0xf0, 0x06, 0x27, 0x38, 0xf0, 0xec, 0xa5, 0x55, 0x98, 0xac,
+	0x8b, 0xc3, 0x30, 0xff, 0x97, 0xdc, 0xac, 0x39, 0xfa, 0x0a, 0x68, 0xc5, 0x85, 0x4a, 0xba, 0x7b,
+	0x61, 0x98, 0xfb, 0x83, 0xee, 0x10, 0xb6, 0x71, 0xa8, 0x25, 0x04, 0xe3, 0xd2, 0x7a, 0x49, 0xea,
+	0xfd, 0xb7, 0xdb, 0xa3, 0xe2, 0xbf, 0xa8, 0xfb, 0xa8, 0xaa, 0x41, 0xb6, 0x18, 0x97, 0xda, 0x57,
+	0xe7, 0x0a, 0xa6, 0x9f, 0x44, 0xf5, 0x1f, 0x33, 0xb0, 0x72, 0xed, 0x9d, 0xd1, 0xe7, 0xb0, 0x65,
+	0x42, 0x61, 0x8a, 0xcf, 0x4c, 0xaa, 0xdc, 0xd0, 0x98, 0x97, 0x37, 0x39, 0xee, 0x33, 0xd8, 0xcc,
+	0x51, 0x2f, 0x49, 0xfb, 0x82, 0xb1, 0x9e, 0xa7, 0xee, 0x15, 0xb9, 0xab, 0x8c, 0x93, 0x41, 0x5e,
+	0x19, 0xc4, 0x79, 0x28, 0xf4, 0x15, 0xe5, 0x13, 0xa8, 0x4e, 0xa1, 0xab, 0xeb, 0x80, 0xe9, 0x9a,
+	0xd6, 0x6f, 0x62, 0xab, 0x0b, 0x4c, 0x13, 0xb6, 0xcd, 0x6d, 0xcd, 0x53, 0x1b, 0x95, 0xff, 0x84,
+	0x0e, 0xa6, 0xa1, 0xba, 0xae, 0x68, 0xd7, 0xb8, 0x9b, 0x06, 0xa5, 0x32, 0x58, 0xf6, 0x0d, 0x87,
+	0x06, 0x82, 0x3e, 0x87, 0x45, 0xeb, 0x5f, 0xec, 0xfb, 0x24, 0x96, 0xce, 0xdc, 0xad, 0x19, 0x60,
+	0xc1, 0x10, 0xf6, 0x34, 0xbe, 0xf1, 0xb1, 0xba, 0x47, 0xfe, 0xf9, 0x5f, 0xdb, 0x85, 0x6f, 0xde,
+	0xbd, 0xdb, 0x3f, 0xfe, 0x71, 0xaf, 0x6b, 0xff, 0x3c, 0x6e, 0xcf, 0x69, 0xf5, 0xf7, 0xfe, 0x3f,
+	0x00, 0x7b, 0x17, 0xd0, 0xfe, 0x2c, 0x18, 0x00, 0x00,
 }
 
 func (this *Settings) Equal(that interface{}) bool {
@@ -2365,6 +2396,9 @@ func (this *Settings_ConsulConfiguration) Equal(that interface{}) bool {
 	if !this.ServiceDiscovery.Equal(that1.ServiceDiscovery) {
 		return false
 	}
+	if this.ConnectSdsClusterName != that1.ConnectSdsClusterName {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -2424,6 +2458,9 @@ func (this *Settings_KubernetesConfiguration) Equal(that interface{}) bool {
 	if !this.RateLimits.Equal(that1.RateLimits) {
 		return false
 	}
+	if this.EndpointLocalityFromNodeLabels != that1.EndpointLocalityFromNodeLabels {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -884,6 +884,10 @@ func (m *Settings_ConsulConfiguration) Hash(hasher hash.Hash64) (uint64, error)
 		}
 	}
 
+	if _, err = hasher.Write([]byte(m.GetConnectSdsClusterName())); err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -914,6 +918,11 @@ func (m *Settings_KubernetesConfiguration) Hash(hasher hash.Hash64) (uint64, err
 		}
 	}
 
+	err = binary.Write(hasher, binary.LittleEndian, m.GetEndpointLocalityFromNodeLabels())
+	if err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -16,6 +16,7 @@ import (
 	ec2 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
 	azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
 	consul "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/consul"
+	dns_srv "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
 	kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	pipe "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/pipe"
 	static "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
@@ -60,6 +61,13 @@ type Upstream struct {
 	// this field is evaluated `true` for upstreams
 	// with a grpc service spec. otherwise defaults to `false`
 	UseHttp2 bool `protobuf:"varint,10,opt,name=use_http2,json=useHttp2,proto3" json:"use_http2,omitempty"`
+	// Backup upstreams that receive traffic when this upstream runs out of healthy endpoints.
+	Failover *Failover `protobuf:"bytes,18,opt,name=failover,proto3" json:"failover,omitempty"`
+	// HTTP protocol options for connections to the upstream. Overrides the HTTP/2 options set by `use_http2`.
+	ProtocolOptions *ProtocolOptions `protobuf:"bytes,19,opt,name=protocol_options,json=protocolOptions,proto3" json:"protocol_options,omitempty"`
+	// Active health checks of the upstream's endpoints, in a simplified form. They are validated by gloo, and
+	// added to the envoy health checks in `health_checks`.
+	HealthCheckSpecs []*HealthCheckSpec `protobuf:"bytes,20,rep,name=health_check_specs,json=healthCheckSpecs,proto3" json:"health_check_specs,omitempty"`
 	// Note to developers: new Upstream plugins must be added to this oneof field
 	// to be usable by Gloo. (plugins currently need to be compiled into Gloo)
 	//
@@ -71,6 +79,7 @@ type Upstream struct {
 	//	*Upstream_Azure
 	//	*Upstream_Consul
 	//	*Upstream_AwsEc2
+	//	*Upstream_DnsSrv
 	UpstreamType         isUpstream_UpstreamType `protobuf_oneof:"upstream_type"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
@@ -127,6 +136,9 @@ type Upstream_Consul struct {
 type Upstream_AwsEc2 struct {
 	AwsEc2 *ec2.UpstreamSpec `protobuf:"bytes,17,opt,name=aws_ec2,json=awsEc2,proto3,oneof" json:"aws_ec2,omitempty"`
 }
+type Upstream_DnsSrv struct {
+	DnsSrv *dns_srv.UpstreamSpec `protobuf:"bytes,21,opt,name=dns_srv,json=dnsSrv,proto3,oneof" json:"dns_srv,omitempty"`
+}
 
 func (*Upstream_Kube) isUpstream_UpstreamType()   {}
 func (*Upstream_Static) isUpstream_UpstreamType() {}
@@ -135,6 +147,7 @@ func (*Upstream_Aws) isUpstream_UpstreamType()    {}
 func (*Upstream_Azure) isUpstream_UpstreamType()  {}
 func (*Upstream_Consul) isUpstream_UpstreamType() {}
 func (*Upstream_AwsEc2) isUpstream_UpstreamType() {}
+func (*Upstream_DnsSrv) isUpstream_UpstreamType() {}
 
 func (m *Upstream) GetUpstreamType() isUpstream_UpstreamType {
 	if m != nil {
@@ -213,6 +226,27 @@ func (m *Upstream) GetUseHttp2() bool {
 	return false
 }
 
+func (m *Upstream) GetFailover() *Failover {
+	if m != nil {
+		return m.Failover
+	}
+	return nil
+}
+
+func (m *Upstream) GetProtocolOptions() *ProtocolOptions {
+	if m != nil {
+		return m.ProtocolOptions
+	}
+	return nil
+}
+
+func (m *Upstream) GetHealthCheckSpecs() []*HealthCheckSpec {
+	if m != nil {
+		return m.HealthCheckSpecs
+	}
+	return nil
+}
+
 func (m *Upstream) GetKube() *kubernetes.UpstreamSpec {
 	if x, ok := m.GetUpstreamType().(*Upstream_Kube); ok {
 		return x.Kube
@@ -262,6 +296,13 @@ func (m *Upstream) GetAwsEc2() *ec2.UpstreamSpec {
 	return nil
 }
 
+func (m *Upstream) GetDnsSrv() *dns_srv.UpstreamSpec {
+	if x, ok := m.GetUpstreamType().(*Upstream_DnsSrv); ok {
+		return x.DnsSrv
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Upstream) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -272,6 +313,7 @@ func (*Upstream) XXX_OneofWrappers() []interface{} {
 		(*Upstream_Azure)(nil),
 		(*Upstream_Consul)(nil),
 		(*Upstream_AwsEc2)(nil),
+		(*Upstream_DnsSrv)(nil),
 	}
 }
 
@@ -316,59 +358,65 @@ func init() {
 }
 
 var fileDescriptor_b74df493149f644d = []byte{
-	// 827 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdf, 0x4e, 0x2b, 0x45,
-	0x1c, 0xc7, 0x4f, 0x0f, 0x7b, 0x38, 0xed, 0x00, 0xd2, 0x8e, 0x44, 0x37, 0x47, 0x85, 0xa6, 0x26,
-	0x1e, 0x24, 0x61, 0x56, 0x4a, 0x8c, 0xa6, 0x06, 0x63, 0x5a, 0x48, 0x48, 0x00, 0x4d, 0x96, 0x78,
-	0xe3, 0xcd, 0x66, 0x3a, 0x1d, 0xda, 0xb1, 0xcb, 0xce, 0x66, 0x67, 0xb6, 0x80, 0x97, 0x3c, 0x8d,
-	0x8f, 0xe0, 0x0b, 0x98, 0xf8, 0x14, 0x5c, 0xf8, 0x06, 0x98, 0x78, 0x6f, 0xe6, 0x5f, 0xe9, 0x1f,
-	0x4a, 0xd7, 0x0b, 0xba, 0x3b, 0x33, 0xdf, 0xef, 0x67, 0x7f, 0xfc, 0x76, 0xe6, 0x9b, 0x05, 0xdf,
-	0xf5, 0x99, 0x1c, 0xe4, 0x5d, 0x44, 0xf8, 0x75, 0x20, 0x78, 0xcc, 0xf7, 0x19, 0x0f, 0xfa, 0x31,
-	0xe7, 0x41, 0x9a, 0xf1, 0x5f, 0x29, 0x91, 0xc2, 0x8c, 0x70, 0xca, 0x82, 0xd1, 0x41, 0x90, 0xa7,
-	0x42, 0x66, 0x14, 0x5f, 0xa3, 0x34, 0xe3, 0x92, 0xc3, 0x75, 0xb5, 0x86, 0x94, 0x0d, 0x31, 0xfe,
-	0x6e, 0xab, 0xcf, 0xfb, 0x5c, 0x2f, 0x04, 0xea, 0xce, 0x68, 0xde, 0x41, 0x7a, 0x2b, 0xcd, 0x24,
-	0xbd, 0x95, 0x76, 0x6e, 0x5b, 0x3f, 0x69, 0xc8, 0xa4, 0xe3, 0x5e, 0x53, 0x89, 0x7b, 0x58, 0x62,
-	0xbb, 0xfe, 0xf9, 0xe2, 0x0a, 0x84, 0x88, 0xad, 0xe8, 0x85, 0x32, 0x09, 0xcb, 0x48, 0xce, 0x64,
-	0xd4, 0xcd, 0x28, 0x1e, 0xd2, 0xcc, 0x1a, 0xf6, 0x17, 0x1b, 0x62, 0x8e, 0x7b, 0x51, 0x17, 0xc7,
-	0x38, 0x21, 0x63, 0xf9, 0xde, 0x0b, 0x7c, 0x9e, 0x24, 0x94, 0x48, 0xc6, 0x13, 0xab, 0x3d, 0x5e,
-	0xa0, 0xa5, 0xb7, 0x92, 0x66, 0x09, 0x8e, 0x03, 0x9a, 0x8c, 0xf8, 0x9d, 0xb1, 0x37, 0x03, 0xc2,
-	0x33, 0x1a, 0x0c, 0x28, 0x8e, 0xe5, 0x20, 0x22, 0x03, 0x4a, 0x86, 0x96, 0xf2, 0xe9, 0x6c, 0x5b,
-	0x84, 0xc4, 0x32, 0x17, 0x76, 0xf5, 0xfc, 0xff, 0x3d, 0x23, 0xce, 0x85, 0xa4, 0x59, 0xc0, 0x73,
-	0x19, 0x33, 0x9a, 0x45, 0x3d, 0x2a, 0xa7, 0x2a, 0x9e, 0x7b, 0x05, 0x6e, 0x6c, 0xd7, 0xbf, 0x5e,
-	0xfc, 0xdf, 0xf3, 0x54, 0x71, 0x84, 0xae, 0x8e, 0x11, 0x7b, 0xb1, 0xb6, 0x83, 0xe5, 0xb6, 0x94,
-	0xa5, 0x54, 0xff, 0x58, 0xcb, 0xd1, 0x72, 0xcb, 0x30, 0xef, 0xd2, 0x2c, 0xa1, 0x92, 0x4e, 0xde,
-	0x2e, 0xdf, 0x06, 0xce, 0x8e, 0x6f, 0xf4, 0x9f, 0x35, 0x1c, 0x16, 0x30, 0xfc, 0x96, 0x67, 0xd4,
-	0xfc, 0x16, 0x6f, 0x07, 0xe1, 0x89, 0xc8, 0x63, 0x7b, 0xb1, 0xb6, 0x6f, 0x8a, 0x15, 0x47, 0x49,
-	0x53, 0x5d, 0x23, 0x4a, 0x9a, 0xd6, 0xf8, 0x7e, 0xa9, 0xd1, 0x08, 0x1b, 0x7f, 0x56, 0x40, 0xf9,
-	0x67, 0x7b, 0x2a, 0xe1, 0x19, 0x58, 0x35, 0x5b, 0xc6, 0x2f, 0xd5, 0x4b, 0xbb, 0x6b, 0xcd, 0x2d,
-	0xa4, 0xb6, 0x9a, 0x3b, 0xa0, 0xe8, 0x52, 0xaf, 0xb5, 0x3f, 0xfb, 0xe3, 0x5f, 0xaf, 0xf4, 0xd7,
-	0xc3, 0xce, 0xab, 0x7f, 0x1e, 0x76, 0x6a, 0x92, 0x0a, 0xd9, 0x63, 0x57, 0x57, 0xad, 0x06, 0xeb,
-	0x27, 0x3c, 0xa3, 0x8d, 0xd0, 0x22, 0xe0, 0xb7, 0xa0, 0xec, 0x8e, 0xa5, 0xff, 0x5a, 0xe3, 0x3e,
-	0x9a, 0xc6, 0x5d, 0xd8, 0xd5, 0xb6, 0xa7, 0x60, 0xe1, 0x58, 0x0d, 0x7f, 0x04, 0xb0, 0xc7, 0x04,
-	0xe1, 0x23, 0x9a, 0xdd, 0x45, 0x63, 0xc6, 0x8a, 0x66, 0xec, 0xa0, 0xc9, 0xcc, 0x40, 0xc7, 0x4e,
-	0xe7, 0x60, 0x61, 0xad, 0x37, 0x3b, 0x05, 0xbf, 0x07, 0x40, 0x88, 0x38, 0x22, 0x3c, 0xb9, 0x62,
-	0x7d, 0xdf, 0x7b, 0x8e, 0xe3, 0x5a, 0x70, 0x29, 0xe2, 0x8e, 0x96, 0x85, 0x15, 0xe1, 0x6e, 0xe1,
-	0x05, 0xa8, 0xce, 0x24, 0x82, 0xf0, 0xdf, 0x68, 0x4a, 0x63, 0x9a, 0xd2, 0x31, 0xaa, 0xb6, 0x11,
-	0x59, 0xd0, 0x26, 0x99, 0x9a, 0x15, 0x30, 0x04, 0x5b, 0x53, 0x79, 0xe1, 0x0a, 0x5b, 0xd5, 0xc8,
-	0xfa, 0x34, 0xf2, 0x9c, 0xe3, 0x5e, 0xdb, 0x0a, 0x2d, 0x10, 0xc6, 0x73, 0x73, 0xf0, 0x0c, 0xd4,
-	0x9e, 0x42, 0xc5, 0x01, 0xdf, 0x6a, 0xe0, 0xf6, 0x4c, 0x8d, 0x63, 0x99, 0xc5, 0x55, 0xc9, 0xcc,
-	0x0c, 0xec, 0x80, 0x8d, 0xc9, 0x74, 0x11, 0x7e, 0xb9, 0xbe, 0xa2, 0x41, 0x3a, 0x21, 0x10, 0x4e,
-	0x19, 0x1a, 0x35, 0xcd, 0xbb, 0x3c, 0xd5, 0xba, 0x8e, 0x92, 0x85, 0xeb, 0x83, 0xa7, 0x81, 0x80,
-	0x97, 0xa0, 0x36, 0x97, 0x1d, 0x7e, 0x45, 0x57, 0xf4, 0xc5, 0x0c, 0xc8, 0x44, 0x0d, 0xfa, 0xc9,
-	0xc8, 0x8f, 0x9d, 0x3a, 0xac, 0xf2, 0x99, 0x19, 0xf8, 0x09, 0xa8, 0xe4, 0x82, 0x46, 0x03, 0x29,
-	0xd3, 0xa6, 0x0f, 0xea, 0xa5, 0xdd, 0x72, 0x58, 0xce, 0x05, 0x3d, 0x55, 0x63, 0xd8, 0x01, 0x9e,
-	0x3a, 0xdd, 0xfe, 0x9a, 0x7e, 0xc8, 0x3e, 0x9a, 0x38, 0xea, 0x6e, 0xcf, 0x3f, 0xff, 0xce, 0x53,
-	0x4a, 0x4e, 0x5f, 0x85, 0xda, 0x0c, 0x3b, 0xe6, 0x08, 0x30, 0xe2, 0xaf, 0x6b, 0xcc, 0x97, 0xc8,
-	0xe6, 0x53, 0x11, 0x84, 0xb5, 0xc2, 0x23, 0xe0, 0xa9, 0x80, 0xf2, 0x37, 0x34, 0xe2, 0x3d, 0xd2,
-	0x69, 0x55, 0xa8, 0x06, 0xa5, 0x84, 0x2d, 0xb0, 0x82, 0x6f, 0x84, 0xff, 0x81, 0x6d, 0x96, 0x8a,
-	0x9e, 0x22, 0x66, 0x65, 0x82, 0x3f, 0x80, 0x37, 0x3a, 0x77, 0xfc, 0x4d, 0xed, 0xde, 0x45, 0x26,
-	0x85, 0x8a, 0xf8, 0x8d, 0x51, 0x75, 0xc0, 0x64, 0x90, 0x5f, 0xb5, 0x1d, 0xb0, 0x91, 0x54, 0xa8,
-	0x03, 0x46, 0x0b, 0x4f, 0xc0, 0x5b, 0x1b, 0x48, 0x7e, 0x4d, 0x53, 0xf6, 0x90, 0x0b, 0xa8, 0x42,
-	0x18, 0x7c, 0x23, 0x4e, 0x48, 0xb3, 0xf5, 0xf1, 0xfd, 0xa3, 0xe7, 0x81, 0xd7, 0xb9, 0xb8, 0x7f,
-	0xf4, 0xd6, 0x60, 0xc5, 0x7d, 0x3e, 0x88, 0xf6, 0x26, 0xd8, 0x70, 0x83, 0x48, 0xde, 0xa5, 0xb4,
-	0xf1, 0x21, 0xa8, 0xcd, 0x65, 0x41, 0xbb, 0xa5, 0x92, 0xea, 0xf7, 0xbf, 0xb7, 0x4b, 0xbf, 0x7c,
-	0x55, 0xec, 0x33, 0x25, 0x1d, 0xf6, 0x6d, 0x4c, 0x76, 0x57, 0x75, 0x3e, 0x1e, 0xfe, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0x57, 0x55, 0x3e, 0x32, 0xe1, 0x08, 0x00, 0x00,
+	// 925 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xc1, 0x6e, 0xdb, 0x36,
+	0x18, 0xc7, 0xeb, 0xc6, 0x6d, 0x1d, 0x26, 0x99, 0x6d, 0x26, 0x1b, 0x88, 0x6e, 0x4d, 0x0c, 0x0f,
+	0x58, 0xb3, 0x62, 0x91, 0x56, 0x17, 0xc3, 0x86, 0x0c, 0x1d, 0x06, 0x3b, 0x1d, 0x02, 0xa4, 0x5d,
+	0x07, 0x19, 0xbb, 0xec, 0x22, 0xd0, 0x14, 0x63, 0x73, 0x56, 0x44, 0x41, 0xa4, 0x9c, 0x64, 0xc7,
+	0x3d, 0xcd, 0x1e, 0x61, 0x8f, 0xb0, 0xfb, 0xee, 0x3d, 0xec, 0x0d, 0x36, 0x60, 0xf7, 0x81, 0xe4,
+	0x27, 0xd7, 0xb2, 0xe3, 0x46, 0x3b, 0xc4, 0xd2, 0x47, 0xfe, 0xff, 0x3f, 0xd3, 0x9f, 0xc8, 0x7f,
+	0x84, 0xbe, 0x1e, 0x0b, 0x3d, 0xc9, 0x47, 0x1e, 0x93, 0x17, 0xbe, 0x92, 0xb1, 0x3c, 0x12, 0xd2,
+	0x1f, 0xc7, 0x52, 0xfa, 0x69, 0x26, 0x7f, 0xe6, 0x4c, 0x2b, 0x57, 0xd1, 0x54, 0xf8, 0xb3, 0xa7,
+	0x7e, 0x9e, 0x2a, 0x9d, 0x71, 0x7a, 0xe1, 0xa5, 0x99, 0xd4, 0x12, 0x6f, 0x9b, 0x39, 0xcf, 0xd8,
+	0x3c, 0x21, 0x1f, 0xee, 0x8d, 0xe5, 0x58, 0xda, 0x09, 0xdf, 0xdc, 0x39, 0xcd, 0x43, 0xcc, 0xaf,
+	0xb4, 0x1b, 0xe4, 0x57, 0x1a, 0xc6, 0xf6, 0xed, 0x37, 0x4d, 0x85, 0x2e, 0xb8, 0x17, 0x5c, 0xd3,
+	0x88, 0x6a, 0x0a, 0xf3, 0x1f, 0xaf, 0x5f, 0x81, 0x52, 0x31, 0x88, 0xde, 0xb1, 0x4c, 0x26, 0x32,
+	0x96, 0x0b, 0x1d, 0x8e, 0x32, 0x4e, 0xa7, 0x3c, 0x03, 0xc3, 0xd1, 0x7a, 0x43, 0x2c, 0x69, 0x14,
+	0x8e, 0x68, 0x4c, 0x13, 0x36, 0x97, 0x3f, 0x79, 0x07, 0x5f, 0x26, 0x09, 0x67, 0x5a, 0xc8, 0x04,
+	0xb4, 0x87, 0xeb, 0xb5, 0xe7, 0x54, 0xc4, 0x72, 0x36, 0xa7, 0x7e, 0xb6, 0x5e, 0x39, 0xe1, 0x34,
+	0xd6, 0x93, 0x90, 0x4d, 0x38, 0x9b, 0x82, 0xfa, 0x64, 0x8d, 0x9a, 0x5f, 0x69, 0x9e, 0x25, 0x34,
+	0xf6, 0x79, 0x32, 0x93, 0xd7, 0x0e, 0xd0, 0xf3, 0x99, 0xcc, 0xf8, 0x4d, 0x94, 0x8f, 0x96, 0xdb,
+	0xad, 0x34, 0xd5, 0xb9, 0x82, 0xd9, 0x97, 0xff, 0xef, 0x3b, 0xe2, 0x5c, 0x69, 0x9e, 0xf9, 0x32,
+	0xd7, 0xb1, 0xe0, 0x59, 0x18, 0x71, 0x5d, 0xea, 0xc4, 0xca, 0xa3, 0x2d, 0x6a, 0x98, 0xff, 0x62,
+	0xfd, 0xef, 0x97, 0xa9, 0xe1, 0x28, 0xbb, 0x3a, 0xc1, 0xe0, 0x02, 0xb6, 0xa7, 0xb7, 0xdb, 0x52,
+	0x91, 0x72, 0xfb, 0x01, 0x96, 0xe7, 0xb7, 0x5b, 0xa6, 0xf9, 0x88, 0x67, 0x09, 0xd7, 0x7c, 0xf1,
+	0xf6, 0xf6, 0xed, 0x55, 0xd8, 0xe9, 0xa5, 0xfd, 0x03, 0xc3, 0xb3, 0x0a, 0x86, 0x5f, 0xf2, 0x8c,
+	0xbb, 0xcf, 0xea, 0xed, 0x60, 0x32, 0x51, 0x79, 0x0c, 0x17, 0xb0, 0x7d, 0x59, 0x6d, 0x71, 0x9c,
+	0xf5, 0xcc, 0x35, 0xe4, 0xac, 0x57, 0xdd, 0x18, 0x25, 0x2a, 0x54, 0xd9, 0xac, 0xb8, 0x82, 0xf1,
+	0xf1, 0xad, 0x46, 0x27, 0xec, 0xfe, 0xb9, 0x85, 0x1a, 0x3f, 0x42, 0x4c, 0xe0, 0x33, 0x74, 0xdf,
+	0xed, 0x35, 0x52, 0xeb, 0xd4, 0x0e, 0xb7, 0x7a, 0x7b, 0x9e, 0xd9, 0xa3, 0x45, 0x62, 0x78, 0x43,
+	0x3b, 0xd7, 0x7f, 0xf4, 0xfb, 0xbf, 0xf5, 0xda, 0x1f, 0x6f, 0x0e, 0xee, 0xfc, 0xf3, 0xe6, 0xa0,
+	0xad, 0xb9, 0xd2, 0x91, 0x38, 0x3f, 0x3f, 0xee, 0x8a, 0x71, 0x22, 0x33, 0xde, 0x0d, 0x00, 0x81,
+	0xbf, 0x42, 0x8d, 0x22, 0x27, 0xc8, 0x5d, 0x8b, 0xfb, 0xa0, 0x8c, 0x7b, 0x05, 0xb3, 0xfd, 0xba,
+	0x81, 0x05, 0x73, 0x35, 0xfe, 0x1e, 0xe1, 0x48, 0x28, 0x66, 0x8e, 0xe1, 0x75, 0x38, 0x67, 0x6c,
+	0x58, 0xc6, 0x81, 0xb7, 0x18, 0x62, 0xde, 0x49, 0xa1, 0x2b, 0x60, 0x41, 0x3b, 0x5a, 0x1e, 0xc2,
+	0xdf, 0x20, 0xa4, 0x54, 0x1c, 0x32, 0x99, 0x9c, 0x8b, 0x31, 0xa9, 0xdf, 0xc4, 0x29, 0x5a, 0x30,
+	0x54, 0xf1, 0xc0, 0xca, 0x82, 0x4d, 0x55, 0xdc, 0xe2, 0x57, 0xa8, 0xb5, 0x14, 0x51, 0x8a, 0xdc,
+	0xb3, 0x94, 0x6e, 0x99, 0x32, 0x70, 0xaa, 0xbe, 0x13, 0x01, 0xa8, 0xc9, 0x4a, 0xa3, 0x0a, 0x07,
+	0x68, 0xaf, 0x14, 0x60, 0xc5, 0xc2, 0xee, 0x5b, 0x64, 0xa7, 0x8c, 0x7c, 0x29, 0x69, 0xd4, 0x07,
+	0x21, 0x00, 0x71, 0xbc, 0x32, 0x86, 0xcf, 0x50, 0xfb, 0x6d, 0xca, 0x15, 0xc0, 0x07, 0x16, 0xb8,
+	0xbf, 0xb4, 0xc6, 0xb9, 0x0c, 0x70, 0x2d, 0xb6, 0x34, 0x82, 0x07, 0x68, 0x67, 0x31, 0x96, 0x14,
+	0x69, 0x74, 0x36, 0x2c, 0xc8, 0x46, 0x8b, 0x47, 0x53, 0xe1, 0xcd, 0x7a, 0xee, 0x59, 0x9e, 0x5a,
+	0xdd, 0xc0, 0xc8, 0x82, 0xed, 0xc9, 0xdb, 0x42, 0xe1, 0x21, 0x6a, 0xaf, 0x84, 0x0e, 0xd9, 0xb4,
+	0x2b, 0xfa, 0x64, 0x09, 0xe4, 0x32, 0xca, 0x7b, 0xed, 0xe4, 0x27, 0x85, 0x3a, 0x68, 0xc9, 0xa5,
+	0x11, 0xfc, 0x21, 0xda, 0xcc, 0x15, 0x0f, 0x27, 0x5a, 0xa7, 0x3d, 0x82, 0x3a, 0xb5, 0xc3, 0x46,
+	0xd0, 0xc8, 0x15, 0x3f, 0x35, 0x35, 0xee, 0xa1, 0x46, 0x91, 0xde, 0x04, 0xc3, 0x86, 0x2b, 0xfd,
+	0xf4, 0xef, 0x60, 0x36, 0x98, 0xeb, 0xf0, 0x29, 0x6a, 0xd9, 0x73, 0xc0, 0x64, 0x1c, 0xc2, 0xc1,
+	0x20, 0xbb, 0xd6, 0xfb, 0xa8, 0xec, 0xfd, 0x01, 0x54, 0xaf, 0x9d, 0x28, 0x68, 0xa6, 0xe5, 0x01,
+	0x7c, 0x86, 0xf0, 0x62, 0xd3, 0x42, 0x95, 0x72, 0xa6, 0xc8, 0x5e, 0x67, 0x63, 0x95, 0xb5, 0xd0,
+	0xb4, 0x61, 0xca, 0x59, 0xd0, 0x9a, 0x94, 0x07, 0x14, 0x1e, 0xa0, 0xba, 0x49, 0x38, 0xb2, 0x65,
+	0x97, 0x72, 0xe4, 0x2d, 0xc4, 0x5d, 0x71, 0x7c, 0x6f, 0xde, 0xbe, 0x29, 0x67, 0xa7, 0x77, 0x02,
+	0x6b, 0xc6, 0x03, 0x77, 0x9a, 0x05, 0x23, 0xdb, 0x16, 0xf3, 0xa9, 0xe7, 0xca, 0x4a, 0x08, 0xb0,
+	0xe2, 0xe7, 0xa8, 0x6e, 0x42, 0x9a, 0xec, 0x58, 0xc4, 0x63, 0xcf, 0x14, 0xd5, 0xd6, 0x60, 0x94,
+	0xf8, 0x18, 0x6d, 0xd0, 0x4b, 0x45, 0xde, 0x83, 0xe7, 0x6e, 0xe2, 0xb7, 0x8a, 0xd9, 0x98, 0xf0,
+	0xb7, 0xe8, 0x9e, 0xcd, 0x5e, 0xd2, 0xb4, 0xee, 0x43, 0xcf, 0x56, 0x95, 0xfc, 0xce, 0x68, 0x3a,
+	0xe0, 0x72, 0x98, 0xb4, 0xa0, 0x03, 0xae, 0xac, 0xd6, 0x01, 0xa7, 0xc5, 0x2f, 0xd0, 0x03, 0x08,
+	0x65, 0xd2, 0xb6, 0x94, 0x27, 0x1e, 0xd4, 0xd5, 0x30, 0xf4, 0x52, 0xbd, 0x60, 0x3d, 0x83, 0x81,
+	0x88, 0x26, 0xef, 0x03, 0x06, 0xea, 0x6a, 0x98, 0x28, 0x51, 0xc3, 0x6c, 0x76, 0xbc, 0xfb, 0xeb,
+	0xdf, 0xf5, 0x26, 0xba, 0x9b, 0x2b, 0xbc, 0x59, 0xbc, 0xdd, 0xa9, 0x7e, 0x13, 0xed, 0x14, 0x45,
+	0xa8, 0xaf, 0x53, 0xde, 0xdd, 0x45, 0xed, 0x95, 0x64, 0xec, 0x1f, 0x9b, 0xdc, 0xfe, 0xed, 0xaf,
+	0xfd, 0xda, 0x4f, 0x9f, 0x57, 0x7b, 0x8b, 0x4c, 0xa7, 0x63, 0xf8, 0xa7, 0x31, 0xba, 0x6f, 0xb7,
+	0xfb, 0xb3, 0xff, 0x06, 0x00, 0x1b, 0x0f, 0xf8, 0x5d, 0x80, 0x0a, 0x00, 0x00,
 }
 
 func (this *Upstream) Equal(that interface{}) bool {
@@ -425,6 +473,20 @@ func (this *Upstream) Equal(that interface{}) bool {
 	if this.UseHttp2 != that1.UseHttp2 {
 		return false
 	}
+	if !this.Failover.Equal(that1.Failover) {
+		return false
+	}
+	if !this.ProtocolOptions.Equal(that1.ProtocolOptions) {
+		return false
+	}
+	if len(this.HealthCheckSpecs) != len(that1.HealthCheckSpecs) {
+		return false
+	}
+	for i := range this.HealthCheckSpecs {
+		if !this.HealthCheckSpecs[i].Equal(that1.HealthCheckSpecs[i]) {
+			return false
+		}
+	}
 	if that1.UpstreamType == nil {
 		if this.UpstreamType != nil {
 			return false
@@ -607,6 +669,30 @@ func (this *Upstream_AwsEc2) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Upstream_DnsSrv) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Upstream_DnsSrv)
+	if !ok {
+		that2, ok := that.(Upstream_DnsSrv)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DnsSrv.Equal(that1.DnsSrv) {
+		return false
+	}
+	return true
+}
 func (this *DiscoveryMetadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
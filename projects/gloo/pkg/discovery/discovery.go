@@ -242,7 +242,8 @@ func aggregateEndpoints(endpointsByUds map[DiscoveryPlugin]v1.EndpointList) v1.E
 func txnEndpoint(original, desired *v1.Endpoint) (bool, error) {
 	equal := refsEqual(original.Upstreams, desired.Upstreams) &&
 		original.Address == desired.Address &&
-		original.Port == desired.Port
+		original.Port == desired.Port &&
+		original.LoadBalancingWeight.Equal(desired.LoadBalancingWeight)
 	return !equal, nil
 }
 
@@ -0,0 +1,294 @@
+package als
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoyal "github.com/envoyproxy/go-control-plane/envoy/config/filter/accesslog/v2"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/internal/common"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+const (
+	StatusCodeFilterRuntimeKey = "access_log.status_code_filter"
+	DurationFilterRuntimeKey   = "access_log.duration_filter"
+	RuntimeFilterRuntimeKey    = "access_log.runtime_filter"
+)
+
+var (
+	MissingComparisonErr   = eris.New("access log status code and duration filters must specify a comparison")
+	MissingHeaderErr       = eris.New("access log header filter must specify a header")
+	EmptyFilterErr         = eris.New("access log filter must specify a filter type")
+	NotEnoughSubFiltersErr = eris.New("access log and/or filters must specify at least two filters")
+)
+
+func translateFilter(ctx context.Context, in *als.AccessLogFilter) (*envoyal.AccessLogFilter, error) {
+	switch filter := in.GetFilterSpecifier().(type) {
+	case *als.AccessLogFilter_StatusCodeFilter:
+		comparison, err := translateComparison(filter.StatusCodeFilter.GetComparison(), StatusCodeFilterRuntimeKey)
+		if err != nil {
+			return nil, err
+		}
+		return &envoyal.AccessLogFilter{
+			FilterSpecifier: &envoyal.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &envoyal.StatusCodeFilter{Comparison: comparison},
+			},
+		}, nil
+	case *als.AccessLogFilter_DurationFilter:
+		comparison, err := translateComparison(filter.DurationFilter.GetComparison(), DurationFilterRuntimeKey)
+		if err != nil {
+			return nil, err
+		}
+		return &envoyal.AccessLogFilter{
+			FilterSpecifier: &envoyal.AccessLogFilter_DurationFilter{
+				DurationFilter: &envoyal.DurationFilter{Comparison: comparison},
+			},
+		}, nil
+	case *als.AccessLogFilter_NotHealthCheckFilter:
+		return &envoyal.AccessLogFilter{
+			FilterSpecifier: &envoyal.AccessLogFilter_NotHealthCheckFilter{
+				NotHealthCheckFilter: &envoyal.NotHealthCheckFilter{},
+			},
+		}, nil
+	case *als.AccessLogFilter_RuntimeFilter:
+		runtimeKey := filter.RuntimeFilter.GetRuntimeKey()
+		if runtimeKey == "" {
+			runtimeKey = RuntimeFilterRuntimeKey
+		}
+		return &envoyal.AccessLogFilter{
+			FilterSpecifier: &envoyal.AccessLogFilter_RuntimeFilter{
+				RuntimeFilter: &envoyal.RuntimeFilter{
+					RuntimeKey:               runtimeKey,
+					PercentSampled:           common.ToEnvoyPercentageWithDefault(filter.RuntimeFilter.GetPercentage(), 100),
+					UseIndependentRandomness: filter.RuntimeFilter.GetUseIndependentRandomness(),
+				},
+			},
+		}, nil
+	case *als.AccessLogFilter_HeaderFilter:
+		if filter.HeaderFilter.GetHeader() == nil {
+			return nil, MissingHeaderErr
+		}
+		return headerFilter(pluginutils.EnvoyHeaderMatchers(ctx, []*matchers.HeaderMatcher{filter.HeaderFilter.GetHeader()})[0]), nil
+	case *als.AccessLogFilter_AndFilter:
+		filters, err := translateFilters(ctx, filter.AndFilter.GetFilters())
+		if err != nil {
+			return nil, err
+		}
+		return &envoyal.AccessLogFilter{
+			FilterSpecifier: &envoyal.AccessLogFilter_AndFilter{
+				AndFilter: &envoyal.AndFilter{Filters: filters},
+			},
+		}, nil
+	case *als.AccessLogFilter_OrFilter:
+		filters, err := translateFilters(ctx, filter.OrFilter.GetFilters())
+		if err != nil {
+			return nil, err
+		}
+		return &envoyal.AccessLogFilter{
+			FilterSpecifier: &envoyal.AccessLogFilter_OrFilter{
+				OrFilter: &envoyal.OrFilter{Filters: filters},
+			},
+		}, nil
+	}
+	return nil, EmptyFilterErr
+}
+
+// envoy requires and/or filters to combine at least two filters
+func translateFilters(ctx context.Context, in []*als.AccessLogFilter) ([]*envoyal.AccessLogFilter, error) {
+	if len(in) < 2 {
+		return nil, NotEnoughSubFiltersErr
+	}
+	var out []*envoyal.AccessLogFilter
+	for _, filter := range in {
+		translated, err := translateFilter(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, translated)
+	}
+	return out, nil
+}
+
+func translateComparison(in *als.ComparisonFilter, defaultRuntimeKey string) (*envoyal.ComparisonFilter, error) {
+	if in == nil {
+		return nil, MissingComparisonErr
+	}
+	runtimeKey := in.GetRuntimeKey()
+	if runtimeKey == "" {
+		runtimeKey = defaultRuntimeKey
+	}
+	return &envoyal.ComparisonFilter{
+		Op: envoyal.ComparisonFilter_Op(in.GetOp()),
+		Value: &envoycore.RuntimeUInt32{
+			DefaultValue: in.GetValue(),
+			RuntimeKey:   runtimeKey,
+		},
+	}, nil
+}
+
+func headerFilter(header *envoyroute.HeaderMatcher) *envoyal.AccessLogFilter {
+	return &envoyal.AccessLogFilter{
+		FilterSpecifier: &envoyal.AccessLogFilter_HeaderFilter{
+			HeaderFilter: &envoyal.HeaderFilter{Header: header},
+		},
+	}
+}
+
+// Matches the requests that envoy does not route to a virtual host that disables access logging, or returns nil if no
+// virtual host disables access logging.
+// Access log filters can neither match the virtual host nor the route metadata of a request on envoy v2, so the host of
+// the request is matched against the domains of the virtual hosts instead, the way envoy selects a virtual host.
+func notDisabledFilter(ctx context.Context, virtualHosts []*v1.VirtualHost) *envoyal.AccessLogFilter {
+	var disabled, enabled []domain
+	for _, virtualHost := range virtualHosts {
+		names := virtualHost.GetDomains()
+		if len(names) == 0 {
+			names = []string{"*"}
+		}
+		for _, name := range names {
+			if virtualHost.GetOptions().GetAccessLogging().GetDisabled() {
+				disabled = append(disabled, parseDomain(name))
+			} else {
+				enabled = append(enabled, parseDomain(name))
+			}
+		}
+	}
+	if len(disabled) == 0 {
+		return nil
+	}
+
+	// a request is logged unless its host matches a domain that disables access logging, and none of the domains of
+	// the other virtual hosts that envoy matches first
+	var filters []*envoyal.AccessLogFilter
+	for _, d := range disabled {
+		alternatives := []*envoyal.AccessLogFilter{d.hostFilter(ctx, true)}
+		for _, e := range enabled {
+			if e.precedes(d) && e.overlaps(d) {
+				alternatives = append(alternatives, e.hostFilter(ctx, false))
+			}
+		}
+		filters = append(filters, orFilter(alternatives))
+	}
+	return andFilter(filters)
+}
+
+// combines the access log's own filter, if any, with the filter for virtual hosts that disable access logging, if any
+func withNotDisabledFilter(filter, notDisabled *envoyal.AccessLogFilter) *envoyal.AccessLogFilter {
+	if notDisabled == nil {
+		return filter
+	}
+	if filter == nil {
+		return notDisabled
+	}
+	return andFilter([]*envoyal.AccessLogFilter{filter, notDisabled})
+}
+
+// envoy requires and/or filters to combine at least two filters, so a single filter is returned as is
+func andFilter(filters []*envoyal.AccessLogFilter) *envoyal.AccessLogFilter {
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	return &envoyal.AccessLogFilter{
+		FilterSpecifier: &envoyal.AccessLogFilter_AndFilter{
+			AndFilter: &envoyal.AndFilter{Filters: filters},
+		},
+	}
+}
+
+func orFilter(filters []*envoyal.AccessLogFilter) *envoyal.AccessLogFilter {
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	return &envoyal.AccessLogFilter{
+		FilterSpecifier: &envoyal.AccessLogFilter_OrFilter{
+			OrFilter: &envoyal.OrFilter{Filters: filters},
+		},
+	}
+}
+
+// kinds of virtual host domains, in the order in which envoy matches them
+const (
+	exactDomain = iota
+	suffixWildcardDomain
+	prefixWildcardDomain
+	catchAllDomain
+)
+
+type domain struct {
+	name string
+	kind int
+}
+
+func parseDomain(name string) domain {
+	name = strings.ToLower(name)
+	switch {
+	case name == "" || name == "*":
+		return domain{name: "*", kind: catchAllDomain}
+	case strings.HasPrefix(name, "*"):
+		return domain{name: name, kind: suffixWildcardDomain}
+	case strings.HasSuffix(name, "*"):
+		return domain{name: name, kind: prefixWildcardDomain}
+	default:
+		return domain{name: name, kind: exactDomain}
+	}
+}
+
+// Returns true if envoy selects the virtual host of this domain over the one of the other domain, for hosts that
+// match both. Wildcard domains with a longer suffix or prefix are matched first.
+func (d domain) precedes(other domain) bool {
+	if d.kind != other.kind {
+		return d.kind < other.kind
+	}
+	return len(d.name) > len(other.name)
+}
+
+// Returns false if no host can match both domains.
+func (d domain) overlaps(other domain) bool {
+	if d.kind == exactDomain {
+		return regexp.MustCompile("^(?:" + other.regex() + ")$").MatchString(d.name)
+	}
+	if other.kind == exactDomain {
+		return other.overlaps(d)
+	}
+	if d.kind == other.kind && d.kind == suffixWildcardDomain {
+		return strings.HasSuffix(d.name, other.name[1:]) || strings.HasSuffix(other.name, d.name[1:])
+	}
+	if d.kind == other.kind && d.kind == prefixWildcardDomain {
+		return strings.HasPrefix(d.name, other.name[:len(other.name)-1]) || strings.HasPrefix(other.name, d.name[:len(d.name)-1])
+	}
+	return true
+}
+
+// envoy matches domains case-insensitively, and wildcards must match at least one character
+func (d domain) regex() string {
+	switch d.kind {
+	case exactDomain:
+		return "(?i)" + regexp.QuoteMeta(d.name)
+	case suffixWildcardDomain:
+		return "(?i).+" + regexp.QuoteMeta(strings.TrimPrefix(d.name, "*"))
+	case prefixWildcardDomain:
+		return "(?i)" + regexp.QuoteMeta(strings.TrimSuffix(d.name, "*")) + ".+"
+	}
+	return ".*"
+}
+
+func (d domain) hostFilter(ctx context.Context, invert bool) *envoyal.AccessLogFilter {
+	header := &envoyroute.HeaderMatcher{
+		Name:        ":authority",
+		InvertMatch: invert,
+	}
+	if d.kind == catchAllDomain {
+		header.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_PresentMatch{PresentMatch: true}
+	} else {
+		header.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_SafeRegexMatch{SafeRegexMatch: regexutils.NewRegex(ctx, d.regex())}
+	}
+	return headerFilter(header)
+}
@@ -0,0 +1,155 @@
+package als
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
+)
+
+var (
+	UnknownCommandOperatorErr = func(operator string) error {
+		return eris.Errorf("unknown access log command operator %%%v%%", operator)
+	}
+	UnterminatedCommandOperatorErr = func(format string) error {
+		return eris.Errorf("access log format %q has an unterminated command operator", format)
+	}
+	EmptyJsonFieldsErr = eris.New("access log json fields must name at least one field")
+	InvalidFormatErr   = func(err error, path string) error {
+		return eris.Wrapf(err, "invalid format for access log to file %v", path)
+	}
+)
+
+// command operators that envoy accepts without arguments.
+// https://www.envoyproxy.io/docs/envoy/v1.14.1/configuration/observability/access_log#command-operators
+var commandOperators = map[string]bool{
+	"START_TIME":                             true,
+	"REQUEST_DURATION":                       true,
+	"RESPONSE_DURATION":                      true,
+	"RESPONSE_TX_DURATION":                   true,
+	"DURATION":                               true,
+	"BYTES_RECEIVED":                         true,
+	"BYTES_SENT":                             true,
+	"PROTOCOL":                               true,
+	"RESPONSE_CODE":                          true,
+	"RESPONSE_CODE_DETAILS":                  true,
+	"RESPONSE_FLAGS":                         true,
+	"ROUTE_NAME":                             true,
+	"UPSTREAM_HOST":                          true,
+	"UPSTREAM_CLUSTER":                       true,
+	"UPSTREAM_LOCAL_ADDRESS":                 true,
+	"UPSTREAM_TRANSPORT_FAILURE_REASON":      true,
+	"DOWNSTREAM_REMOTE_ADDRESS":              true,
+	"DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT": true,
+	"DOWNSTREAM_DIRECT_REMOTE_ADDRESS":       true,
+	"DOWNSTREAM_DIRECT_REMOTE_ADDRESS_WITHOUT_PORT": true,
+	"DOWNSTREAM_LOCAL_ADDRESS":                      true,
+	"DOWNSTREAM_LOCAL_ADDRESS_WITHOUT_PORT":         true,
+	"REQUESTED_SERVER_NAME":                         true,
+	"DOWNSTREAM_PEER_URI_SAN":                       true,
+	"DOWNSTREAM_LOCAL_URI_SAN":                      true,
+	"DOWNSTREAM_PEER_SUBJECT":                       true,
+	"DOWNSTREAM_LOCAL_SUBJECT":                      true,
+	"DOWNSTREAM_TLS_SESSION_ID":                     true,
+	"DOWNSTREAM_TLS_CIPHER":                         true,
+	"DOWNSTREAM_TLS_VERSION":                        true,
+	"DOWNSTREAM_PEER_FINGERPRINT_256":               true,
+	"DOWNSTREAM_PEER_SERIAL":                        true,
+	"DOWNSTREAM_PEER_ISSUER":                        true,
+	"DOWNSTREAM_PEER_CERT":                          true,
+	"DOWNSTREAM_PEER_CERT_V_START":                  true,
+	"DOWNSTREAM_PEER_CERT_V_END":                    true,
+	"HOSTNAME":                                      true,
+}
+
+// command operators that envoy accepts with arguments, e.g. %REQ(:PATH):10%
+var commandOperatorsWithArgs = map[string]bool{
+	"START_TIME":       true,
+	"REQ":              true,
+	"RESP":             true,
+	"TRAILER":          true,
+	"DYNAMIC_METADATA": true,
+	"FILTER_STATE":     true,
+}
+
+var commandOperatorWithArgsRegex = regexp.MustCompile(`^%([A-Z_]+)\(`)
+var maxLengthRegex = regexp.MustCompile(`^(:[0-9]+)?%`)
+
+// ValidateAccessLoggingService checks the json fields of the file sinks of an access logging config, so
+// that an unknown command operator is reported instead of causing envoy to reject the listener.
+// String and json formats are passed to envoy as they are.
+func ValidateAccessLoggingService(service *als.AccessLoggingService) error {
+	for _, accessLog := range service.GetAccessLog() {
+		fileSink := accessLog.GetFileSink()
+		if fileSink == nil {
+			continue
+		}
+		if err := validateFileSinkFormat(fileSink); err != nil {
+			return InvalidFormatErr(err, fileSink.GetPath())
+		}
+	}
+	return nil
+}
+
+func validateFileSinkFormat(fileSink *als.FileSink) error {
+	jsonFields, ok := fileSink.GetOutputFormat().(*als.FileSink_JsonFields)
+	if !ok {
+		return nil
+	}
+	if len(jsonFields.JsonFields.GetFields()) == 0 {
+		return EmptyJsonFieldsErr
+	}
+	for _, name := range sortedFieldNames(jsonFields.JsonFields.GetFields()) {
+		if err := ValidateFormat(jsonFields.JsonFields.GetFields()[name]); err != nil {
+			return eris.Wrapf(err, "json field %v", name)
+		}
+	}
+	return nil
+}
+
+// ValidateFormat returns an error if the format string contains a command operator that envoy does not know,
+// following the parsing rules of envoy's access log formatter.
+func ValidateFormat(format string) error {
+	for pos := strings.Index(format, "%"); pos >= 0; pos = strings.Index(format, "%") {
+		format = format[pos:]
+		if match := commandOperatorWithArgsRegex.FindStringSubmatch(format); match != nil && commandOperatorsWithArgs[match[1]] {
+			// the arguments may contain '%' (e.g. the START_TIME format), so look for the closing parenthesis first
+			closing := ")%"
+			if match[1] != "START_TIME" {
+				closing = ")"
+			}
+			end := strings.Index(format[len(match[0]):], closing)
+			if end < 0 {
+				return UnterminatedCommandOperatorErr(format)
+			}
+			rest := format[len(match[0])+end+1:]
+			length := maxLengthRegex.FindString(rest)
+			if length == "" {
+				return UnterminatedCommandOperatorErr(format)
+			}
+			format = rest[len(length):]
+			continue
+		}
+		end := strings.Index(format[1:], "%")
+		if end < 0 {
+			return UnterminatedCommandOperatorErr(format)
+		}
+		operator := format[1 : end+1]
+		if !commandOperators[operator] {
+			return UnknownCommandOperatorErr(operator)
+		}
+		format = format[end+2:]
+	}
+	return nil
+}
+
+func sortedFieldNames(fields map[string]string) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,115 @@
+package als_test
+
+import (
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/als"
+)
+
+var _ = Describe("Format validation", func() {
+
+	DescribeTable("accepts known command operators",
+		func(format string) {
+			Expect(ValidateFormat(format)).NotTo(HaveOccurred())
+		},
+		Entry("plain text", "no operators here"),
+		Entry("operator", "%RESPONSE_CODE%"),
+		Entry("operators and text", "[%START_TIME%] %RESPONSE_CODE% %DURATION%ms\n"),
+		Entry("operator with arguments", "%REQ(:PATH)%"),
+		Entry("operator with alternate header and max length", "%REQ(X-FORWARDED-PATH?:PATH):10%"),
+		Entry("start time with a format containing %", "%START_TIME(%Y/%m/%dT%H:%M:%S%z %s)%"),
+		Entry("dynamic metadata", "%DYNAMIC_METADATA(com.test.my_filter:test_key)%"),
+	)
+
+	DescribeTable("rejects invalid formats",
+		func(format string, expected error) {
+			Expect(ValidateFormat(format)).To(MatchError(expected))
+		},
+		Entry("unknown operator", "%STATUS%", UnknownCommandOperatorErr("STATUS")),
+		Entry("unknown operator with arguments", "%HEADER(x)%", UnknownCommandOperatorErr("HEADER(x)")),
+		Entry("lowercase operator", "%response_code%", UnknownCommandOperatorErr("response_code")),
+		Entry("unterminated operator", "%RESPONSE_CODE", UnterminatedCommandOperatorErr("%RESPONSE_CODE")),
+		Entry("unterminated arguments", "%REQ(:PATH%", UnterminatedCommandOperatorErr("%REQ(:PATH%")),
+	)
+
+	It("validates the json fields of all file sinks", func() {
+		service := &als.AccessLoggingService{
+			AccessLog: []*als.AccessLog{
+				{
+					OutputDestination: &als.AccessLog_FileSink{
+						FileSink: &als.FileSink{
+							Path:         "/dev/stdout",
+							OutputFormat: &als.FileSink_StringFormat{StringFormat: "%RESPONSE_CODE%"},
+						},
+					},
+				},
+				{
+					OutputDestination: &als.AccessLog_FileSink{
+						FileSink: &als.FileSink{
+							Path: "/dev/stderr",
+							OutputFormat: &als.FileSink_JsonFields{
+								JsonFields: &als.JsonFields{
+									Fields: map[string]string{"code": "%CODE%"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		err := ValidateAccessLoggingService(service)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("/dev/stderr"))
+		Expect(err.Error()).To(ContainSubstring(UnknownCommandOperatorErr("CODE").Error()))
+	})
+
+	It("accepts existing string and json formats as they are", func() {
+		service := &als.AccessLoggingService{
+			AccessLog: []*als.AccessLog{
+				{
+					OutputDestination: &als.AccessLog_FileSink{
+						FileSink: &als.FileSink{
+							Path:         "/dev/stdout",
+							OutputFormat: &als.FileSink_StringFormat{StringFormat: "%STATUS% %REQ(:PATH%"},
+						},
+					},
+				},
+				{
+					OutputDestination: &als.AccessLog_FileSink{
+						FileSink: &als.FileSink{
+							Path: "/dev/stderr",
+							OutputFormat: &als.FileSink_JsonFormat{
+								JsonFormat: &types.Struct{
+									Fields: map[string]*types.Value{
+										"code":    {Kind: &types.Value_StringValue{StringValue: "%CODE%"}},
+										"version": {Kind: &types.Value_NumberValue{NumberValue: 2}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(ValidateAccessLoggingService(service)).NotTo(HaveOccurred())
+	})
+
+	It("rejects empty json fields", func() {
+		service := &als.AccessLoggingService{
+			AccessLog: []*als.AccessLog{{
+				OutputDestination: &als.AccessLog_FileSink{
+					FileSink: &als.FileSink{
+						OutputFormat: &als.FileSink_JsonFields{JsonFields: &als.JsonFields{}},
+					},
+				},
+			}},
+		}
+		err := ValidateAccessLoggingService(service)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(EmptyJsonFieldsErr.Error()))
+	})
+})
@@ -9,6 +9,7 @@ import (
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/tcp_proxy/v2"
 	envoyalfile "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/protoutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -61,7 +62,8 @@ func (p *Plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *en
 					}
 
 					accessLogs := hcmCfg.GetAccessLog()
-					hcmCfg.AccessLog, err = handleAccessLogPlugins(alSettings.AccessLoggingService, accessLogs, params)
+					hcmCfg.AccessLog, err = handleAccessLogPlugins(alSettings.AccessLoggingService, accessLogs, params,
+						notDisabledFilter(params.Ctx, listenerType.HttpListener.GetVirtualHosts()))
 					if err != nil {
 						return err
 					}
@@ -90,7 +92,7 @@ func (p *Plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *en
 					}
 
 					accessLogs := tcpCfg.GetAccessLog()
-					tcpCfg.AccessLog, err = handleAccessLogPlugins(alSettings.AccessLoggingService, accessLogs, params)
+					tcpCfg.AccessLog, err = handleAccessLogPlugins(alSettings.AccessLoggingService, accessLogs, params, nil)
 					if err != nil {
 						return err
 					}
@@ -107,9 +109,18 @@ func (p *Plugin) ProcessListener(params plugins.Params, in *v1.Listener, out *en
 	return nil
 }
 
-func handleAccessLogPlugins(service *als.AccessLoggingService, logCfg []*envoyal.AccessLog, params plugins.Params) ([]*envoyal.AccessLog, error) {
+func handleAccessLogPlugins(service *als.AccessLoggingService, logCfg []*envoyal.AccessLog, params plugins.Params, notDisabled *envoyal.AccessLogFilter) ([]*envoyal.AccessLog, error) {
 	results := make([]*envoyal.AccessLog, 0, len(service.GetAccessLog()))
 	for _, al := range service.GetAccessLog() {
+		var filter *envoyal.AccessLogFilter
+		if al.GetFilter() != nil {
+			var err error
+			if filter, err = translateFilter(params.Ctx, al.GetFilter()); err != nil {
+				return nil, err
+			}
+		}
+		filter = withNotDisabledFilter(filter, notDisabled)
+
 		switch cfgType := al.GetOutputDestination().(type) {
 		case *als.AccessLog_FileSink:
 			var cfg envoyalfile.FileAccessLog
@@ -120,6 +131,7 @@ func handleAccessLogPlugins(service *als.AccessLoggingService, logCfg []*envoyal
 			if err != nil {
 				return nil, err
 			}
+			newAlsCfg.Filter = filter
 			results = append(results, &newAlsCfg)
 		case *als.AccessLog_GrpcService:
 			var cfg envoyalcfg.HttpGrpcAccessLogConfig
@@ -130,6 +142,7 @@ func handleAccessLogPlugins(service *als.AccessLoggingService, logCfg []*envoyal
 			if err != nil {
 				return nil, err
 			}
+			newAlsCfg.Filter = filter
 			results = append(results, &newAlsCfg)
 		}
 	}
@@ -161,6 +174,9 @@ func copyGrpcSettings(cfg *envoyalcfg.HttpGrpcAccessLogConfig, alsSettings *als.
 
 func copyFileSettings(cfg *envoyalfile.FileAccessLog, alsSettings *als.AccessLog_FileSink) error {
 	cfg.Path = alsSettings.FileSink.Path
+	if err := validateFileSinkFormat(alsSettings.FileSink); err != nil {
+		return InvalidFormatErr(err, cfg.Path)
+	}
 	switch fileSinkType := alsSettings.FileSink.GetOutputFormat().(type) {
 	case *als.FileSink_StringFormat:
 		if fileSinkType.StringFormat != "" {
@@ -184,6 +200,18 @@ func copyFileSettings(cfg *envoyalfile.FileAccessLog, alsSettings *als.AccessLog
 				},
 			},
 		}
+	case *als.FileSink_JsonFields:
+		jsonFormat := &structpb.Struct{Fields: make(map[string]*structpb.Value)}
+		for name, value := range fileSinkType.JsonFields.GetFields() {
+			jsonFormat.Fields[name] = &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: value}}
+		}
+		cfg.AccessLogFormat = &envoyalfile.FileAccessLog_LogFormat{
+			LogFormat: &envoy_config_core_v3.SubstitutionFormatString{
+				Format: &envoy_config_core_v3.SubstitutionFormatString_JsonFormat{
+					JsonFormat: jsonFormat,
+				},
+			},
+		}
 	}
 	return cfg.Validate()
 }
@@ -1,12 +1,19 @@
 package als_test
 
 import (
+	"context"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	envoyal "github.com/envoyproxy/go-control-plane/envoy/config/filter/accesslog/v2"
 	envoyalfile "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type"
 	"github.com/gogo/protobuf/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/gloo/pkg/utils/protoutils"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
 	"github.com/solo-io/solo-kit/pkg/api/v1/control-plane/util"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -369,4 +376,393 @@ var _ = Describe("Plugin", func() {
 			})
 		})
 	})
+
+	Context("json fields", func() {
+		It("translates the fields to an envoy json format", func() {
+			in := &v1.Listener{
+				ListenerType: &v1.Listener_HttpListener{
+					HttpListener: &v1.HttpListener{},
+				},
+				Options: &v1.ListenerOptions{
+					AccessLoggingService: &als.AccessLoggingService{
+						AccessLog: []*als.AccessLog{{
+							OutputDestination: &als.AccessLog_FileSink{
+								FileSink: &als.FileSink{
+									Path: "/dev/stdout",
+									OutputFormat: &als.FileSink_JsonFields{
+										JsonFields: &als.JsonFields{
+											Fields: map[string]string{
+												"status":  "%RESPONSE_CODE%",
+												"request": "%REQ(:METHOD)% %REQ(:PATH)%",
+											},
+										},
+									},
+								},
+							},
+						}},
+					},
+				},
+			}
+			filters := []*envoylistener.Filter{{
+				Name: util.HTTPConnectionManager,
+			}}
+			outl := &envoyapi.Listener{
+				FilterChains: []*envoylistener.FilterChain{{
+					Filters: filters,
+				}},
+			}
+
+			err := NewPlugin().ProcessListener(plugins.Params{}, in, outl)
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoyhttp.HttpConnectionManager
+			err = translatorutil.ParseConfig(filters[0], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.AccessLog).To(HaveLen(1))
+			var falCfg envoyalfile.FileAccessLog
+			err = translatorutil.ParseConfig(cfg.AccessLog[0], &falCfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(protoutils.StructPbToGogo(falCfg.GetLogFormat().GetJsonFormat())).To(Equal(&types.Struct{
+				Fields: map[string]*types.Value{
+					"status":  {Kind: &types.Value_StringValue{StringValue: "%RESPONSE_CODE%"}},
+					"request": {Kind: &types.Value_StringValue{StringValue: "%REQ(:METHOD)% %REQ(:PATH)%"}},
+				},
+			}))
+		})
+
+		It("rejects unknown command operators", func() {
+			in := &v1.Listener{
+				ListenerType: &v1.Listener_HttpListener{
+					HttpListener: &v1.HttpListener{},
+				},
+				Options: &v1.ListenerOptions{
+					AccessLoggingService: &als.AccessLoggingService{
+						AccessLog: []*als.AccessLog{{
+							OutputDestination: &als.AccessLog_FileSink{
+								FileSink: &als.FileSink{
+									Path: "/dev/stdout",
+									OutputFormat: &als.FileSink_JsonFields{
+										JsonFields: &als.JsonFields{
+											Fields: map[string]string{"status": "%STATUS%"},
+										},
+									},
+								},
+							},
+						}},
+					},
+				},
+			}
+			outl := &envoyapi.Listener{
+				FilterChains: []*envoylistener.FilterChain{{
+					Filters: []*envoylistener.Filter{{
+						Name: util.HTTPConnectionManager,
+					}},
+				}},
+			}
+
+			err := NewPlugin().ProcessListener(plugins.Params{}, in, outl)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(UnknownCommandOperatorErr("STATUS").Error()))
+		})
+	})
+
+	Context("filters", func() {
+		var (
+			hl *v1.HttpListener
+			in *v1.Listener
+		)
+
+		BeforeEach(func() {
+			alsConfig = &als.AccessLoggingService{
+				AccessLog: []*als.AccessLog{
+					{
+						OutputDestination: &als.AccessLog_FileSink{
+							FileSink: &als.FileSink{
+								Path: "/dev/stdout",
+							},
+						},
+					},
+				},
+			}
+			hl = &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Name: "default",
+				}},
+			}
+			in = &v1.Listener{
+				ListenerType: &v1.Listener_HttpListener{
+					HttpListener: hl,
+				},
+				Options: &v1.ListenerOptions{
+					AccessLoggingService: alsConfig,
+				},
+			}
+		})
+
+		processAccessLogs := func() ([]*envoyal.AccessLog, error) {
+			filters := []*envoylistener.Filter{{
+				Name: util.HTTPConnectionManager,
+			}}
+			outl := &envoyapi.Listener{
+				FilterChains: []*envoylistener.FilterChain{{
+					Filters: filters,
+				}},
+			}
+
+			err := NewPlugin().ProcessListener(plugins.Params{Ctx: context.TODO()}, in, outl)
+			if err != nil {
+				return nil, err
+			}
+
+			var cfg envoyhttp.HttpConnectionManager
+			err = translatorutil.ParseConfig(filters[0], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			return cfg.AccessLog, nil
+		}
+
+		It("does not filter access logs by default", func() {
+			accessLogs, err := processAccessLogs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(accessLogs).To(HaveLen(1))
+			Expect(accessLogs[0].GetFilter()).To(BeNil())
+		})
+
+		It("translates access log filters", func() {
+			alsConfig.AccessLog[0].Filter = &als.AccessLogFilter{
+				FilterSpecifier: &als.AccessLogFilter_OrFilter{
+					OrFilter: &als.OrFilter{
+						Filters: []*als.AccessLogFilter{
+							{
+								FilterSpecifier: &als.AccessLogFilter_StatusCodeFilter{
+									StatusCodeFilter: &als.StatusCodeFilter{
+										Comparison: &als.ComparisonFilter{
+											Op:    als.ComparisonFilter_GE,
+											Value: 500,
+										},
+									},
+								},
+							},
+							{
+								FilterSpecifier: &als.AccessLogFilter_DurationFilter{
+									DurationFilter: &als.DurationFilter{
+										Comparison: &als.ComparisonFilter{
+											Op:         als.ComparisonFilter_GE,
+											Value:      1000,
+											RuntimeKey: "slow_requests",
+										},
+									},
+								},
+							},
+							{
+								FilterSpecifier: &als.AccessLogFilter_HeaderFilter{
+									HeaderFilter: &als.HeaderFilter{
+										Header: &matchers.HeaderMatcher{
+											Name:  ":path",
+											Value: "/payments.*",
+											Regex: true,
+										},
+									},
+								},
+							},
+							{
+								FilterSpecifier: &als.AccessLogFilter_RuntimeFilter{
+									RuntimeFilter: &als.RuntimeFilter{
+										Percentage: &types.FloatValue{Value: 10},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			accessLogs, err := processAccessLogs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(accessLogs).To(HaveLen(1))
+			Expect(accessLogs[0].GetFilter()).To(Equal(&envoyal.AccessLogFilter{
+				FilterSpecifier: &envoyal.AccessLogFilter_OrFilter{
+					OrFilter: &envoyal.OrFilter{
+						Filters: []*envoyal.AccessLogFilter{
+							{
+								FilterSpecifier: &envoyal.AccessLogFilter_StatusCodeFilter{
+									StatusCodeFilter: &envoyal.StatusCodeFilter{
+										Comparison: &envoyal.ComparisonFilter{
+											Op: envoyal.ComparisonFilter_GE,
+											Value: &envoycore.RuntimeUInt32{
+												DefaultValue: 500,
+												RuntimeKey:   StatusCodeFilterRuntimeKey,
+											},
+										},
+									},
+								},
+							},
+							{
+								FilterSpecifier: &envoyal.AccessLogFilter_DurationFilter{
+									DurationFilter: &envoyal.DurationFilter{
+										Comparison: &envoyal.ComparisonFilter{
+											Op: envoyal.ComparisonFilter_GE,
+											Value: &envoycore.RuntimeUInt32{
+												DefaultValue: 1000,
+												RuntimeKey:   "slow_requests",
+											},
+										},
+									},
+								},
+							},
+							{
+								FilterSpecifier: &envoyal.AccessLogFilter_HeaderFilter{
+									HeaderFilter: &envoyal.HeaderFilter{
+										Header: &envoyroute.HeaderMatcher{
+											Name: ":path",
+											HeaderMatchSpecifier: &envoyroute.HeaderMatcher_SafeRegexMatch{
+												SafeRegexMatch: regexutils.NewRegex(context.TODO(), "/payments.*"),
+											},
+										},
+									},
+								},
+							},
+							{
+								FilterSpecifier: &envoyal.AccessLogFilter_RuntimeFilter{
+									RuntimeFilter: &envoyal.RuntimeFilter{
+										RuntimeKey: RuntimeFilterRuntimeKey,
+										PercentSampled: &envoytype.FractionalPercent{
+											Numerator:   100000,
+											Denominator: envoytype.FractionalPercent_MILLION,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("rejects invalid access log filters", func() {
+			alsConfig.AccessLog[0].Filter = &als.AccessLogFilter{
+				FilterSpecifier: &als.AccessLogFilter_AndFilter{
+					AndFilter: &als.AndFilter{
+						Filters: []*als.AccessLogFilter{{
+							FilterSpecifier: &als.AccessLogFilter_NotHealthCheckFilter{
+								NotHealthCheckFilter: &als.NotHealthCheckFilter{},
+							},
+						}},
+					},
+				},
+			}
+			_, err := processAccessLogs()
+			Expect(err).To(MatchError(NotEnoughSubFiltersErr))
+
+			alsConfig.AccessLog[0].Filter = &als.AccessLogFilter{
+				FilterSpecifier: &als.AccessLogFilter_StatusCodeFilter{
+					StatusCodeFilter: &als.StatusCodeFilter{},
+				},
+			}
+			_, err = processAccessLogs()
+			Expect(err).To(MatchError(MissingComparisonErr))
+		})
+
+		Context("virtual hosts that disable access logging", func() {
+
+			hostFilter := func(hostRegex string, invert bool) *envoyal.AccessLogFilter {
+				header := &envoyroute.HeaderMatcher{
+					Name:                 ":authority",
+					HeaderMatchSpecifier: &envoyroute.HeaderMatcher_PresentMatch{PresentMatch: true},
+					InvertMatch:          invert,
+				}
+				if hostRegex != "" {
+					header.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_SafeRegexMatch{
+						SafeRegexMatch: regexutils.NewRegex(context.TODO(), hostRegex),
+					}
+				}
+				return &envoyal.AccessLogFilter{
+					FilterSpecifier: &envoyal.AccessLogFilter_HeaderFilter{
+						HeaderFilter: &envoyal.HeaderFilter{Header: header},
+					},
+				}
+			}
+
+			BeforeEach(func() {
+				hl.VirtualHosts = append(hl.VirtualHosts, &v1.VirtualHost{
+					Name:    "health",
+					Domains: []string{"Health.example.com"},
+					Options: &v1.VirtualHostOptions{
+						AccessLogging: &als.VirtualHostAccessLogging{Disabled: true},
+					},
+				})
+			})
+
+			It("filters out requests to the domains of the virtual host", func() {
+				accessLogs, err := processAccessLogs()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(accessLogs).To(HaveLen(1))
+				Expect(accessLogs[0].GetFilter()).To(Equal(hostFilter(`(?i)health\.example\.com`, true)))
+			})
+
+			It("combines the filter with the access log's own filter", func() {
+				alsConfig.AccessLog[0].Filter = &als.AccessLogFilter{
+					FilterSpecifier: &als.AccessLogFilter_NotHealthCheckFilter{
+						NotHealthCheckFilter: &als.NotHealthCheckFilter{},
+					},
+				}
+				accessLogs, err := processAccessLogs()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(accessLogs).To(HaveLen(1))
+				Expect(accessLogs[0].GetFilter()).To(Equal(&envoyal.AccessLogFilter{
+					FilterSpecifier: &envoyal.AccessLogFilter_AndFilter{
+						AndFilter: &envoyal.AndFilter{
+							Filters: []*envoyal.AccessLogFilter{
+								{
+									FilterSpecifier: &envoyal.AccessLogFilter_NotHealthCheckFilter{
+										NotHealthCheckFilter: &envoyal.NotHealthCheckFilter{},
+									},
+								},
+								hostFilter(`(?i)health\.example\.com`, true),
+							},
+						},
+					},
+				}))
+			})
+
+			It("keeps logging requests to the domains that envoy matches first", func() {
+				hl.VirtualHosts[0].Domains = []string{"api.example.com", "api.internal", "*.example.com", "www.*"}
+				hl.VirtualHosts[1].Domains = []string{"*.internal", "*"}
+
+				accessLogs, err := processAccessLogs()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(accessLogs).To(HaveLen(1))
+				Expect(accessLogs[0].GetFilter()).To(Equal(&envoyal.AccessLogFilter{
+					FilterSpecifier: &envoyal.AccessLogFilter_AndFilter{
+						AndFilter: &envoyal.AndFilter{
+							Filters: []*envoyal.AccessLogFilter{
+								{
+									FilterSpecifier: &envoyal.AccessLogFilter_OrFilter{
+										OrFilter: &envoyal.OrFilter{
+											Filters: []*envoyal.AccessLogFilter{
+												hostFilter(`(?i).+\.internal`, true),
+												hostFilter(`(?i)api\.internal`, false),
+											},
+										},
+									},
+								},
+								{
+									FilterSpecifier: &envoyal.AccessLogFilter_OrFilter{
+										OrFilter: &envoyal.OrFilter{
+											Filters: []*envoyal.AccessLogFilter{
+												hostFilter("", true),
+												hostFilter(`(?i)api\.example\.com`, false),
+												hostFilter(`(?i)api\.internal`, false),
+												hostFilter(`(?i).+\.example\.com`, false),
+												hostFilter(`(?i)www\..+`, false),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}))
+			})
+		})
+	})
 })
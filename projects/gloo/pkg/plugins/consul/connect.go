@@ -0,0 +1,82 @@
+package consul
+
+import (
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+)
+
+const (
+	// Prepended to the names of the endpoints of connect enabled upstreams
+	connectEndpointNamePrefix = "connect-"
+)
+
+var (
+	ConnectSdsNotConfiguredErr = eris.New("cannot originate consul connect mTLS: the consul settings do not set " +
+		"connectSdsClusterName, the cluster of the SDS server that serves the connect certificates to the proxies")
+)
+
+// Builds the transport socket used to originate Consul Connect mTLS to the proxies of the given service. The leaf
+// certificate and CA roots are served by the SDS server behind the given cluster, and the certificate of the proxy
+// must have been issued to the service.
+func connectTransportSocket(service, sdsClusterName string) *envoycore.TransportSocket {
+	tlsContext := &envoyauth.UpstreamTlsContext{
+		CommonTlsContext: &envoyauth.CommonTlsContext{
+			TlsCertificateSdsSecretConfigs: []*envoyauth.SdsSecretConfig{
+				connectSdsSecretConfig(consul.ConnectLeafCertSecretName, sdsClusterName),
+			},
+			ValidationContextType: &envoyauth.CommonTlsContext_CombinedValidationContext{
+				CombinedValidationContext: &envoyauth.CommonTlsContext_CombinedCertificateValidationContext{
+					DefaultValidationContext: &envoyauth.CertificateValidationContext{
+						MatchSubjectAltNames: []*envoymatcher.StringMatcher{{
+							MatchPattern: &envoymatcher.StringMatcher_SafeRegex{
+								SafeRegex: &envoymatcher.RegexMatcher{
+									EngineType: &envoymatcher.RegexMatcher_GoogleRe2{GoogleRe2: &envoymatcher.RegexMatcher_GoogleRE2{}},
+									Regex:      consul.ConnectServiceSpiffeIdRegex(service),
+								},
+							},
+						}},
+					},
+					ValidationContextSdsSecretConfig: connectSdsSecretConfig(consul.ConnectRootsSecretName, sdsClusterName),
+				},
+			},
+		},
+	}
+	return &envoycore.TransportSocket{
+		Name:       pluginutils.TlsTransportSocket,
+		ConfigType: &envoycore.TransportSocket_TypedConfig{TypedConfig: pluginutils.MustMessageToAny(tlsContext)},
+	}
+}
+
+func connectSdsSecretConfig(name, sdsClusterName string) *envoyauth.SdsSecretConfig {
+	return &envoyauth.SdsSecretConfig{
+		Name: name,
+		SdsConfig: &envoycore.ConfigSource{
+			ConfigSourceSpecifier: &envoycore.ConfigSource_ApiConfigSource{
+				ApiConfigSource: &envoycore.ApiConfigSource{
+					ApiType: envoycore.ApiConfigSource_GRPC,
+					GrpcServices: []*envoycore.GrpcService{{
+						TargetSpecifier: &envoycore.GrpcService_EnvoyGrpc_{
+							EnvoyGrpc: &envoycore.GrpcService_EnvoyGrpc{
+								ClusterName: sdsClusterName,
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+// The Connect-capable instances of a service are its sidecar proxies, which are registered as services of their own,
+// or the service itself if it integrates Connect natively. Returns the name of the service the instance serves.
+func connectDestinationServiceName(spec *consulapi.CatalogService) string {
+	if spec.ServiceProxy != nil && spec.ServiceProxy.DestinationServiceName != "" {
+		return spec.ServiceProxy.DestinationServiceName
+	}
+	return spec.ServiceName
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/solo-io/gloo/pkg/utils"
 
+	"github.com/gogo/protobuf/types"
 	consulapi "github.com/hashicorp/consul/api"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/go-utils/errutils"
@@ -37,12 +38,27 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 
 	// Filter out non-consul upstreams
 	trackedServiceToUpstreams := make(map[string][]*v1.Upstream)
-	var previousSpecs []*consulapi.CatalogService
+	// The endpoints of connect enabled upstreams are the Connect-capable instances of their service
+	trackedConnectServiceToUpstreams := make(map[string][]*v1.Upstream)
+	// The services whose instances are filtered based on their health checks
+	healthTrackedServices := make(map[string]bool)
+	pollHealth := false
+	var previousServiceMeta []*consul.ServiceMeta
+	var previousSpecs, previousConnectSpecs []*consulapi.CatalogService
 	var previousHash uint64
 	for _, us := range upstreamsToTrack {
 		if consulUsSpec := us.GetConsul(); consulUsSpec != nil {
+			if consulUsSpec.ConnectEnabled {
+				trackedConnectServiceToUpstreams[consulUsSpec.ServiceName] = append(trackedConnectServiceToUpstreams[consulUsSpec.ServiceName], us)
+				pollHealth = pollHealth || consulUsSpec.PassingOnly
+				continue
+			}
 			// We generate one upstream for every Consul service name, so this should never happen.
 			trackedServiceToUpstreams[consulUsSpec.ServiceName] = append(trackedServiceToUpstreams[consulUsSpec.ServiceName], us)
+			if consulUsSpec.PassingOnly {
+				healthTrackedServices[consulUsSpec.ServiceName] = true
+				pollHealth = true
+			}
 		}
 	}
 
@@ -100,19 +116,33 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 				ctx, newCancel := context.WithCancel(opts.Ctx)
 				cancel = newCancel
 
-				specs := refreshSpecs(ctx, p.client, serviceMeta, errChan)
-				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, specs, trackedServiceToUpstreams)
+				specs := refreshSpecs(ctx, p.client, serviceMeta, healthTrackedServices, errChan)
+				connectSpecs := refreshConnectSpecs(ctx, p.client, serviceMeta, trackedConnectServiceToUpstreams, errChan)
+				endpoints := buildAllEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, specs, trackedServiceToUpstreams, connectSpecs, trackedConnectServiceToUpstreams)
 
 				previousHash = hashutils.MustHash(endpoints)
 				previousSpecs = specs
+				previousConnectSpecs = connectSpecs
+				previousServiceMeta = serviceMeta
 
 				if !publishEndpoints(endpoints) {
 					return
 				}
 
 			case <-timer.C:
+				// Changes to the health of service instances do not trigger the services watch, so
+				// poll the services whose instances are filtered based on their health
+				if pollHealth && previousServiceMeta != nil {
+					cancel()
+					ctx, newCancel := context.WithCancel(opts.Ctx)
+					cancel = newCancel
+
+					previousSpecs = refreshSpecs(ctx, p.client, previousServiceMeta, healthTrackedServices, errChan)
+					previousConnectSpecs = refreshConnectSpecs(ctx, p.client, previousServiceMeta, trackedConnectServiceToUpstreams, errChan)
+				}
+
 				// Poll to ensure any DNS updates get picked up in endpoints for EDS
-				endpoints := buildEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, previousSpecs, trackedServiceToUpstreams)
+				endpoints := buildAllEndpointsFromSpecs(opts.Ctx, writeNamespace, p.resolver, previousSpecs, trackedServiceToUpstreams, previousConnectSpecs, trackedConnectServiceToUpstreams)
 
 				currentHash := hashutils.MustHash(endpoints)
 				if previousHash == currentHash {
@@ -137,7 +167,49 @@ func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.Upstr
 	return endpointsChan, errChan, nil
 }
 
-func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta []*consul.ServiceMeta, errChan chan error) []*consulapi.CatalogService {
+// The instances of the health tracked services are fetched together with their health checks.
+func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta []*consul.ServiceMeta, healthTrackedServices map[string]bool, errChan chan error) []*consulapi.CatalogService {
+	return collectSpecs(ctx, serviceMeta, errChan, func(queryOpts *consulapi.QueryOptions, service string) ([]*consulapi.CatalogService, error) {
+		if healthTrackedServices[service] {
+			entries, _, err := client.ServiceHealth(service, "", queryOpts)
+			if err != nil {
+				return nil, err
+			}
+			return toCatalogServices(entries, queryOpts.Datacenter), nil
+		}
+
+		services, _, err := client.Service(service, "", queryOpts)
+		return services, err
+	})
+}
+
+// Fetches the Connect-capable instances of the services tracked by connect enabled upstreams.
+func refreshConnectSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta []*consul.ServiceMeta, trackedConnectServiceToUpstreams map[string][]*v1.Upstream, errChan chan error) []*consulapi.CatalogService {
+	if len(trackedConnectServiceToUpstreams) == 0 {
+		return nil
+	}
+	return collectSpecs(ctx, serviceMeta, errChan, func(queryOpts *consulapi.QueryOptions, service string) ([]*consulapi.CatalogService, error) {
+		upstreams, ok := trackedConnectServiceToUpstreams[service]
+		if !ok {
+			return nil, nil
+		}
+
+		for _, us := range upstreams {
+			if us.GetConsul().GetPassingOnly() {
+				entries, _, err := client.ConnectHealth(service, "", queryOpts)
+				if err != nil {
+					return nil, err
+				}
+				return toCatalogServices(entries, queryOpts.Datacenter), nil
+			}
+		}
+
+		services, _, err := client.Connect(service, "", queryOpts)
+		return services, err
+	})
+}
+
+func collectSpecs(ctx context.Context, serviceMeta []*consul.ServiceMeta, errChan chan error, query func(queryOpts *consulapi.QueryOptions, service string) ([]*consulapi.CatalogService, error)) []*consulapi.CatalogService {
 	logger := contextutils.LoggerFrom(contextutils.WithLogger(ctx, "consul_eds"))
 
 	specs := newSpecCollector()
@@ -155,7 +227,7 @@ func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta
 			eg.Go(func() error {
 				queryOpts := &consulapi.QueryOptions{Datacenter: dcName, RequireConsistent: true}
 
-				services, _, err := client.Service(svc.Name, "", queryOpts.WithContext(ctx))
+				services, err := query(queryOpts.WithContext(ctx), svc.Name)
 				if err != nil {
 					return err
 				}
@@ -181,16 +253,63 @@ func refreshSpecs(ctx context.Context, client consul.ConsulWatcher, serviceMeta
 	return specs.Get()
 }
 
+// Converts the service entries returned by the Consul health API to the catalog representation of the service
+// instances, keeping their health checks.
+func toCatalogServices(entries []*consulapi.ServiceEntry, dataCenter string) []*consulapi.CatalogService {
+	var services []*consulapi.CatalogService
+	for _, entry := range entries {
+		if entry.Node == nil || entry.Service == nil {
+			continue
+		}
+		dc := entry.Node.Datacenter
+		if dc == "" {
+			dc = dataCenter
+		}
+		services = append(services, &consulapi.CatalogService{
+			ID:                       entry.Node.ID,
+			Node:                     entry.Node.Node,
+			Address:                  entry.Node.Address,
+			Datacenter:               dc,
+			TaggedAddresses:          entry.Node.TaggedAddresses,
+			NodeMeta:                 entry.Node.Meta,
+			ServiceID:                entry.Service.ID,
+			ServiceName:              entry.Service.Service,
+			ServiceAddress:           entry.Service.Address,
+			ServiceTaggedAddresses:   entry.Service.TaggedAddresses,
+			ServiceTags:              entry.Service.Tags,
+			ServiceMeta:              entry.Service.Meta,
+			ServicePort:              entry.Service.Port,
+			ServiceWeights:           consulapi.Weights{Passing: entry.Service.Weights.Passing, Warning: entry.Service.Weights.Warning},
+			ServiceEnableTagOverride: entry.Service.EnableTagOverride,
+			ServiceProxy:             entry.Service.Proxy,
+			CreateIndex:              entry.Service.CreateIndex,
+			Checks:                   entry.Checks,
+			ModifyIndex:              entry.Service.ModifyIndex,
+		})
+	}
+	return services
+}
+
 func buildEndpointsFromSpecs(ctx context.Context, writeNamespace string, resolver DnsResolver, specs []*consulapi.CatalogService, trackedServiceToUpstreams map[string][]*v1.Upstream) v1.EndpointList {
+	return buildAllEndpointsFromSpecs(ctx, writeNamespace, resolver, specs, trackedServiceToUpstreams, nil, nil)
+}
+
+// Builds the endpoints of both the upstreams that are connect enabled and those that are not.
+func buildAllEndpointsFromSpecs(ctx context.Context, writeNamespace string, resolver DnsResolver,
+	specs []*consulapi.CatalogService, trackedServiceToUpstreams map[string][]*v1.Upstream,
+	connectSpecs []*consulapi.CatalogService, trackedConnectServiceToUpstreams map[string][]*v1.Upstream) v1.EndpointList {
 	var endpoints v1.EndpointList
 	for _, spec := range specs {
 		if upstreams, ok := trackedServiceToUpstreams[spec.ServiceName]; ok {
-			// TODO if buildEndpoints fails temporarily due to dns failure, we will remove it from eds.
-			// tracking issue: https://github.com/solo-io/gloo/issues/2576
-			if eps, err := buildEndpoints(ctx, writeNamespace, resolver, spec, upstreams); err != nil {
-				contextutils.LoggerFrom(ctx).Warnf("consul eds plugin encountered error resolving DNS for consul service %v", spec, err)
-			} else {
-				endpoints = append(endpoints, eps...)
+			endpoints = append(endpoints, buildEndpointsOrWarn(ctx, writeNamespace, resolver, spec, upstreams)...)
+		}
+	}
+	for _, spec := range connectSpecs {
+		if upstreams, ok := trackedConnectServiceToUpstreams[connectDestinationServiceName(spec)]; ok {
+			for _, ep := range buildEndpointsOrWarn(ctx, writeNamespace, resolver, spec, upstreams) {
+				// Services that integrate Connect natively are their own Connect-capable instances
+				ep.Metadata.Name = kubeutils.SanitizeNameV2(connectEndpointNamePrefix + ep.Metadata.Name)
+				endpoints = append(endpoints, ep)
 			}
 		}
 	}
@@ -202,6 +321,16 @@ func buildEndpointsFromSpecs(ctx context.Context, writeNamespace string, resolve
 	return endpoints
 }
 
+func buildEndpointsOrWarn(ctx context.Context, writeNamespace string, resolver DnsResolver, spec *consulapi.CatalogService, upstreams []*v1.Upstream) []*v1.Endpoint {
+	// TODO if buildEndpoints fails temporarily due to dns failure, we will remove it from eds.
+	// tracking issue: https://github.com/solo-io/gloo/issues/2576
+	eps, err := buildEndpoints(ctx, writeNamespace, resolver, spec, upstreams)
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Warnf("consul eds plugin encountered error resolving DNS for consul service %v", spec, err)
+	}
+	return eps
+}
+
 // The ServiceTags on the Consul Upstream(s) represent all tags for Consul services with the given ServiceName across
 // data centers. We create an endpoint label for each of these tags, where the label key is the name of the tag and
 // the label value is "1" if the current service contains the same tag, else "0".
@@ -256,6 +385,12 @@ func BuildDataCenterMetadata(dataCenters []string, upstreams []*v1.Upstream) map
 
 func buildEndpoints(ctx context.Context, namespace string, resolver DnsResolver, service *consulapi.CatalogService, upstreams []*v1.Upstream) ([]*v1.Endpoint, error) {
 
+	// Don't bother resolving the address of instances that none of the upstreams accept because of their health
+	healthStatus := service.Checks.AggregatedStatus()
+	if len(filterUpstreamsByHealth(upstreams, healthStatus)) == 0 {
+		return nil, nil
+	}
+
 	// Address is the IP address of the Consul node on which the service is registered.
 	// ServiceAddress is the IP address of the service host — if empty, node address should be used
 	address := service.ServiceAddress
@@ -270,7 +405,7 @@ func buildEndpoints(ctx context.Context, namespace string, resolver DnsResolver,
 
 	var endpoints []*v1.Endpoint
 	for _, ipAddr := range ipAddresses {
-		endpoints = append(endpoints, buildEndpoint(namespace, address, ipAddr, service, upstreams))
+		endpoints = append(endpoints, buildEndpoint(namespace, address, ipAddr, healthStatus, service, upstreams))
 	}
 	return endpoints, nil
 }
@@ -301,7 +436,7 @@ func getIpAddresses(ctx context.Context, address string, resolver DnsResolver) (
 	return ipAddresses, nil
 }
 
-func buildEndpoint(namespace, address, ipAddress string, service *consulapi.CatalogService, upstreams []*v1.Upstream) *v1.Endpoint {
+func buildEndpoint(namespace, address, ipAddress, healthStatus string, service *consulapi.CatalogService, upstreams []*v1.Upstream) *v1.Endpoint {
 	hostname := ""
 	var healthCheckConfig *v1.HealthCheckConfig
 	if address != ipAddress {
@@ -311,6 +446,9 @@ func buildEndpoint(namespace, address, ipAddress string, service *consulapi.Cata
 			Hostname: hostname,
 		}
 	}
+	// The labels are computed from all the upstreams of the service, so that the subset keys are the same for all
+	// the instances of the service
+	healthyUpstreams := filterUpstreamsByHealth(upstreams, healthStatus)
 	return &v1.Endpoint{
 		Metadata: core.Metadata{
 			Namespace:       namespace,
@@ -318,12 +456,56 @@ func buildEndpoint(namespace, address, ipAddress string, service *consulapi.Cata
 			Labels:          buildLabels(service.ServiceTags, []string{service.Datacenter}, upstreams),
 			ResourceVersion: strconv.FormatUint(service.ModifyIndex, 10),
 		},
-		Upstreams:   toResourceRefs(upstreams, service.ServiceTags),
-		Address:     ipAddress,
-		Port:        uint32(service.ServicePort),
-		Hostname:    hostname,
-		HealthCheck: healthCheckConfig,
+		Upstreams:           toResourceRefs(healthyUpstreams, service.ServiceTags),
+		Address:             ipAddress,
+		Port:                uint32(service.ServicePort),
+		Hostname:            hostname,
+		HealthCheck:         healthCheckConfig,
+		LoadBalancingWeight: buildWeight(healthStatus, service.ServiceWeights, healthyUpstreams),
+	}
+}
+
+// Returns the upstreams that accept service instances with the given aggregated health check status.
+// Instances without health checks, or whose health checks were not fetched, are considered passing.
+func filterUpstreamsByHealth(upstreams []*v1.Upstream, healthStatus string) []*v1.Upstream {
+	var result []*v1.Upstream
+	for _, us := range upstreams {
+		spec := us.GetConsul()
+		switch {
+		case !spec.GetPassingOnly():
+		case healthStatus == consulapi.HealthPassing:
+		case healthStatus == consulapi.HealthWarning && spec.GetIncludeWarning():
+		default:
+			continue
+		}
+		result = append(result, us)
+	}
+	return result
+}
+
+// The endpoints of an instance are shared by all the upstreams of its service, so the Consul weight of the
+// instance is used as soon as one of the upstreams it belongs to uses the weights. Returns nil if the instance
+// was registered without weights.
+func buildWeight(healthStatus string, weights consulapi.Weights, upstreams []*v1.Upstream) *types.UInt32Value {
+	useWeights := false
+	for _, us := range upstreams {
+		spec := us.GetConsul()
+		if spec.GetUseServiceWeights() || (spec.GetPassingOnly() && spec.GetIncludeWarning()) {
+			useWeights = true
+		}
+	}
+	if !useWeights {
+		return nil
+	}
+
+	weight := weights.Passing
+	if healthStatus == consulapi.HealthWarning {
+		weight = weights.Warning
+	}
+	if weight <= 0 {
+		return nil
 	}
+	return &types.UInt32Value{Value: uint32(weight)}
 }
 
 func buildEndpointName(address string, service *consulapi.CatalogService) string {
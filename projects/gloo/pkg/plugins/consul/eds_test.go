@@ -16,6 +16,7 @@ import (
 
 	. "github.com/solo-io/gloo/projects/gloo/constants"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	consulapi "github.com/hashicorp/consul/api"
 	. "github.com/onsi/ginkgo"
@@ -695,6 +696,190 @@ var _ = Describe("Consul EDS", func() {
 		})
 
 	})
+
+	Describe("health checks and weights", func() {
+
+		var (
+			passingOnly, includeWarning, weighted, all *v1.Upstream
+		)
+
+		BeforeEach(func() {
+			passingOnly = createTestUpstream("passing", "my-svc", nil, []string{"dc-1"})
+			passingOnly.GetConsul().PassingOnly = true
+			includeWarning = createTestUpstream("warning", "my-svc", nil, []string{"dc-1"})
+			includeWarning.GetConsul().PassingOnly = true
+			includeWarning.GetConsul().IncludeWarning = true
+			weighted = createTestUpstream("weighted", "my-svc", nil, []string{"dc-1"})
+			weighted.GetConsul().UseServiceWeights = true
+			all = createTestUpstream("all", "my-svc", nil, []string{"dc-1"})
+		})
+
+		createServiceWithHealth := func(serviceStatus, nodeStatus string) *consulapi.CatalogService {
+			service := createTestService("127.0.0.1", "dc-1", "my-svc", "my-svc-0", nil, 1234, 100)
+			service.Node = "node-1"
+			service.ServiceWeights = consulapi.Weights{Passing: 10, Warning: 2}
+			service.Checks = consulapi.HealthChecks{
+				{Node: "node-1", CheckID: "serfHealth", Status: nodeStatus},
+				{Node: "node-1", CheckID: "service:my-svc-0", ServiceID: "my-svc-0", Status: serviceStatus},
+			}
+			return service
+		}
+
+		upstreamRefs := func(upstreams ...*v1.Upstream) []*core.ResourceRef {
+			var refs []*core.ResourceRef
+			for _, us := range upstreams {
+				refs = append(refs, utils.ResourceRefPtr(us.Metadata.Ref()))
+			}
+			return refs
+		}
+
+		It("only adds passing instances to upstreams that require them", func() {
+			service := createServiceWithHealth(consulapi.HealthCritical, consulapi.HealthPassing)
+
+			endpoints, err := buildEndpoints(context.TODO(), writeNamespace, nil, service, v1.UpstreamList{passingOnly, includeWarning, all})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Upstreams).To(Equal(upstreamRefs(all)))
+			Expect(endpoints[0].LoadBalancingWeight).To(BeNil())
+
+			service = createServiceWithHealth(consulapi.HealthPassing, consulapi.HealthPassing)
+			endpoints, err = buildEndpoints(context.TODO(), writeNamespace, nil, service, v1.UpstreamList{passingOnly, all})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Upstreams).To(Equal(upstreamRefs(passingOnly, all)))
+			Expect(endpoints[0].LoadBalancingWeight).To(BeNil())
+		})
+
+		It("takes the health of the node into account", func() {
+			service := createServiceWithHealth(consulapi.HealthPassing, consulapi.HealthCritical)
+
+			endpoints, err := buildEndpoints(context.TODO(), writeNamespace, nil, service, v1.UpstreamList{passingOnly})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(BeEmpty())
+		})
+
+		It("does not resolve the address of instances that no upstream accepts", func() {
+			service := createServiceWithHealth(consulapi.HealthCritical, consulapi.HealthPassing)
+			service.Address = "hostname.foo.com"
+
+			// the mock fails the test if it gets called
+			mockDnsResolver := mock_consul2.NewMockDnsResolver(ctrl)
+			endpoints, err := buildEndpoints(context.TODO(), writeNamespace, mockDnsResolver, service, v1.UpstreamList{passingOnly})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(BeEmpty())
+		})
+
+		It("includes warning instances with their warning weight", func() {
+			service := createServiceWithHealth(consulapi.HealthWarning, consulapi.HealthPassing)
+
+			endpoints, err := buildEndpoints(context.TODO(), writeNamespace, nil, service, v1.UpstreamList{passingOnly, includeWarning})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Upstreams).To(Equal(upstreamRefs(includeWarning)))
+			Expect(endpoints[0].LoadBalancingWeight).To(Equal(&types.UInt32Value{Value: 2}))
+
+			service = createServiceWithHealth(consulapi.HealthPassing, consulapi.HealthPassing)
+			endpoints, err = buildEndpoints(context.TODO(), writeNamespace, nil, service, v1.UpstreamList{passingOnly, includeWarning})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Upstreams).To(Equal(upstreamRefs(passingOnly, includeWarning)))
+			Expect(endpoints[0].LoadBalancingWeight).To(Equal(&types.UInt32Value{Value: 10}))
+		})
+
+		It("maps the consul weights to the endpoint weights", func() {
+			service := createServiceWithHealth(consulapi.HealthPassing, consulapi.HealthPassing)
+
+			endpoints, err := buildEndpoints(context.TODO(), writeNamespace, nil, service, v1.UpstreamList{weighted})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].LoadBalancingWeight).To(Equal(&types.UInt32Value{Value: 10}))
+
+			// instances registered without weights
+			service.ServiceWeights = consulapi.Weights{}
+			endpoints, err = buildEndpoints(context.TODO(), writeNamespace, nil, service, v1.UpstreamList{weighted})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].LoadBalancingWeight).To(BeNil())
+		})
+
+		It("fetches the health checks of the health tracked services", func() {
+			consulWatcherMock := mock_consul.NewMockConsulWatcher(ctrl)
+			consulWatcherMock.EXPECT().ServiceHealth("my-svc", "", gomock.Any()).Return([]*consulapi.ServiceEntry{
+				{
+					Node: &consulapi.Node{Node: "node-1", Address: "127.0.0.1"},
+					Service: &consulapi.AgentService{
+						ID:      "my-svc-0",
+						Service: "my-svc",
+						Port:    1234,
+						Weights: consulapi.AgentWeights{Passing: 10, Warning: 2},
+					},
+					Checks: consulapi.HealthChecks{
+						{Node: "node-1", CheckID: "service:my-svc-0", ServiceID: "my-svc-0", Status: consulapi.HealthWarning},
+					},
+				},
+			}, nil, nil).Times(1)
+			consulWatcherMock.EXPECT().Service("other-svc", "", gomock.Any()).Return(nil, nil, nil).Times(1)
+
+			serviceMeta := []*consul.ServiceMeta{
+				{Name: "my-svc", DataCenters: []string{"dc-1"}},
+				{Name: "other-svc", DataCenters: []string{"dc-1"}},
+			}
+			specs := refreshSpecs(context.TODO(), consulWatcherMock, serviceMeta, map[string]bool{"my-svc": true}, make(chan error, 1))
+			Expect(specs).To(HaveLen(1))
+			Expect(specs[0].ServiceName).To(Equal("my-svc"))
+			Expect(specs[0].Datacenter).To(Equal("dc-1"))
+			Expect(specs[0].Address).To(Equal("127.0.0.1"))
+			Expect(specs[0].ServiceWeights).To(Equal(consulapi.Weights{Passing: 10, Warning: 2}))
+			Expect(specs[0].Checks.AggregatedStatus()).To(Equal(consulapi.HealthWarning))
+		})
+	})
+
+	Describe("connect", func() {
+
+		var (
+			connectUpstream, plainUpstream *v1.Upstream
+		)
+
+		BeforeEach(func() {
+			connectUpstream = createTestUpstream("connect", "my-svc", nil, []string{"dc-1"})
+			connectUpstream.GetConsul().ConnectEnabled = true
+			plainUpstream = createTestUpstream("plain", "my-svc", nil, []string{"dc-1"})
+		})
+
+		It("fetches the connect-capable instances of the services of connect enabled upstreams", func() {
+			consulWatcherMock := mock_consul.NewMockConsulWatcher(ctrl)
+			consulWatcherMock.EXPECT().Connect("my-svc", "", gomock.Any()).Return([]*consulapi.CatalogService{
+				createTestService("127.0.0.1", "dc-1", "my-svc-sidecar-proxy", "my-svc-0-sidecar-proxy", nil, 21000, 100),
+			}, nil, nil).Times(1)
+
+			serviceMeta := []*consul.ServiceMeta{
+				{Name: "my-svc", DataCenters: []string{"dc-1"}},
+				{Name: "other-svc", DataCenters: []string{"dc-1"}},
+			}
+			trackedConnectServices := map[string][]*v1.Upstream{"my-svc": {connectUpstream}}
+			specs := refreshConnectSpecs(context.TODO(), consulWatcherMock, serviceMeta, trackedConnectServices, make(chan error, 1))
+			Expect(specs).To(HaveLen(1))
+			Expect(specs[0].ServiceName).To(Equal("my-svc-sidecar-proxy"))
+		})
+
+		It("routes connect enabled upstreams to the sidecar proxies of their service", func() {
+			service := createTestService("127.0.0.1", "dc-1", "my-svc", "my-svc-0", nil, 1234, 100)
+			proxy := createTestService("127.0.0.1", "dc-1", "my-svc-sidecar-proxy", "my-svc-0-sidecar-proxy", nil, 21000, 100)
+			proxy.ServiceProxy = &consulapi.AgentServiceConnectProxyConfig{DestinationServiceName: "my-svc"}
+
+			endpoints := buildAllEndpointsFromSpecs(context.TODO(), writeNamespace, nil,
+				[]*consulapi.CatalogService{service}, map[string][]*v1.Upstream{"my-svc": {plainUpstream}},
+				[]*consulapi.CatalogService{proxy}, map[string][]*v1.Upstream{"my-svc": {connectUpstream}})
+			Expect(endpoints).To(HaveLen(2))
+
+			Expect(endpoints[0].Port).To(BeEquivalentTo(1234))
+			Expect(endpoints[0].Upstreams).To(Equal([]*core.ResourceRef{utils.ResourceRefPtr(plainUpstream.Metadata.Ref())}))
+
+			Expect(endpoints[1].Metadata.Name).To(HavePrefix("connect-"))
+			Expect(endpoints[1].Port).To(BeEquivalentTo(21000))
+			Expect(endpoints[1].Upstreams).To(Equal([]*core.ResourceRef{utils.ResourceRefPtr(connectUpstream.Metadata.Ref())}))
+		})
+	})
 })
 
 func createTestUpstream(usptreamName, svcName string, tags, dataCenters []string) *v1.Upstream {
@@ -30,6 +30,7 @@ type plugin struct {
 	client             consul.ConsulWatcher
 	resolver           DnsResolver
 	dnsPollingInterval time.Duration
+	connectSdsCluster  string
 }
 
 func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
@@ -83,11 +84,12 @@ func NewPlugin(client consul.ConsulWatcher, resolver DnsResolver, dnsPollingInte
 }
 
 func (p *plugin) Init(params plugins.InitParams) error {
+	p.connectSdsCluster = params.Settings.GetConsul().GetConnectSdsClusterName()
 	return nil
 }
 
 func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
-	_, ok := in.UpstreamType.(*v1.Upstream_Consul)
+	consulSpec, ok := in.UpstreamType.(*v1.Upstream_Consul)
 	if !ok {
 		return nil
 	}
@@ -95,6 +97,15 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	// consul upstreams use EDS
 	xds.SetEdsOnCluster(out)
 
+	// originate connect mTLS, unless the upstream has an ssl config of its own
+	if consulSpec.Consul.GetConnectEnabled() && out.TransportSocket == nil {
+		// the proxies cannot load the connect certificates without an SDS server, and would reject the whole cds update
+		if p.connectSdsCluster == "" {
+			return ConnectSdsNotConfiguredErr
+		}
+		out.TransportSocket = connectTransportSocket(consulSpec.Consul.GetServiceName(), p.connectSdsCluster)
+	}
+
 	return nil
 }
 
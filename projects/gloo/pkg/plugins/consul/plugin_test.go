@@ -4,6 +4,14 @@ import (
 	"net"
 	"net/url"
 
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+
 	mock_consul2 "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul/mocks"
 
 	"github.com/golang/mock/gomock"
@@ -120,3 +128,74 @@ var _ = Describe("Resolve", func() {
 		Expect(u).To(Equal(&url.URL{Scheme: "http", Host: "5.6.7.8:1234"}))
 	})
 })
+
+var _ = Describe("ProcessUpstream", func() {
+
+	var plug *plugin
+
+	BeforeEach(func() {
+		plug = NewPlugin(nil, nil, nil)
+		err := plug.Init(plugins.InitParams{Settings: &v1.Settings{
+			Consul: &v1.Settings_ConsulConfiguration{ConnectSdsClusterName: "gateway_proxy_sds"},
+		}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("originates Consul Connect mTLS to connect enabled upstreams", func() {
+		us := createTestUpstream("my-svc", "my-svc", nil, []string{"dc1"})
+		us.GetConsul().ConnectEnabled = true
+		out := &envoyapi.Cluster{}
+
+		err := plug.ProcessUpstream(plugins.Params{}, us, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.TransportSocket).NotTo(BeNil())
+		Expect(out.TransportSocket.Name).To(Equal(pluginutils.TlsTransportSocket))
+
+		msg, err := pluginutils.AnyToMessage(out.TransportSocket.GetTypedConfig())
+		Expect(err).NotTo(HaveOccurred())
+		tlsContext := msg.(*envoyauth.UpstreamTlsContext)
+
+		certConfigs := tlsContext.CommonTlsContext.TlsCertificateSdsSecretConfigs
+		Expect(certConfigs).To(HaveLen(1))
+		Expect(certConfigs[0].Name).To(Equal(consul.ConnectLeafCertSecretName))
+		Expect(certConfigs[0].SdsConfig.GetApiConfigSource().GrpcServices[0].GetEnvoyGrpc().ClusterName).To(Equal("gateway_proxy_sds"))
+
+		validationContext := tlsContext.CommonTlsContext.GetCombinedValidationContext()
+		Expect(validationContext.ValidationContextSdsSecretConfig.Name).To(Equal(consul.ConnectRootsSecretName))
+		subjectAltNames := validationContext.DefaultValidationContext.MatchSubjectAltNames
+		Expect(subjectAltNames).To(HaveLen(1))
+		Expect(subjectAltNames[0].GetSafeRegex().Regex).To(Equal(consul.ConnectServiceSpiffeIdRegex("my-svc")))
+	})
+
+	It("rejects connect enabled upstreams if no SDS cluster is configured", func() {
+		err := plug.Init(plugins.InitParams{Settings: &v1.Settings{}})
+		Expect(err).NotTo(HaveOccurred())
+		us := createTestUpstream("my-svc", "my-svc", nil, []string{"dc1"})
+		us.GetConsul().ConnectEnabled = true
+		out := &envoyapi.Cluster{}
+
+		err = plug.ProcessUpstream(plugins.Params{}, us, out)
+		Expect(err).To(MatchError(ConnectSdsNotConfiguredErr))
+		Expect(out.TransportSocket).To(BeNil())
+	})
+
+	It("does not override the transport socket of the cluster", func() {
+		us := createTestUpstream("my-svc", "my-svc", nil, []string{"dc1"})
+		us.GetConsul().ConnectEnabled = true
+		transportSocket := &envoycore.TransportSocket{Name: "custom"}
+		out := &envoyapi.Cluster{TransportSocket: transportSocket}
+
+		err := plug.ProcessUpstream(plugins.Params{}, us, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.TransportSocket).To(BeIdenticalTo(transportSocket))
+	})
+
+	It("does not originate mTLS to upstreams that are not connect enabled", func() {
+		us := createTestUpstream("my-svc", "my-svc", nil, []string{"dc1"})
+		out := &envoyapi.Cluster{}
+
+		err := plug.ProcessUpstream(plugins.Params{}, us, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.TransportSocket).To(BeNil())
+	})
+})
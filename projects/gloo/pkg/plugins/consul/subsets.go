@@ -42,6 +42,9 @@ func (p *plugin) ProcessRouteAction(params plugins.RouteActionParams, inAction *
 			Destinations: upstreamGroup.Destinations,
 		}
 		return setWeightedClusters(params.Params, md, out)
+
+	case *v1.RouteAction_DynamicForwardProxy:
+		return nil
 	}
 	return eris.Errorf("unknown upstream destination type")
 }
@@ -79,6 +79,11 @@ func UpdateUpstream(original, desired *v1.Upstream) (bool, error) {
 	// copy service spec, we don't want to overwrite that
 	desiredSpec.Consul.ServiceSpec = originalSpec.Consul.ServiceSpec
 
+	// the health and weight options are set by the user, keep them
+	desiredSpec.Consul.PassingOnly = originalSpec.Consul.PassingOnly
+	desiredSpec.Consul.IncludeWarning = originalSpec.Consul.IncludeWarning
+	desiredSpec.Consul.UseServiceWeights = originalSpec.Consul.UseServiceWeights
+
 	utils.UpdateUpstream(original, desired)
 
 	if originalSpec.Equal(desiredSpec) {
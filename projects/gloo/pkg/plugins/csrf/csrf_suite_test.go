@@ -0,0 +1,13 @@
+package csrf_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCsrf(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Csrf Suite")
+}
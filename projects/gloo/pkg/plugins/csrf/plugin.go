@@ -0,0 +1,146 @@
+package csrf
+
+import (
+	"context"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoycsrf "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/csrf/v2"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher"
+	"github.com/gogo/protobuf/types"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/csrf"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/internal/common"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+const FilterName = "envoy.filters.http.csrf"
+
+// csrf checks run right after cors, so that cors preflight requests are answered first
+var pluginStage = plugins.AfterStage(plugins.CorsStage)
+
+var (
+	InvalidPercentageErr = func(field string, percentage float32) error {
+		return eris.Errorf("csrf policy %v must be a percentage between 0 and 100, got %v", field, percentage)
+	}
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+
+type Plugin struct {
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	csrfPolicy := in.GetOptions().GetCsrf()
+	if csrfPolicy == nil {
+		return nil
+	}
+
+	config, err := translateCsrfPolicy(params.Ctx, csrfPolicy)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, config)
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	csrfPolicy := in.GetOptions().GetCsrf()
+	if csrfPolicy == nil {
+		return nil
+	}
+
+	config, err := translateCsrfPolicy(params.Ctx, csrfPolicy)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, config)
+}
+
+// The listener-level policy is disabled; policies are only enforced on the virtual hosts and routes
+// that define them, through their per-filter config.
+func (p *Plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if !usesCsrf(listener) {
+		return nil, nil
+	}
+
+	filter, err := plugins.NewStagedFilterWithConfig(FilterName, &envoycsrf.CsrfPolicy{
+		FilterEnabled: runtimePercentage(common.ToEnvoyPercentage(0)),
+	}, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func usesCsrf(listener *v1.HttpListener) bool {
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		if virtualHost.GetOptions().GetCsrf() != nil {
+			return true
+		}
+		for _, route := range virtualHost.GetRoutes() {
+			if route.GetOptions().GetCsrf() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func translateCsrfPolicy(ctx context.Context, in *csrf.CsrfPolicy) (*envoycsrf.CsrfPolicy, error) {
+	if err := validatePercentage("filterEnabled", in.GetFilterEnabled()); err != nil {
+		return nil, err
+	}
+	if err := validatePercentage("shadowEnabled", in.GetShadowEnabled()); err != nil {
+		return nil, err
+	}
+
+	out := &envoycsrf.CsrfPolicy{
+		FilterEnabled: runtimePercentage(common.ToEnvoyPercentageWithDefault(in.GetFilterEnabled(), 100)),
+	}
+	if in.GetShadowEnabled() != nil {
+		out.ShadowEnabled = runtimePercentage(common.ToEnvoyPercentage(in.GetShadowEnabled().GetValue()))
+	}
+	for _, origin := range in.GetAdditionalOrigin() {
+		out.AdditionalOrigins = append(out.AdditionalOrigins, &envoymatcher.StringMatcher{
+			MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: origin},
+		})
+	}
+	for _, origin := range in.GetAdditionalOriginRegex() {
+		out.AdditionalOrigins = append(out.AdditionalOrigins, &envoymatcher.StringMatcher{
+			MatchPattern: &envoymatcher.StringMatcher_SafeRegex{SafeRegex: regexutils.NewRegex(ctx, origin)},
+		})
+	}
+	return out, nil
+}
+
+func validatePercentage(field string, percentage *types.FloatValue) error {
+	if percentage == nil {
+		return nil
+	}
+	if percentage.GetValue() < 0 || percentage.GetValue() > 100 {
+		return InvalidPercentageErr(field, percentage.GetValue())
+	}
+	return nil
+}
+
+func runtimePercentage(percentage *envoytype.FractionalPercent) *envoycore.RuntimeFractionalPercent {
+	return &envoycore.RuntimeFractionalPercent{
+		DefaultValue: percentage,
+	}
+}
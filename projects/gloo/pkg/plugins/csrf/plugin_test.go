@@ -0,0 +1,156 @@
+package csrf_test
+
+import (
+	"context"
+
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoycsrf "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/csrf/v2"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type"
+	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher"
+	"github.com/envoyproxy/go-control-plane/pkg/conversion"
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/csrf"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/csrf"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params plugins.RouteParams
+	)
+
+	BeforeEach(func() {
+		params = plugins.RouteParams{
+			VirtualHostParams: plugins.VirtualHostParams{
+				Params: plugins.Params{
+					Ctx: context.TODO(),
+				},
+			},
+		}
+	})
+
+	percentage := func(numerator uint32) *envoycore.RuntimeFractionalPercent {
+		return &envoycore.RuntimeFractionalPercent{
+			DefaultValue: &envoytype.FractionalPercent{
+				Numerator:   numerator,
+				Denominator: envoytype.FractionalPercent_MILLION,
+			},
+		}
+	}
+
+	Context("http filters", func() {
+
+		It("adds a disabled filter when a virtual host uses csrf", func() {
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Options: &v1.VirtualHostOptions{
+						Csrf: &csrf.CsrfPolicy{},
+					},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+			Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.CorsStage)))
+
+			var cfg envoycsrf.CsrfPolicy
+			err = conversion.StructToMessage(filters[0].HttpFilter.GetConfig(), &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg).To(Equal(envoycsrf.CsrfPolicy{
+				FilterEnabled: percentage(0),
+			}))
+		})
+
+		It("adds a filter when a route uses csrf", func() {
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{
+						Options: &v1.RouteOptions{
+							Csrf: &csrf.CsrfPolicy{},
+						},
+					}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+		})
+
+		It("does not add the filter when csrf is not used", func() {
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Routes: []*v1.Route{{}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+	})
+
+	Context("per route config", func() {
+
+		It("enforces the policy on all requests by default", func() {
+			out := &envoyroute.VirtualHost{}
+			err := NewPlugin().ProcessVirtualHost(params.VirtualHostParams, &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{
+					Csrf: &csrf.CsrfPolicy{},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoycsrf.CsrfPolicy
+			err = conversion.StructToMessage(out.GetPerFilterConfig()[FilterName], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg).To(Equal(envoycsrf.CsrfPolicy{
+				FilterEnabled: percentage(1000000),
+			}))
+		})
+
+		It("translates the route policy", func() {
+			out := &envoyroute.Route{}
+			err := NewPlugin().ProcessRoute(params, &v1.Route{
+				Options: &v1.RouteOptions{
+					Csrf: &csrf.CsrfPolicy{
+						FilterEnabled:         &types.FloatValue{Value: 0},
+						ShadowEnabled:         &types.FloatValue{Value: 50},
+						AdditionalOrigin:      []string{"solo.io"},
+						AdditionalOriginRegex: []string{".*\\.solo\\.io"},
+					},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoycsrf.CsrfPolicy
+			err = conversion.StructToMessage(out.GetPerFilterConfig()[FilterName], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg).To(Equal(envoycsrf.CsrfPolicy{
+				FilterEnabled: percentage(0),
+				ShadowEnabled: percentage(500000),
+				AdditionalOrigins: []*envoymatcher.StringMatcher{
+					{
+						MatchPattern: &envoymatcher.StringMatcher_Exact{Exact: "solo.io"},
+					},
+					{
+						MatchPattern: &envoymatcher.StringMatcher_SafeRegex{SafeRegex: regexutils.NewRegex(context.TODO(), ".*\\.solo\\.io")},
+					},
+				},
+			}))
+		})
+
+		It("rejects invalid percentages", func() {
+			err := NewPlugin().ProcessRoute(params, &v1.Route{
+				Options: &v1.RouteOptions{
+					Csrf: &csrf.CsrfPolicy{
+						ShadowEnabled: &types.FloatValue{Value: 101},
+					},
+				},
+			}, &envoyroute.Route{})
+			Expect(err).To(MatchError(InvalidPercentageErr("shadowEnabled", 101).Error()))
+		})
+	})
+})
@@ -0,0 +1,16 @@
+package dnssrv
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var T *testing.T
+
+func TestDnsSrv(t *testing.T) {
+	RegisterFailHandler(Fail)
+	T = t
+	RunSpecs(t, "DNS SRV Suite")
+}
@@ -0,0 +1,231 @@
+package dnssrv
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/go-utils/kubeutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+// the interval at which SRV records are resolved, unless the upstream sets its own
+const DefaultPollInterval = 5 * time.Second
+
+// TODO[eds enhancement] - update the EDS interface to include a registration function which would ensure uniqueness among prefixes
+const endpointNamePrefix = "dns-srv"
+
+type upstreamEndpoints struct {
+	upstream  core.ResourceRef
+	endpoints v1.EndpointList
+}
+
+// EDS API
+// start the EDS watch which sends a new list of endpoints on any change
+func (p *plugin) WatchEndpoints(writeNamespace string, unfilteredUpstreams v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
+	ctx := contextutils.WithLogger(opts.Ctx, "dns_srv_eds")
+	contextutils.LoggerFrom(ctx).Debugw("calling WatchEndpoints on DNS SRV")
+
+	var srvUpstreams v1.UpstreamList
+	for _, upstream := range unfilteredUpstreams {
+		if _, ok := upstream.GetUpstreamType().(*v1.Upstream_DnsSrv); ok {
+			srvUpstreams = append(srvUpstreams, upstream)
+		}
+	}
+
+	var wg sync.WaitGroup
+	endpointsChan := make(chan v1.EndpointList)
+	errChan := make(chan error)
+	updates := make(chan upstreamEndpoints)
+
+	// every upstream polls its record at its own interval
+	for _, upstream := range srvUpstreams {
+		upstream := upstream
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.pollUpstream(ctx, writeNamespace, upstream, updates, errChan)
+		}()
+	}
+
+	go func() {
+		defer close(endpointsChan)
+		defer close(errChan)
+		// Wait for the polling routines to shut down to avoid writing to closed channels
+		defer wg.Wait()
+
+		endpointsByUpstream := make(map[core.ResourceRef]v1.EndpointList)
+		publish := func() {
+			var endpoints v1.EndpointList
+			for _, upstreamEndpoints := range endpointsByUpstream {
+				endpoints = append(endpoints, upstreamEndpoints...)
+			}
+			sort.SliceStable(endpoints, func(i, j int) bool {
+				return endpoints[i].Metadata.Less(endpoints[j].Metadata)
+			})
+			select {
+			case endpointsChan <- endpoints:
+			case <-ctx.Done():
+			}
+		}
+
+		// discovery waits for every plugin to send its endpoints once before reporting ready
+		if len(srvUpstreams) == 0 {
+			publish()
+		}
+		for {
+			select {
+			case update := <-updates:
+				endpointsByUpstream[update.upstream] = update.endpoints
+				// wait for the first resolution of every record
+				if len(endpointsByUpstream) == len(srvUpstreams) {
+					publish()
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return endpointsChan, errChan, nil
+}
+
+// resolves the record of the upstream on every poll interval, and sends its endpoints whenever they change.
+// if the record cannot be resolved, the upstream keeps the endpoints of the previous resolution, as the DNS failure
+// might be temporary.
+func (p *plugin) pollUpstream(ctx context.Context, writeNamespace string, upstream *v1.Upstream, updates chan<- upstreamEndpoints, errChan chan<- error) {
+	spec := upstream.GetDnsSrv()
+	resolver := p.newResolver(spec.GetResolverAddress())
+	pollInterval := DefaultPollInterval
+	if spec.GetPollInterval() != nil && *spec.GetPollInterval() > 0 {
+		pollInterval = *spec.GetPollInterval()
+	}
+	ref := upstream.Metadata.Ref()
+
+	var (
+		previousEndpoints v1.EndpointList
+		previousHash      uint64
+		sent              bool
+	)
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		endpoints, err := buildEndpoints(ctx, writeNamespace, resolver, spec.GetRecord(), ref)
+		if err != nil {
+			select {
+			case errChan <- err:
+			case <-ctx.Done():
+				return
+			}
+			endpoints = previousEndpoints
+		}
+
+		if currentHash := hashutils.MustHash(endpoints); !sent || currentHash != previousHash {
+			select {
+			case updates <- upstreamEndpoints{upstream: ref, endpoints: endpoints}:
+			case <-ctx.Done():
+				return
+			}
+			previousEndpoints = endpoints
+			previousHash = currentHash
+			sent = true
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// builds an endpoint for each address of the targets of the record that have the lowest priority. per RFC 2782,
+// clients must attempt to contact the targets with the lowest priority they can reach, so the others are only
+// meant as a fallback.
+func buildEndpoints(ctx context.Context, writeNamespace string, resolver Resolver, record string, upstream core.ResourceRef) (v1.EndpointList, error) {
+	srvs, err := resolver.LookupSRV(ctx, record)
+	if err != nil {
+		return nil, SrvLookupErr(record, err)
+	}
+
+	var targets []*net.SRV
+	for _, srv := range srvs {
+		if len(targets) == 0 || srv.Priority < targets[0].Priority {
+			targets = []*net.SRV{srv}
+		} else if srv.Priority == targets[0].Priority {
+			targets = append(targets, srv)
+		}
+	}
+
+	var endpoints v1.EndpointList
+	names := make(map[string]bool)
+	for _, target := range targets {
+		host := strings.TrimSuffix(target.Target, ".")
+
+		var hostname string
+		ipAddresses := []string{host}
+		if net.ParseIP(host) == nil {
+			ipAddrs, err := resolver.LookupIPAddr(ctx, host)
+			if err != nil {
+				return nil, TargetLookupErr(host, record, err)
+			}
+			hostname = host
+			ipAddresses = nil
+			for _, ipAddr := range ipAddrs {
+				ipAddresses = append(ipAddresses, ipAddr.IP.String())
+			}
+		}
+
+		for _, ipAddress := range ipAddresses {
+			endpoint := buildEndpoint(writeNamespace, upstream, ipAddress, hostname, target)
+			// several targets of the record may resolve to the same address
+			if names[endpoint.Metadata.Name] {
+				continue
+			}
+			names[endpoint.Metadata.Name] = true
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints, nil
+}
+
+func buildEndpoint(writeNamespace string, upstream core.ResourceRef, ipAddress, hostname string, target *net.SRV) *v1.Endpoint {
+	var healthCheckConfig *v1.HealthCheckConfig
+	if hostname != "" {
+		healthCheckConfig = &v1.HealthCheckConfig{
+			Hostname: hostname,
+		}
+	}
+
+	// targets with a weight of zero get the default weight of envoy
+	var weight *types.UInt32Value
+	if target.Weight > 0 {
+		weight = &types.UInt32Value{Value: uint32(target.Weight)}
+	}
+
+	return &v1.Endpoint{
+		Metadata: core.Metadata{
+			Namespace: writeNamespace,
+			Name:      generateName(upstream, ipAddress, target.Port),
+		},
+		Upstreams:           []*core.ResourceRef{&upstream},
+		Address:             ipAddress,
+		Port:                uint32(target.Port),
+		Hostname:            hostname,
+		HealthCheck:         healthCheckConfig,
+		LoadBalancingWeight: weight,
+	}
+}
+
+func generateName(upstreamRef core.ResourceRef, ipAddress string, port uint16) string {
+	return kubeutils.SanitizeNameV2(fmt.Sprintf("%v-%v-%v-%v", endpointNamePrefix, upstreamRef.String(), ipAddress, port))
+}
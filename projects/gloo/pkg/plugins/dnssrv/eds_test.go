@@ -0,0 +1,188 @@
+package dnssrv
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
+	mock_dnssrv "github.com/solo-io/gloo/projects/gloo/pkg/plugins/dnssrv/mocks"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("DNS SRV EDS", func() {
+
+	const (
+		writeNamespace = "gloo-system"
+		record         = "_http._tcp.my-svc.service.nomad"
+	)
+
+	var (
+		ctrl     *gomock.Controller
+		resolver *mock_dnssrv.MockResolver
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(T)
+		resolver = mock_dnssrv.NewMockResolver(ctrl)
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "my-svc", Namespace: writeNamespace},
+			UpstreamType: &v1.Upstream_DnsSrv{
+				DnsSrv: &dns_srv.UpstreamSpec{
+					Record:          record,
+					ResolverAddress: "127.0.0.1:8600",
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("building endpoints", func() {
+
+		It("builds an endpoint for each address of the targets of the record", func() {
+			resolver.EXPECT().LookupSRV(gomock.Any(), record).Return([]*net.SRV{
+				{Target: "10.0.0.1", Port: 8080, Priority: 1, Weight: 10},
+				{Target: "node-2.nomad.", Port: 9090, Priority: 1},
+			}, nil)
+			resolver.EXPECT().LookupIPAddr(gomock.Any(), "node-2.nomad").Return([]net.IPAddr{
+				{IP: net.IPv4(10, 0, 0, 2)},
+				{IP: net.IPv4(10, 0, 0, 3)},
+			}, nil)
+
+			endpoints, err := buildEndpoints(context.TODO(), writeNamespace, resolver, record, upstream.Metadata.Ref())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(3))
+
+			Expect(endpoints[0].Address).To(Equal("10.0.0.1"))
+			Expect(endpoints[0].Port).To(BeEquivalentTo(8080))
+			Expect(endpoints[0].Hostname).To(BeEmpty())
+			Expect(endpoints[0].LoadBalancingWeight).To(Equal(&types.UInt32Value{Value: 10}))
+			Expect(endpoints[0].Metadata.Namespace).To(Equal(writeNamespace))
+			Expect(endpoints[0].Upstreams).To(ConsistOf(&core.ResourceRef{Name: "my-svc", Namespace: writeNamespace}))
+
+			Expect(endpoints[1].Address).To(Equal("10.0.0.2"))
+			Expect(endpoints[1].Port).To(BeEquivalentTo(9090))
+			Expect(endpoints[1].Hostname).To(Equal("node-2.nomad"))
+			Expect(endpoints[1].HealthCheck).To(Equal(&v1.HealthCheckConfig{Hostname: "node-2.nomad"}))
+			Expect(endpoints[1].LoadBalancingWeight).To(BeNil())
+			Expect(endpoints[2].Address).To(Equal("10.0.0.3"))
+		})
+
+		It("only uses the targets with the lowest priority", func() {
+			resolver.EXPECT().LookupSRV(gomock.Any(), record).Return([]*net.SRV{
+				{Target: "10.0.0.1", Port: 8080, Priority: 2},
+				{Target: "10.0.0.2", Port: 8080, Priority: 1},
+				{Target: "10.0.0.3", Port: 8080, Priority: 3},
+			}, nil)
+
+			endpoints, err := buildEndpoints(context.TODO(), writeNamespace, resolver, record, upstream.Metadata.Ref())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Address).To(Equal("10.0.0.2"))
+		})
+
+		It("does not duplicate the endpoints of targets that resolve to the same address", func() {
+			resolver.EXPECT().LookupSRV(gomock.Any(), record).Return([]*net.SRV{
+				{Target: "10.0.0.1", Port: 8080},
+				{Target: "node-1.nomad.", Port: 8080},
+			}, nil)
+			resolver.EXPECT().LookupIPAddr(gomock.Any(), "node-1.nomad").Return([]net.IPAddr{{IP: net.IPv4(10, 0, 0, 1)}}, nil)
+
+			endpoints, err := buildEndpoints(context.TODO(), writeNamespace, resolver, record, upstream.Metadata.Ref())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints).To(HaveLen(1))
+		})
+
+		It("fails if a target cannot be resolved", func() {
+			resolver.EXPECT().LookupSRV(gomock.Any(), record).Return([]*net.SRV{
+				{Target: "node-1.nomad.", Port: 8080},
+			}, nil)
+			resolver.EXPECT().LookupIPAddr(gomock.Any(), "node-1.nomad").Return(nil, eris.New("no such host"))
+
+			_, err := buildEndpoints(context.TODO(), writeNamespace, resolver, record, upstream.Metadata.Ref())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(TargetLookupErr("node-1.nomad", record, eris.New("no such host")).Error()))
+		})
+	})
+
+	Describe("watching endpoints", func() {
+
+		var (
+			ctx    context.Context
+			cancel context.CancelFunc
+			plug   *plugin
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			pollInterval := 10 * time.Millisecond
+			upstream.GetDnsSrv().PollInterval = &pollInterval
+			plug = NewPlugin(func(dnsAddress string) Resolver {
+				return resolver
+			})
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		It("sends the endpoints whenever the record changes", func() {
+			gomock.InOrder(
+				resolver.EXPECT().LookupSRV(gomock.Any(), record).Return([]*net.SRV{{Target: "10.0.0.1", Port: 8080}}, nil).Times(2),
+				resolver.EXPECT().LookupSRV(gomock.Any(), record).Return([]*net.SRV{{Target: "10.0.0.2", Port: 8080}}, nil).AnyTimes(),
+			)
+
+			endpointsChan, _, err := plug.WatchEndpoints(writeNamespace, v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			var endpoints v1.EndpointList
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Address).To(Equal("10.0.0.1"))
+
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(1))
+			Expect(endpoints[0].Address).To(Equal("10.0.0.2"))
+
+			// the record does not change anymore
+			Consistently(endpointsChan, 100*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("keeps the endpoints of the previous resolution on failure", func() {
+			gomock.InOrder(
+				resolver.EXPECT().LookupSRV(gomock.Any(), record).Return([]*net.SRV{{Target: "10.0.0.1", Port: 8080}}, nil).Times(1),
+				resolver.EXPECT().LookupSRV(gomock.Any(), record).Return(nil, eris.New("timeout")).AnyTimes(),
+			)
+
+			endpointsChan, errChan, err := plug.WatchEndpoints(writeNamespace, v1.UpstreamList{upstream}, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			var endpoints v1.EndpointList
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpoints).To(HaveLen(1))
+
+			Eventually(errChan).Should(Receive())
+			Consistently(endpointsChan, 100*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("sends an empty list when there are no DNS SRV upstreams", func() {
+			endpointsChan, _, err := plug.WatchEndpoints(writeNamespace, v1.UpstreamList{{Metadata: core.Metadata{Name: "static"}}}, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+
+			var endpoints v1.EndpointList
+			Eventually(endpointsChan).Should(Receive(&endpoints))
+			Expect(endpoints).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,85 @@
+package dnssrv
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+)
+
+/*
+Steps:
+- User creates a DNS SRV upstream
+  - names the SRV record that publishes the instances of the service
+- EDS polls the record, and resolves the addresses of its targets
+- Gloo plugin creates an endpoint for each address
+*/
+
+type plugin struct {
+	newResolver ResolverFactory
+}
+
+// checks to ensure interfaces are implemented
+var _ plugins.Plugin = new(plugin)
+var _ plugins.UpstreamPlugin = new(plugin)
+var _ discovery.DiscoveryPlugin = new(plugin)
+
+func NewPlugin(newResolver ResolverFactory) *plugin {
+	return &plugin{newResolver: newResolver}
+}
+
+func (p *plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *plugin) Resolve(u *v1.Upstream) (*url.URL, error) {
+	srvSpec, ok := u.UpstreamType.(*v1.Upstream_DnsSrv)
+	if !ok {
+		return nil, nil
+	}
+
+	endpoints, err := buildEndpoints(context.TODO(), "", p.newResolver(srvSpec.DnsSrv.GetResolverAddress()), srvSpec.DnsSrv.GetRecord(), u.Metadata.Ref())
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 0 {
+		return nil, NoTargetsErr(srvSpec.DnsSrv.GetRecord())
+	}
+
+	scheme := "http"
+	if u.SslConfig != nil {
+		scheme = "https"
+	}
+	return url.Parse(fmt.Sprintf("%v://%v:%v", scheme, endpoints[0].Address, endpoints[0].Port))
+}
+
+func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	_, ok := in.UpstreamType.(*v1.Upstream_DnsSrv)
+	if !ok {
+		return nil
+	}
+
+	// configure the cluster to use EDS:ADS and call it a day
+	xds.SetEdsOnCluster(out)
+	return nil
+}
+
+var (
+	SrvLookupErr = func(record string, err error) error {
+		return eris.Wrapf(err, "failed to look up SRV record %v", record)
+	}
+
+	TargetLookupErr = func(target, record string, err error) error {
+		return eris.Wrapf(err, "failed to resolve the address of target %v of SRV record %v", target, record)
+	}
+
+	NoTargetsErr = func(record string) error {
+		return eris.Errorf("SRV record %v has no targets", record)
+	}
+)
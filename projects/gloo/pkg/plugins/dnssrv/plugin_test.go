@@ -0,0 +1,71 @@
+package dnssrv
+
+import (
+	"net"
+	"net/url"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dns_srv"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	mock_dnssrv "github.com/solo-io/gloo/projects/gloo/pkg/plugins/dnssrv/mocks"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		ctrl     *gomock.Controller
+		resolver *mock_dnssrv.MockResolver
+		plug     *plugin
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(T)
+		resolver = mock_dnssrv.NewMockResolver(ctrl)
+		plug = NewPlugin(func(dnsAddress string) Resolver {
+			return resolver
+		})
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "my-svc", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_DnsSrv{
+				DnsSrv: &dns_srv.UpstreamSpec{
+					Record: "_http._tcp.my-svc.service.nomad",
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("configures the cluster to use EDS", func() {
+		out := &envoyapi.Cluster{}
+		err := plug.ProcessUpstream(plugins.Params{}, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetType()).To(Equal(envoyapi.Cluster_EDS))
+		Expect(out.EdsClusterConfig).NotTo(BeNil())
+	})
+
+	It("ignores other upstreams", func() {
+		out := &envoyapi.Cluster{}
+		err := plug.ProcessUpstream(plugins.Params{}, &v1.Upstream{}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.EdsClusterConfig).To(BeNil())
+	})
+
+	It("resolves the upstream to the first target of its record", func() {
+		resolver.EXPECT().LookupSRV(gomock.Any(), "_http._tcp.my-svc.service.nomad").Return([]*net.SRV{
+			{Target: "10.0.0.1", Port: 8080},
+		}, nil)
+
+		u, err := plug.Resolve(upstream)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u).To(Equal(&url.URL{Scheme: "http", Host: "10.0.0.1:8080"}))
+	})
+})
@@ -0,0 +1,52 @@
+package dnssrv
+
+import (
+	"context"
+	"net"
+)
+
+//go:generate mockgen -destination ./mocks/resolver_mock.go github.com/solo-io/gloo/projects/gloo/pkg/plugins/dnssrv Resolver
+//go:generate gofmt -w ./mocks/
+//go:generate goimports -w ./mocks/
+
+// Resolves SRV records, and the addresses of their targets
+type Resolver interface {
+	LookupSRV(ctx context.Context, record string) ([]*net.SRV, error)
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// Creates the resolver that queries the DNS server at the given address
+type ResolverFactory func(dnsAddress string) Resolver
+
+type dnsResolver struct {
+	resolver *net.Resolver
+}
+
+// Returns a resolver that queries the DNS server at the given address, or the resolver configured on the host if
+// the address is empty
+func NewResolver(dnsAddress string) Resolver {
+	if dnsAddress == "" {
+		return &dnsResolver{resolver: net.DefaultResolver}
+	}
+	return &dnsResolver{
+		resolver: &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+				// DNS typically uses UDP and falls back to TCP if the response size is greater than one packet
+				// (originally 512 bytes). we use TCP to ensure we receive all records in a large DNS response
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "tcp", dnsAddress)
+			},
+		},
+	}
+}
+
+func (r *dnsResolver) LookupSRV(ctx context.Context, record string) ([]*net.SRV, error) {
+	// with an empty service and protocol, the record name is looked up directly
+	_, srvs, err := r.resolver.LookupSRV(ctx, "", "", record)
+	return srvs, err
+}
+
+func (r *dnsResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	return r.resolver.LookupIPAddr(ctx, host)
+}
@@ -81,7 +81,10 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		return nil
 	}
 	grpcSpec := grpcWrapper.Grpc
-	out.Http2ProtocolOptions = &envoycore.Http2ProtocolOptions{}
+	// keep the options of the upstream's protocol options, if set
+	if out.Http2ProtocolOptions == nil {
+		out.Http2ProtocolOptions = &envoycore.Http2ProtocolOptions{}
+	}
 
 	if grpcSpec == nil || len(grpcSpec.GrpcServices) == 0 {
 		// no services, this just marks the upstream as a grpc one.
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/controller"
@@ -22,6 +23,8 @@ import (
 
 type KubePluginSharedFactory interface {
 	EndpointsLister(ns string) kubelisters.EndpointsLister
+	// returns nil if nodes are not watched
+	NodeLister() kubelisters.NodeLister
 	Subscribe() <-chan struct{}
 	Unsubscribe(<-chan struct{})
 }
@@ -30,23 +33,24 @@ type KubePluginListers struct {
 	initError error
 
 	endpointsLister map[string]kubelisters.EndpointsLister
+	nodeLister      kubelisters.NodeLister
 
 	cacheUpdatedWatchers      []chan struct{}
 	cacheUpdatedWatchersMutex sync.Mutex
 }
 
-func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func getInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, watchNodes bool) *KubePluginListers {
 	if len(watchNamespaces) == 0 {
 		watchNamespaces = []string{metav1.NamespaceAll}
 	}
-	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces)
+	kubePluginSharedFactory := startInformerFactory(ctx, client, watchNamespaces, watchNodes)
 	if kubePluginSharedFactory.initError != nil {
 		panic(kubePluginSharedFactory.initError)
 	}
 	return kubePluginSharedFactory
 }
 
-func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string) *KubePluginListers {
+func startInformerFactory(ctx context.Context, client kubernetes.Interface, watchNamespaces []string, watchNodes bool) *KubePluginListers {
 	resyncDuration := 12 * time.Hour
 
 	var informers []cache.SharedIndexInformer
@@ -59,6 +63,13 @@ func startInformerFactory(ctx context.Context, client kubernetes.Interface, watc
 		informers = append(informers, endpointInformer.Informer())
 		k.endpointsLister[nsToWatch] = endpointInformer.Lister()
 	}
+	if watchNodes && canListNodes(ctx, client) {
+		// nodes are cluster scoped
+		kubeInformerFactory := kubeinformers.NewSharedInformerFactory(client, resyncDuration)
+		nodeInformer := kubeInformerFactory.Core().V1().Nodes()
+		informers = append(informers, nodeInformer.Informer())
+		k.nodeLister = nodeInformer.Lister()
+	}
 
 	kubeController := controller.NewController("kube-plugin-controller",
 		controller.NewLockingSyncHandler(k.updatedOccured),
@@ -79,16 +90,31 @@ func startInformerFactory(ctx context.Context, client kubernetes.Interface, watc
 	ok := cache.WaitForCacheSync(stop, syncFuncs...)
 	if !ok && ctx.Err() == nil {
 		// if initError is non-nil, the kube resource client will panic
-		k.initError = errors.Errorf("waiting for kube pod, endpoints, services cache sync failed")
+		k.initError = errors.Errorf("waiting for kube pod, endpoints, services, nodes cache sync failed")
 	}
 
 	return k
 }
 
+// nodes are cluster scoped, so they cannot be listed with namespaced RBAC. the informer would then never sync, so the
+// endpoints are discovered without the locality of their nodes instead.
+func canListNodes(ctx context.Context, client kubernetes.Interface) bool {
+	if _, err := client.CoreV1().Nodes().List(metav1.ListOptions{Limit: 1}); err != nil {
+		contextutils.LoggerFrom(ctx).Warnw("cannot list nodes, the locality of kubernetes endpoints will not be "+
+			"populated from node labels; this requires a ClusterRole that allows to list and watch nodes", "error", err)
+		return false
+	}
+	return true
+}
+
 func (k *KubePluginListers) EndpointsLister(ns string) kubelisters.EndpointsLister {
 	return k.endpointsLister[ns]
 }
 
+func (k *KubePluginListers) NodeLister() kubelisters.NodeLister {
+	return k.nodeLister
+}
+
 func (k *KubePluginListers) Subscribe() <-chan struct{} {
 	k.cacheUpdatedWatchersMutex.Lock()
 	defer k.cacheUpdatedWatchersMutex.Unlock()
@@ -0,0 +1,50 @@
+package kubernetes
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	kubev1 "k8s.io/api/core/v1"
+	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("Informer factory", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		client *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		client = fake.NewSimpleClientset(&kubev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}})
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("watches nodes when they can be listed", func() {
+		listers := startInformerFactory(ctx, client, []string{metav1.NamespaceAll}, true)
+		Expect(listers.initError).NotTo(HaveOccurred())
+		Expect(listers.NodeLister()).NotTo(BeNil())
+		Expect(listers.NodeLister().Get("node-1")).NotTo(BeNil())
+	})
+
+	It("does not watch nodes when they cannot be listed", func() {
+		client.PrependReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, kubeerrors.NewForbidden(kubev1.Resource("nodes"), "", nil)
+		})
+
+		listers := startInformerFactory(ctx, client, []string{metav1.NamespaceAll}, true)
+		Expect(listers.initError).NotTo(HaveOccurred())
+		Expect(listers.NodeLister()).To(BeNil())
+		Expect(listers.EndpointsLister(metav1.NamespaceAll)).NotTo(BeNil())
+	})
+})
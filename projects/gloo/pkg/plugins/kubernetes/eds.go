@@ -18,12 +18,17 @@ import (
 	kubev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	kubelisters "k8s.io/client-go/listers/core/v1"
 )
 
+// the node label read to populate the subzone of an endpoint's locality, as kubernetes has no well known label for it.
+const SubZoneLabel = "topology.gloo.solo.io/subzone"
+
 func (p *plugin) WatchEndpoints(writeNamespace string, upstreamsToTrack v1.UpstreamList, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
 
+	watchNodes := settingsutil.FromContext(opts.Ctx).GetKubernetes().GetEndpointLocalityFromNodeLabels()
 	kubeFactory := func(namespaces []string) KubePluginSharedFactory {
-		return getInformerFactory(opts.Ctx, p.kube, namespaces)
+		return getInformerFactory(opts.Ctx, p.kube, namespaces, watchNodes)
 	}
 	watcher, err := newEndpointWatcherForUpstreams(kubeFactory, p.kubeCoreCache, writeNamespace, upstreamsToTrack, opts)
 	if err != nil {
@@ -60,7 +65,9 @@ func newEndpointWatcherForUpstreams(kubeFactoryFactory func(ns []string) KubePlu
 	}
 	opts = opts.WithDefaults()
 
-	return newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack), nil
+	watcher := newEndpointsWatcher(kubeCoreCache, namespaces, kubeFactory, upstreamsToTrack)
+	watcher.nodeLocality = settings.GetKubernetes().GetEndpointLocalityFromNodeLabels()
+	return watcher, nil
 }
 
 type edsWatcher struct {
@@ -68,6 +75,8 @@ type edsWatcher struct {
 	kubeShareFactory KubePluginSharedFactory
 	kubeCoreCache    corecache.KubeCoreCache
 	namespaces       []string
+	// populate the locality of the endpoints from the labels of their nodes
+	nodeLocality bool
 }
 
 func newEndpointsWatcher(kubeCoreCache corecache.KubeCoreCache, namespaces []string, kubeShareFactory KubePluginSharedFactory, upstreams v1.UpstreamList) *edsWatcher {
@@ -92,6 +101,7 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 	var endpointList []*kubev1.Endpoints
 	var serviceList []*kubev1.Service
 	var podList []*kubev1.Pod
+	var nodeList []*kubev1.Node
 	ctx := contextutils.WithLogger(opts.Ctx, "kubernetes_eds")
 	logger := contextutils.LoggerFrom(ctx)
 
@@ -118,7 +128,21 @@ func (c *edsWatcher) List(writeNamespace string, opts clients.ListOpts) (v1.Endp
 		}
 		endpointList = append(endpointList, endpoints...)
 	}
-	return filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, c.upstreams), nil
+	if nodeLister := c.nodeLister(); nodeLister != nil {
+		nodes, err := nodeLister.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		nodeList = nodes
+	}
+	return filterEndpoints(ctx, writeNamespace, endpointList, serviceList, podList, nodeList, c.upstreams), nil
+}
+
+func (c *edsWatcher) nodeLister() kubelisters.NodeLister {
+	if !c.nodeLocality {
+		return nil
+	}
+	return c.kubeShareFactory.NodeLister()
 }
 
 func (c *edsWatcher) watch(writeNamespace string, opts clients.WatchOpts) (<-chan v1.EndpointList, <-chan error, error) {
@@ -165,7 +189,7 @@ func (c *edsWatcher) watch(writeNamespace string, opts clients.WatchOpts) (<-cha
 }
 
 func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints []*kubev1.Endpoints,
-	services []*kubev1.Service, pods []*kubev1.Pod, upstreams map[core.ResourceRef]*kubeplugin.UpstreamSpec) v1.EndpointList {
+	services []*kubev1.Service, pods []*kubev1.Pod, nodes []*kubev1.Node, upstreams map[core.ResourceRef]*kubeplugin.UpstreamSpec) v1.EndpointList {
 	var endpoints v1.EndpointList
 
 	logger := contextutils.LoggerFrom(ctx)
@@ -178,6 +202,8 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 		UpstreamRef  core.ResourceRef
 	}
 	endpointsMap := make(map[Epkey][]*core.ResourceRef)
+	// the node is not part of the key, so that the names of the endpoints do not change
+	nodeNames := make(map[Epkey]string)
 
 	// for each upstream
 	for usRef, spec := range upstreams {
@@ -256,6 +282,9 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 					key := Epkey{addr.IP, port, podName, podNamespace, usRef}
 					copyRef := usRef
 					endpointsMap[key] = append(endpointsMap[key], &copyRef)
+					if addr.NodeName != nil {
+						nodeNames[key] = *addr.NodeName
+					}
 				}
 			}
 		}
@@ -279,6 +308,11 @@ func filterEndpoints(ctx context.Context, writeNamespace string, kubeEndpoints [
 		endpointName := fmt.Sprintf("ep-%v-%v-%x", dnsname, addr.Port, hasher.Sum64())
 		pod, _ := getPodForIp(addr.Address, addr.PodName, addr.PodNamespace, pods)
 		ep := createEndpoint(writeNamespace, endpointName, refs, addr.Address, addr.Port, pod)
+		nodeName := nodeNames[addr]
+		if nodeName == "" && pod != nil {
+			nodeName = pod.Spec.NodeName
+		}
+		ep.Locality = localityFromNode(nodeName, nodes)
 		endpoints = append(endpoints, ep)
 	}
 
@@ -297,7 +331,6 @@ func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, addre
 		Upstreams: upstreams,
 		Address:   address,
 		Port:      port,
-		// TODO: add locality info
 	}
 
 	if pod != nil {
@@ -306,6 +339,38 @@ func createEndpoint(namespace, name string, upstreams []*core.ResourceRef, addre
 	return ep
 }
 
+// returns the locality of the given node from its topology labels, or nil if the node is not found or has no
+// topology labels.
+func localityFromNode(nodeName string, nodes []*kubev1.Node) *v1.Locality {
+	if nodeName == "" {
+		return nil
+	}
+	for _, node := range nodes {
+		if node.Name != nodeName {
+			continue
+		}
+		locality := &v1.Locality{
+			Region:  firstLabel(node.Labels, kubev1.LabelZoneRegionStable, kubev1.LabelZoneRegion),
+			Zone:    firstLabel(node.Labels, kubev1.LabelZoneFailureDomainStable, kubev1.LabelZoneFailureDomain),
+			SubZone: node.Labels[SubZoneLabel],
+		}
+		if locality.Region == "" && locality.Zone == "" && locality.SubZone == "" {
+			return nil
+		}
+		return locality
+	}
+	return nil
+}
+
+func firstLabel(nodeLabels map[string]string, keys ...string) string {
+	for _, key := range keys {
+		if value := nodeLabels[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
 func getPodLabelsForIp(ip string, podName, podNamespace string, pods []*kubev1.Pod) (map[string]string, error) {
 	pod, err := getPodForIp(ip, podName, podNamespace, pods)
 	if err != nil {
@@ -6,10 +6,15 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
-	kubev1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	mock_kubernetes "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/mocks"
 	mock_cache "github.com/solo-io/gloo/test/mocks/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -38,7 +43,7 @@ var _ = Describe("Eds", func() {
 	It("should ignore upstreams in non watched namesapces", func() {
 		up := v1.NewUpstream("foo", "name")
 		up.UpstreamType = &v1.Upstream_Kube{
-			Kube: &kubev1.UpstreamSpec{
+			Kube: &kubeplugin.UpstreamSpec{
 				ServiceName:      "name",
 				ServiceNamespace: "bar",
 			},
@@ -54,4 +59,112 @@ var _ = Describe("Eds", func() {
 
 	})
 
+	Context("locality", func() {
+		var (
+			upstreams map[core.ResourceRef]*kubeplugin.UpstreamSpec
+			services  []*kubev1.Service
+			endpoints []*kubev1.Endpoints
+			pods      []*kubev1.Pod
+			nodes     []*kubev1.Node
+		)
+		BeforeEach(func() {
+			upstreams = map[core.ResourceRef]*kubeplugin.UpstreamSpec{
+				{Namespace: "foo", Name: "name"}: {
+					ServiceName:      "svc",
+					ServiceNamespace: "bar",
+					ServicePort:      80,
+				},
+			}
+			services = []*kubev1.Service{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "svc"},
+				Spec: kubev1.ServiceSpec{
+					Ports: []kubev1.ServicePort{{Port: 80}},
+				},
+			}}
+			nodeName := "node-1"
+			endpoints = []*kubev1.Endpoints{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "svc"},
+				Subsets: []kubev1.EndpointSubset{{
+					Addresses: []kubev1.EndpointAddress{{
+						IP:        "1.2.3.4",
+						NodeName:  &nodeName,
+						TargetRef: &kubev1.ObjectReference{Kind: "Pod", Name: "pod-1", Namespace: "bar"},
+					}, {
+						IP:        "1.2.3.5",
+						TargetRef: &kubev1.ObjectReference{Kind: "Pod", Name: "pod-2", Namespace: "bar"},
+					}},
+					Ports: []kubev1.EndpointPort{{Port: 8080}},
+				}},
+			}}
+			pods = []*kubev1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "pod-1"},
+				Spec:       kubev1.PodSpec{NodeName: "node-1"},
+			}, {
+				ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "pod-2"},
+				Spec:       kubev1.PodSpec{NodeName: "node-2"},
+			}}
+			nodes = []*kubev1.Node{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-1",
+					Labels: map[string]string{
+						kubev1.LabelZoneRegionStable:        "us-east-1",
+						kubev1.LabelZoneFailureDomainStable: "us-east-1a",
+						SubZoneLabel:                        "rack-1",
+					},
+				},
+			}, {
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node-2",
+					Labels: map[string]string{
+						kubev1.LabelZoneRegion:        "us-east-1",
+						kubev1.LabelZoneFailureDomain: "us-east-1b",
+					},
+				},
+			}}
+		})
+
+		It("should populate the locality from the labels of the nodes", func() {
+			eps := filterEndpoints(ctx, "foo", endpoints, services, pods, nodes, upstreams)
+			Expect(eps).To(HaveLen(2))
+			localities := map[string]*v1.Locality{}
+			for _, ep := range eps {
+				localities[ep.Address] = ep.Locality
+			}
+			Expect(localities["1.2.3.4"]).To(Equal(&v1.Locality{Region: "us-east-1", Zone: "us-east-1a", SubZone: "rack-1"}))
+			// the node of this address is found through its pod
+			Expect(localities["1.2.3.5"]).To(Equal(&v1.Locality{Region: "us-east-1", Zone: "us-east-1b"}))
+		})
+
+		It("should not populate the locality without nodes", func() {
+			eps := filterEndpoints(ctx, "foo", endpoints, services, pods, nil, upstreams)
+			Expect(eps).To(HaveLen(2))
+			for _, ep := range eps {
+				Expect(ep.Locality).To(BeNil())
+			}
+		})
+
+		It("should list nodes when enabled in the settings", func() {
+			ctx = settingsutil.WithSettings(ctx, &v1.Settings{
+				WatchNamespaces: []string{"bar"},
+				Kubernetes: &v1.Settings_KubernetesConfiguration{
+					EndpointLocalityFromNodeLabels: true,
+				},
+			})
+			up := v1.NewUpstream("foo", "name")
+			up.UpstreamType = &v1.Upstream_Kube{Kube: upstreams[core.ResourceRef{Namespace: "foo", Name: "name"}]}
+
+			mockCache.EXPECT().NamespacedServiceLister("bar").Return(nil)
+			nodeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, node := range nodes {
+				Expect(nodeIndexer.Add(node)).NotTo(HaveOccurred())
+			}
+			mockSharedFactory.EXPECT().NodeLister().Return(listers.NewNodeLister(nodeIndexer))
+
+			watcher, err := newEndpointWatcherForUpstreams(func([]string) KubePluginSharedFactory { return mockSharedFactory }, mockCache, "foo", v1.UpstreamList{up}, clients.WatchOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = watcher.List("foo", clients.ListOpts{Ctx: ctx})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
 })
@@ -48,6 +48,20 @@ func (mr *MockKubePluginSharedFactoryMockRecorder) EndpointsLister(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndpointsLister", reflect.TypeOf((*MockKubePluginSharedFactory)(nil).EndpointsLister), arg0)
 }
 
+// NodeLister mocks base method
+func (m *MockKubePluginSharedFactory) NodeLister() v1.NodeLister {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeLister")
+	ret0, _ := ret[0].(v1.NodeLister)
+	return ret0
+}
+
+// NodeLister indicates an expected call of NodeLister
+func (mr *MockKubePluginSharedFactoryMockRecorder) NodeLister() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeLister", reflect.TypeOf((*MockKubePluginSharedFactory)(nil).NodeLister))
+}
+
 // Subscribe mocks base method
 func (m *MockKubePluginSharedFactory) Subscribe() <-chan struct{} {
 	m.ctrl.T.Helper()
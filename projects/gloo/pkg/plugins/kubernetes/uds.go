@@ -90,6 +90,15 @@ func (p *plugin) ConvertServices(ctx context.Context, watchNamespaces []string,
 			u.Metadata.Namespace = writeNamespace
 		}
 
+		if useReadinessProbeHealthChecks(svc) && len(svc.Spec.Selector) > 0 && p.kubeCoreCache.NamespacedPodLister(svc.Namespace) != nil {
+			pods, err := p.kubeCoreCache.NamespacedPodLister(svc.Namespace).List(labels.SelectorFromSet(svc.Spec.Selector))
+			if err != nil {
+				contextutils.LoggerFrom(ctx).Errorf("failed to list the pods of service %v.%v: %v", svc.Namespace, svc.Name, err)
+			} else {
+				setReadinessProbeHealthChecks(svc, upstreamsToCreate, pods)
+			}
+		}
+
 		upstreams = append(upstreams, upstreamsToCreate...)
 	}
 	return upstreams
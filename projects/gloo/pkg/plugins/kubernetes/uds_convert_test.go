@@ -3,6 +3,10 @@ package kubernetes
 import (
 	"context"
 	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -151,4 +155,88 @@ var _ = Describe("UdsConvert", func() {
 			}, nil),
 		)
 	})
+
+	Context("readiness probe health checks", func() {
+		var (
+			svc *kubev1.Service
+			pod *kubev1.Pod
+		)
+
+		BeforeEach(func() {
+			svc = &kubev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Namespace:   "test",
+					Annotations: map[string]string{readinessProbeHealthCheckAnnotationKey: readinessProbeHealthCheckAnnotationTrue},
+				},
+				Spec: kubev1.ServiceSpec{
+					Selector: map[string]string{"app": "test"},
+					Ports: []kubev1.ServicePort{
+						{Port: 80, TargetPort: intstr.FromString("http")},
+						{Port: 9090},
+					},
+				},
+			}
+			pod = &kubev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-1",
+					Namespace: "test",
+					Labels:    map[string]string{"app": "test"},
+				},
+				Spec: kubev1.PodSpec{
+					Containers: []kubev1.Container{{
+						Ports: []kubev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+						ReadinessProbe: &kubev1.Probe{
+							Handler: kubev1.Handler{
+								HTTPGet: &kubev1.HTTPGetAction{
+									Path:        "/ready",
+									Port:        intstr.FromInt(8080),
+									HTTPHeaders: []kubev1.HTTPHeader{{Name: "Host", Value: "example.com"}},
+								},
+							},
+							TimeoutSeconds:   2,
+							PeriodSeconds:    5,
+							FailureThreshold: 4,
+							SuccessThreshold: 1,
+						},
+					}},
+				},
+			}
+		})
+
+		It("creates http health checks for the upstreams of the probed port", func() {
+			upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc)
+			setReadinessProbeHealthChecks(svc, upstreams, []*kubev1.Pod{pod})
+
+			timeout := 2 * time.Second
+			interval := 5 * time.Second
+			Expect(upstreams[0].HealthCheckSpecs).To(Equal([]*v1.HealthCheckSpec{{
+				Timeout:            &timeout,
+				Interval:           &interval,
+				UnhealthyThreshold: &types.UInt32Value{Value: 4},
+				HealthyThreshold:   &types.UInt32Value{Value: 1},
+				HealthChecker: &v1.HealthCheckSpec_Http{
+					Http: &v1.HealthCheckSpec_HttpHealthCheck{
+						Path: "/ready",
+						Host: "example.com",
+					},
+				},
+			}}))
+			Expect(upstreams[1].HealthCheckSpecs).To(BeNil())
+		})
+
+		It("ignores https probes", func() {
+			pod.Spec.Containers[0].ReadinessProbe.HTTPGet.Scheme = kubev1.URISchemeHTTPS
+			upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc)
+			setReadinessProbeHealthChecks(svc, upstreams, []*kubev1.Pod{pod})
+			Expect(upstreams[0].HealthCheckSpecs).To(BeNil())
+		})
+
+		It("ignores pods that are not selected by the service", func() {
+			pod.Labels = map[string]string{"app": "other"}
+			upstreams := DefaultUpstreamConverter().UpstreamsForService(context.TODO(), svc)
+			setReadinessProbeHealthChecks(svc, upstreams, []*kubev1.Pod{pod})
+			Expect(upstreams[0].HealthCheckSpecs).To(BeNil())
+		})
+	})
 })
@@ -0,0 +1,127 @@
+package kubernetes
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// set to "true" on a service to generate http health checks for its upstreams from the readiness probes of its pods
+	readinessProbeHealthCheckAnnotationKey  = "gloo.solo.io/readiness_probe_health_check"
+	readinessProbeHealthCheckAnnotationTrue = "true"
+)
+
+func useReadinessProbeHealthChecks(svc *kubev1.Service) bool {
+	return svc.Annotations[readinessProbeHealthCheckAnnotationKey] == readinessProbeHealthCheckAnnotationTrue
+}
+
+// sets health check specs on the upstreams of the service, based on the http readiness probes of the containers
+// serving the target port of each upstream. the pods of a service are expected to share a pod template, so the
+// probe of the first pod (by name) that has one is used.
+func setReadinessProbeHealthChecks(svc *kubev1.Service, upstreams v1.UpstreamList, pods []*kubev1.Pod) {
+	selector := labels.SelectorFromSet(svc.Spec.Selector)
+	var selectedPods []*kubev1.Pod
+	for _, pod := range pods {
+		if pod.Namespace == svc.Namespace && selector.Matches(labels.Set(pod.Labels)) {
+			selectedPods = append(selectedPods, pod)
+		}
+	}
+	sort.SliceStable(selectedPods, func(i, j int) bool {
+		return selectedPods[i].Name < selectedPods[j].Name
+	})
+
+	for _, us := range upstreams {
+		if us.HealthCheckSpecs != nil {
+			continue
+		}
+		for _, port := range svc.Spec.Ports {
+			if uint32(port.Port) != us.GetKube().GetServicePort() {
+				continue
+			}
+			for _, pod := range selectedPods {
+				if spec := readinessProbeHealthCheck(pod, port); spec != nil {
+					us.HealthCheckSpecs = []*v1.HealthCheckSpec{spec}
+					break
+				}
+			}
+		}
+	}
+}
+
+func readinessProbeHealthCheck(pod *kubev1.Pod, port kubev1.ServicePort) *v1.HealthCheckSpec {
+	targetPort := port.TargetPort
+	if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+		targetPort = intstr.FromInt(int(port.Port))
+	}
+	for _, container := range pod.Spec.Containers {
+		probe := container.ReadinessProbe
+		if probe == nil || probe.HTTPGet == nil || probe.HTTPGet.Scheme == kubev1.URISchemeHTTPS {
+			continue
+		}
+		servicePort, ok := containerPort(container, targetPort)
+		if !ok {
+			continue
+		}
+		probePort, ok := containerPort(container, probe.HTTPGet.Port)
+		if !ok || probePort != servicePort {
+			continue
+		}
+		return healthCheckFromProbe(probe)
+	}
+	return nil
+}
+
+func containerPort(container kubev1.Container, port intstr.IntOrString) (int32, bool) {
+	if port.Type == intstr.Int {
+		return port.IntVal, true
+	}
+	for _, containerPort := range container.Ports {
+		if containerPort.Name == port.StrVal {
+			return containerPort.ContainerPort, true
+		}
+	}
+	return 0, false
+}
+
+// fields that are not set on the probe keep the defaults of the gloo health check
+func healthCheckFromProbe(probe *kubev1.Probe) *v1.HealthCheckSpec {
+	path := probe.HTTPGet.Path
+	if path == "" {
+		path = "/"
+	}
+	httpHealthCheck := &v1.HealthCheckSpec_HttpHealthCheck{
+		Path: path,
+	}
+	for _, header := range probe.HTTPGet.HTTPHeaders {
+		if header.Name == "Host" {
+			httpHealthCheck.Host = header.Value
+		}
+	}
+
+	spec := &v1.HealthCheckSpec{
+		HealthChecker: &v1.HealthCheckSpec_Http{
+			Http: httpHealthCheck,
+		},
+	}
+	if probe.TimeoutSeconds > 0 {
+		timeout := time.Duration(probe.TimeoutSeconds) * time.Second
+		spec.Timeout = &timeout
+	}
+	if probe.PeriodSeconds > 0 {
+		interval := time.Duration(probe.PeriodSeconds) * time.Second
+		spec.Interval = &interval
+	}
+	if probe.FailureThreshold > 0 {
+		spec.UnhealthyThreshold = &types.UInt32Value{Value: uint32(probe.FailureThreshold)}
+	}
+	if probe.SuccessThreshold > 0 {
+		spec.HealthyThreshold = &types.UInt32Value{Value: uint32(probe.SuccessThreshold)}
+	}
+	return spec
+}
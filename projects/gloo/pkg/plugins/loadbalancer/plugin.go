@@ -85,7 +85,7 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		return nil
 	}
 
-	if cfg.HealthyPanicThreshold != nil || cfg.UpdateMergeWindow != nil {
+	if cfg.HealthyPanicThreshold != nil || cfg.UpdateMergeWindow != nil || cfg.LocalityConfig != nil {
 		out.CommonLbConfig = &envoyapi.Cluster_CommonLbConfig{}
 		if cfg.HealthyPanicThreshold != nil {
 			out.CommonLbConfig.HealthyPanicThreshold = &envoytype.Percent{
@@ -95,6 +95,7 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 		if cfg.UpdateMergeWindow != nil {
 			out.CommonLbConfig.UpdateMergeWindow = gogoutils.DurationStdToProto(cfg.UpdateMergeWindow)
 		}
+		setLocalityConfig(out.CommonLbConfig, cfg)
 	}
 
 	if cfg.Type != nil {
@@ -143,3 +144,24 @@ func setRingHashLbConfig(out *envoyapi.Cluster, userConfig *v1.LoadBalancerConfi
 	}
 	out.LbConfig = cfg
 }
+
+func setLocalityConfig(out *envoyapi.Cluster_CommonLbConfig, cfg *v1.LoadBalancerConfig) {
+	switch localityConfig := cfg.LocalityConfig.(type) {
+	case *v1.LoadBalancerConfig_ZoneAwareLbConfig_:
+		zoneAware := &envoyapi.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+			MinClusterSize: gogoutils.UInt64GogoToProto(localityConfig.ZoneAwareLbConfig.MinClusterSize),
+		}
+		if localityConfig.ZoneAwareLbConfig.RoutingEnabled != nil {
+			zoneAware.RoutingEnabled = &envoytype.Percent{
+				Value: localityConfig.ZoneAwareLbConfig.RoutingEnabled.Value,
+			}
+		}
+		out.LocalityConfigSpecifier = &envoyapi.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+			ZoneAwareLbConfig: zoneAware,
+		}
+	case *v1.LoadBalancerConfig_LocalityWeightedLbConfig_:
+		out.LocalityConfigSpecifier = &envoyapi.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
+			LocalityWeightedLbConfig: &envoyapi.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type"
 	"github.com/gogo/protobuf/types"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -201,6 +202,38 @@ status: {}
 		Expect(out.LbConfig).To(BeNil())
 	})
 
+	It("should set zone aware lb config", func() {
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			LocalityConfig: &v1.LoadBalancerConfig_ZoneAwareLbConfig_{
+				ZoneAwareLbConfig: &v1.LoadBalancerConfig_ZoneAwareLbConfig{
+					RoutingEnabled: &types.DoubleValue{Value: 80},
+					MinClusterSize: &types.UInt64Value{Value: 3},
+				},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.CommonLbConfig.LocalityConfigSpecifier).To(Equal(&envoyapi.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+			ZoneAwareLbConfig: &envoyapi.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+				RoutingEnabled: &envoytype.Percent{Value: 80},
+				MinClusterSize: &wrappers.UInt64Value{Value: 3},
+			},
+		}))
+	})
+
+	It("should set locality weighted lb config", func() {
+		upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+			LocalityConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig_{
+				LocalityWeightedLbConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig{},
+			},
+		}
+		err := plugin.ProcessUpstream(params, upstream, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.CommonLbConfig.LocalityConfigSpecifier).To(Equal(&envoyapi.Cluster_CommonLbConfig_LocalityWeightedLbConfig_{
+			LocalityWeightedLbConfig: &envoyapi.Cluster_CommonLbConfig_LocalityWeightedLbConfig{},
+		}))
+	})
+
 	Context("route plugin", func() {
 		var (
 			routeParams plugins.RouteParams
@@ -0,0 +1,151 @@
+package localratelimit
+
+import (
+	"time"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/types"
+	"github.com/rotisserie/eris"
+	envoylocalratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoytype "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	envoycore "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
+	envoy_type "github.com/solo-io/solo-kit/pkg/api/external/envoy/type"
+)
+
+const (
+	FilterName = "envoy.filters.http.local_ratelimit"
+	StatPrefix = "http_local_rate_limiter"
+
+	// envoy rejects token buckets that refill more often than this
+	MinFillInterval = 50 * time.Millisecond
+)
+
+// local rate limiting runs alongside the (external) rate limit filter, after auth
+var pluginStage = plugins.DuringStage(plugins.RateLimitStage)
+
+var (
+	MissingTokenBucketErr   = eris.New("local rate limit must specify a token bucket")
+	InvalidMaxTokensErr     = eris.New("local rate limit token bucket must specify max tokens greater than 0")
+	InvalidTokensPerFillErr = eris.New("local rate limit token bucket tokens per fill must be greater than 0")
+	InvalidFillIntervalErr  = func(fillInterval time.Duration) error {
+		return eris.Errorf("local rate limit token bucket fill interval must be at least %v, got %v", MinFillInterval, fillInterval)
+	}
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+
+type Plugin struct {
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	localRateLimit := in.GetOptions().GetLocalRatelimit()
+	if localRateLimit == nil {
+		return nil
+	}
+
+	config, err := translateLocalRateLimit(localRateLimit)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, config)
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	localRateLimit := in.GetOptions().GetLocalRatelimit()
+	if localRateLimit == nil {
+		return nil
+	}
+
+	config, err := translateLocalRateLimit(localRateLimit)
+	if err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, config)
+}
+
+// The listener-level filter carries no token bucket of its own; buckets are only configured
+// on the virtual hosts and routes that request them.
+func (p *Plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if !usesLocalRateLimit(listener) {
+		return nil, nil
+	}
+
+	filter, err := plugins.NewStagedFilterWithConfig(FilterName, &envoylocalratelimit.LocalRateLimit{
+		StatPrefix: StatPrefix,
+	}, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func usesLocalRateLimit(listener *v1.HttpListener) bool {
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		if virtualHost.GetOptions().GetLocalRatelimit() != nil {
+			return true
+		}
+		for _, route := range virtualHost.GetRoutes() {
+			if route.GetOptions().GetLocalRatelimit() != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func translateLocalRateLimit(in *local_ratelimit.LocalRateLimit) (*envoylocalratelimit.LocalRateLimit, error) {
+	tokenBucket := in.GetTokenBucket()
+	if tokenBucket == nil {
+		return nil, MissingTokenBucketErr
+	}
+	if tokenBucket.GetMaxTokens() == 0 {
+		return nil, InvalidMaxTokensErr
+	}
+	if tokenBucket.GetTokensPerFill() != nil && tokenBucket.GetTokensPerFill().GetValue() == 0 {
+		return nil, InvalidTokensPerFillErr
+	}
+	var fillInterval time.Duration
+	if tokenBucket.GetFillInterval() != nil {
+		fillInterval = *tokenBucket.GetFillInterval()
+	}
+	if fillInterval < MinFillInterval {
+		return nil, InvalidFillIntervalErr(fillInterval)
+	}
+
+	return &envoylocalratelimit.LocalRateLimit{
+		StatPrefix: StatPrefix,
+		TokenBucket: &envoytype.TokenBucket{
+			MaxTokens:     tokenBucket.GetMaxTokens(),
+			TokensPerFill: tokenBucket.GetTokensPerFill(),
+			FillInterval:  types.DurationProto(fillInterval),
+		},
+		// envoy defaults both of these to 0%, which would leave the limit disabled
+		FilterEnabled:  allRequests(),
+		FilterEnforced: allRequests(),
+	}, nil
+}
+
+func allRequests() *envoycore.RuntimeFractionalPercent {
+	return &envoycore.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoy_type.FractionalPercent_HUNDRED,
+		},
+	}
+}
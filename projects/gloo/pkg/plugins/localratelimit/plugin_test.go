@@ -0,0 +1,175 @@
+package localratelimit_test
+
+import (
+	"time"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/gogo/protobuf/types"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/utils/protoutils"
+	envoylocalratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/local_ratelimit/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/localratelimit"
+	envoy_type "github.com/solo-io/solo-kit/pkg/api/external/envoy/type"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p              *Plugin
+		fillInterval   time.Duration
+		localRateLimit *local_ratelimit.LocalRateLimit
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		Expect(p.Init(plugins.InitParams{})).NotTo(HaveOccurred())
+		fillInterval = time.Second
+		localRateLimit = &local_ratelimit.LocalRateLimit{
+			TokenBucket: &local_ratelimit.TokenBucket{
+				MaxTokens:     10,
+				TokensPerFill: &types.UInt32Value{Value: 5},
+				FillInterval:  &fillInterval,
+			},
+		}
+	})
+
+	perFilterConfig := func(config map[string]*structpb.Struct) *envoylocalratelimit.LocalRateLimit {
+		Expect(config).To(HaveKey(FilterName))
+		var cfg envoylocalratelimit.LocalRateLimit
+		err := protoutils.UnmarshalStruct(config[FilterName], &cfg)
+		Expect(err).NotTo(HaveOccurred())
+		return &cfg
+	}
+
+	expectTranslated := func(cfg *envoylocalratelimit.LocalRateLimit) {
+		Expect(cfg.GetStatPrefix()).To(Equal(StatPrefix))
+		Expect(cfg.GetTokenBucket().GetMaxTokens()).To(Equal(uint32(10)))
+		Expect(cfg.GetTokenBucket().GetTokensPerFill().GetValue()).To(Equal(uint32(5)))
+		Expect(cfg.GetTokenBucket().GetFillInterval()).To(Equal(types.DurationProto(time.Second)))
+		hundredPercent := &envoy_type.FractionalPercent{Numerator: 100, Denominator: envoy_type.FractionalPercent_HUNDRED}
+		Expect(cfg.GetFilterEnabled().GetDefaultValue()).To(Equal(hundredPercent))
+		Expect(cfg.GetFilterEnforced().GetDefaultValue()).To(Equal(hundredPercent))
+	}
+
+	Context("http filters", func() {
+
+		It("does not add the filter when no virtual host or route uses it", func() {
+			filters, err := p.HttpFilters(plugins.Params{}, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Name:   "vh",
+					Routes: []*v1.Route{{}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("adds the filter in the rate limit stage when a route uses it", func() {
+			filters, err := p.HttpFilters(plugins.Params{}, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Name: "vh",
+					Routes: []*v1.Route{{
+						Options: &v1.RouteOptions{LocalRatelimit: localRateLimit},
+					}},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+			Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.RateLimitStage)))
+
+			var cfg envoylocalratelimit.LocalRateLimit
+			err = protoutils.UnmarshalStruct(filters[0].HttpFilter.GetConfig(), &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg).To(Equal(envoylocalratelimit.LocalRateLimit{StatPrefix: StatPrefix}))
+		})
+
+		It("adds the filter when a virtual host uses it", func() {
+			filters, err := p.HttpFilters(plugins.Params{}, &v1.HttpListener{
+				VirtualHosts: []*v1.VirtualHost{{
+					Name:    "vh",
+					Options: &v1.VirtualHostOptions{LocalRatelimit: localRateLimit},
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+		})
+	})
+
+	Context("virtual hosts", func() {
+
+		It("sets the per filter config", func() {
+			out := &envoyroute.VirtualHost{}
+			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{LocalRatelimit: localRateLimit},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			expectTranslated(perFilterConfig(out.GetPerFilterConfig()))
+		})
+
+		It("does nothing when not configured", func() {
+			out := &envoyroute.VirtualHost{}
+			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetPerFilterConfig()).To(BeEmpty())
+		})
+	})
+
+	Context("routes", func() {
+
+		It("sets the per filter config", func() {
+			out := &envoyroute.Route{}
+			err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{LocalRatelimit: localRateLimit},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			expectTranslated(perFilterConfig(out.GetPerFilterConfig()))
+		})
+
+		It("defaults tokens per fill", func() {
+			localRateLimit.TokenBucket.TokensPerFill = nil
+			out := &envoyroute.Route{}
+			err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{LocalRatelimit: localRateLimit},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perFilterConfig(out.GetPerFilterConfig()).GetTokenBucket().GetTokensPerFill()).To(BeNil())
+		})
+
+		processRoute := func() error {
+			return p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{LocalRatelimit: localRateLimit},
+			}, &envoyroute.Route{})
+		}
+
+		It("errors without a token bucket", func() {
+			localRateLimit.TokenBucket = nil
+			Expect(processRoute()).To(MatchError(MissingTokenBucketErr))
+		})
+
+		It("errors without max tokens", func() {
+			localRateLimit.TokenBucket.MaxTokens = 0
+			Expect(processRoute()).To(MatchError(InvalidMaxTokensErr))
+		})
+
+		It("errors with zero tokens per fill", func() {
+			localRateLimit.TokenBucket.TokensPerFill = &types.UInt32Value{}
+			Expect(processRoute()).To(MatchError(InvalidTokensPerFillErr))
+		})
+
+		It("errors when the fill interval is missing", func() {
+			localRateLimit.TokenBucket.FillInterval = nil
+			Expect(processRoute()).To(MatchError(InvalidFillIntervalErr(0)))
+		})
+
+		It("errors when the fill interval is too short", func() {
+			fillInterval = 10 * time.Millisecond
+			Expect(processRoute()).To(MatchError(InvalidFillIntervalErr(fillInterval)))
+		})
+	})
+})
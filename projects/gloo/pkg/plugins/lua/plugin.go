@@ -0,0 +1,110 @@
+package lua
+
+import (
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/rotisserie/eris"
+	envoylua "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/lua/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+const (
+	FilterName = "envoy.filters.http.lua"
+
+	// envoy requires inline code on the filter; this is used when the listener only defines named scripts
+	NoopInlineCode = "function envoy_on_request(request_handle)\nend\n"
+)
+
+// scripts run once the request has been authenticated, authorized and rate limited
+var pluginStage = plugins.DuringStage(plugins.AcceptedStage)
+
+var (
+	MissingLuaConfigErr  = eris.New("a lua script was selected, but the listener does not configure lua")
+	EmptyLuaPerRouteErr  = eris.New("lua per route config must either set disabled to true or select a script by name")
+	UnknownSourceCodeErr = func(name string) error {
+		return eris.Errorf("lua script %v is not one of the source codes defined on the listener", name)
+	}
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+var _ plugins.VirtualHostPlugin = new(Plugin)
+var _ plugins.RoutePlugin = new(Plugin)
+
+type Plugin struct {
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	luaConfig := listener.GetOptions().GetLua()
+	if luaConfig == nil {
+		return nil, nil
+	}
+
+	if luaConfig.GetInlineCode() == "" {
+		luaConfig = &envoylua.Lua{
+			InlineCode:  NoopInlineCode,
+			SourceCodes: luaConfig.GetSourceCodes(),
+		}
+	}
+
+	luaFilter, err := plugins.NewStagedFilterWithConfig(FilterName, luaConfig, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{luaFilter}, nil
+}
+
+func (p *Plugin) ProcessVirtualHost(params plugins.VirtualHostParams, in *v1.VirtualHost, out *envoyroute.VirtualHost) error {
+	luaPerRoute := in.GetOptions().GetLuaPerRoute()
+	if luaPerRoute == nil {
+		return nil
+	}
+
+	if err := validateLuaPerRoute(params.Listener, luaPerRoute); err != nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, luaPerRoute)
+}
+
+func (p *Plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoyroute.Route) error {
+	luaPerRoute := in.GetOptions().GetLuaPerRoute()
+	if luaPerRoute == nil {
+		return nil
+	}
+
+	if err := validateLuaPerRoute(params.Listener, luaPerRoute); err != nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, luaPerRoute)
+}
+
+// disabling lua is always valid, but a selected script must exist on the listener's filter.
+// envoy requires the per route config to either disable lua or select a script.
+func validateLuaPerRoute(listener *v1.Listener, luaPerRoute *envoylua.LuaPerRoute) error {
+	if luaPerRoute.GetDisabled() {
+		return nil
+	}
+	name := luaPerRoute.GetName()
+	if name == "" {
+		return EmptyLuaPerRouteErr
+	}
+
+	luaConfig := listener.GetHttpListener().GetOptions().GetLua()
+	if luaConfig == nil {
+		return MissingLuaConfigErr
+	}
+	if _, ok := luaConfig.GetSourceCodes()[name]; !ok {
+		return UnknownSourceCodeErr(name)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package lua_test
+
+import (
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/utils/protoutils"
+	envoylua "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/lua/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/lua"
+	envoycore "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		luaConfig *envoylua.Lua
+		listener  *v1.Listener
+		params    plugins.RouteParams
+	)
+
+	BeforeEach(func() {
+		luaConfig = &envoylua.Lua{
+			InlineCode: "function envoy_on_request(request_handle) end",
+			SourceCodes: map[string]*envoycore.DataSource{
+				"hello.lua": {
+					Specifier: &envoycore.DataSource_InlineString{
+						InlineString: "function envoy_on_response(response_handle) end",
+					},
+				},
+			},
+		}
+		listener = &v1.Listener{
+			ListenerType: &v1.Listener_HttpListener{
+				HttpListener: &v1.HttpListener{
+					Options: &v1.HttpListenerOptions{
+						Lua: luaConfig,
+					},
+				},
+			},
+		}
+		params = plugins.RouteParams{
+			VirtualHostParams: plugins.VirtualHostParams{
+				Listener: listener,
+			},
+		}
+	})
+
+	Context("http filters", func() {
+
+		It("copies the lua config from the listener to the filter", func() {
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, listener.GetHttpListener())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+			Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.AcceptedStage)))
+
+			var cfg envoylua.Lua
+			err = protoutils.UnmarshalStruct(filters[0].HttpFilter.GetConfig(), &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(&cfg).To(Equal(luaConfig))
+		})
+
+		It("uses a no-op script when the listener only defines named scripts", func() {
+			luaConfig.InlineCode = ""
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, listener.GetHttpListener())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+
+			var cfg envoylua.Lua
+			err = protoutils.UnmarshalStruct(filters[0].HttpFilter.GetConfig(), &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetInlineCode()).To(Equal(NoopInlineCode))
+			Expect(cfg.GetSourceCodes()).To(Equal(luaConfig.GetSourceCodes()))
+		})
+
+		It("does not add the filter when lua is not configured", func() {
+			filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+	})
+
+	Context("per route config", func() {
+
+		It("allows virtual host specific disabling of lua", func() {
+			out := &envoyroute.VirtualHost{}
+			err := NewPlugin().ProcessVirtualHost(params.VirtualHostParams, &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{
+					LuaPerRoute: &envoylua.LuaPerRoute{
+						Override: &envoylua.LuaPerRoute_Disabled{Disabled: true},
+					},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoylua.LuaPerRoute
+			err = protoutils.UnmarshalStruct(out.GetPerFilterConfig()[FilterName], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetDisabled()).To(BeTrue())
+		})
+
+		It("allows route specific script selection", func() {
+			out := &envoyroute.Route{}
+			err := NewPlugin().ProcessRoute(params, &v1.Route{
+				Options: &v1.RouteOptions{
+					LuaPerRoute: &envoylua.LuaPerRoute{
+						Override: &envoylua.LuaPerRoute_Name{Name: "hello.lua"},
+					},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			var cfg envoylua.LuaPerRoute
+			err = protoutils.UnmarshalStruct(out.GetPerFilterConfig()[FilterName], &cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetName()).To(Equal("hello.lua"))
+		})
+
+		DescribeTable("rejects invalid per route config",
+			func(luaPerRoute *envoylua.LuaPerRoute, expectedErr error) {
+				err := NewPlugin().ProcessRoute(params, &v1.Route{
+					Options: &v1.RouteOptions{LuaPerRoute: luaPerRoute},
+				}, &envoyroute.Route{})
+				Expect(err).To(MatchError(expectedErr.Error()))
+
+				err = NewPlugin().ProcessVirtualHost(params.VirtualHostParams, &v1.VirtualHost{
+					Options: &v1.VirtualHostOptions{LuaPerRoute: luaPerRoute},
+				}, &envoyroute.VirtualHost{})
+				Expect(err).To(MatchError(expectedErr.Error()))
+			},
+			Entry("script not defined on the listener", &envoylua.LuaPerRoute{
+				Override: &envoylua.LuaPerRoute_Name{Name: "missing.lua"},
+			}, UnknownSourceCodeErr("missing.lua")),
+			Entry("neither disabled nor a script", &envoylua.LuaPerRoute{}, EmptyLuaPerRouteErr),
+			Entry("disabled set to false", &envoylua.LuaPerRoute{
+				Override: &envoylua.LuaPerRoute_Disabled{Disabled: false},
+			}, EmptyLuaPerRouteErr),
+			Entry("empty script name", &envoylua.LuaPerRoute{
+				Override: &envoylua.LuaPerRoute_Name{Name: ""},
+			}, EmptyLuaPerRouteErr),
+		)
+
+		It("errors when a script is selected but the listener does not configure lua", func() {
+			listener.GetHttpListener().Options = nil
+			err := NewPlugin().ProcessRoute(params, &v1.Route{
+				Options: &v1.RouteOptions{
+					LuaPerRoute: &envoylua.LuaPerRoute{
+						Override: &envoylua.LuaPerRoute_Name{Name: "hello.lua"},
+					},
+				},
+			}, &envoyroute.Route{})
+			Expect(err).To(MatchError(MissingLuaConfigErr))
+		})
+	})
+})
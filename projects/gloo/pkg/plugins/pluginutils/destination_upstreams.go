@@ -28,6 +28,10 @@ func DestinationUpstreams(snap *v1.ApiSnapshot, in *v1.RouteAction) ([]core.Reso
 			return nil, NewUpstreamGroupNotFoundErr(*dest.UpstreamGroup)
 		}
 		return destinationsToRefs(upstreamGroup.Destinations)
+
+	case *v1.RouteAction_DynamicForwardProxy:
+		// hosts are resolved at request time, not through upstreams
+		return nil, nil
 	}
 	panic("invalid route")
 }
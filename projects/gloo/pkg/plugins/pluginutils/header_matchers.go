@@ -0,0 +1,42 @@
+package pluginutils
+
+import (
+	"context"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+)
+
+// converts gloo header matchers into envoy header matchers, as used in route matches
+func EnvoyHeaderMatchers(ctx context.Context, in []*matchers.HeaderMatcher) []*envoyroute.HeaderMatcher {
+	var out []*envoyroute.HeaderMatcher
+	for _, matcher := range in {
+
+		envoyMatch := &envoyroute.HeaderMatcher{
+			Name: matcher.Name,
+		}
+		if matcher.Value == "" {
+			envoyMatch.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_PresentMatch{
+				PresentMatch: true,
+			}
+		} else {
+			if matcher.Regex {
+				envoyMatch.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_SafeRegexMatch{
+					SafeRegexMatch: regexutils.NewRegex(ctx, matcher.Value),
+				}
+			} else {
+				envoyMatch.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_ExactMatch{
+					ExactMatch: matcher.Value,
+				}
+			}
+		}
+
+		if matcher.InvertMatch {
+			envoyMatch.InvertMatch = true
+		}
+
+		out = append(out, envoyMatch)
+	}
+	return out
+}
@@ -33,6 +33,9 @@ func MarkHeaders(ctx context.Context, snap *v1.ApiSnapshot, in *v1.Route, out *e
 		return configureHeadersMultiDest(dest.Multi.Destinations, outAction, headers)
 	case *v1.RouteAction_Single:
 		return configureHeadersSingleDest(dest.Single, &out.RequestHeadersToAdd, headers)
+	case *v1.RouteAction_DynamicForwardProxy:
+		// no destination specs to configure
+		return nil
 	}
 
 	err = errors.Errorf("unexpected destination type %v", reflect.TypeOf(inAction.Destination).Name())
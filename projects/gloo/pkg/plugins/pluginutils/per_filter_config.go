@@ -59,6 +59,9 @@ func MarkPerFilterConfig(ctx context.Context, snap *v1.ApiSnapshot, in *v1.Route
 			out.PerFilterConfig = make(map[string]*structpb.Struct)
 		}
 		return configureSingleDest(dest.Single, out.PerFilterConfig, filterName, perFilterConfig)
+	case *v1.RouteAction_DynamicForwardProxy:
+		// no destination specs to configure
+		return nil
 	}
 
 	err = errors.Errorf("unexpected destination type %v", reflect.TypeOf(inAction.Destination).Name())
@@ -11,6 +11,8 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/buffer"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/cors"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/csrf"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/dnssrv"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/faultinjection"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpc"
@@ -23,12 +25,15 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/linkerd"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/listener"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/loadbalancer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/localratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/lua"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pipe"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rest"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tcp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tracing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
@@ -64,18 +69,23 @@ var globalRegistry = func(opts bootstrap.Opts, pluginExtensions ...func() plugin
 		faultinjection.NewPlugin(),
 		basicroute.NewPlugin(),
 		cors.NewPlugin(),
+		csrf.NewPlugin(),
 		linkerd.NewPlugin(),
 		stats.NewPlugin(),
 		ec2.NewPlugin(opts.Secrets),
+		dnssrv.NewPlugin(dnssrv.NewResolver),
 		tracing.NewPlugin(),
 		shadowing.NewPlugin(),
 		headers.NewPlugin(),
 		healthcheck.NewPlugin(),
 		extauth.NewCustomAuthPlugin(),
 		ratelimit.NewPlugin(),
+		localratelimit.NewPlugin(),
 		wasm.NewPlugin(),
 		gzip.NewPlugin(),
 		buffer.NewPlugin(),
+		lua.NewPlugin(),
+		tap.NewPlugin(),
 		listener.NewPlugin(),
 	)
 	if opts.KubeClient != nil {
@@ -0,0 +1,136 @@
+package tap
+
+import (
+	"context"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoytapcommon "github.com/envoyproxy/go-control-plane/envoy/config/common/tap/v2alpha"
+	envoytap "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/tap/v2alpha"
+	envoytapservice "github.com/envoyproxy/go-control-plane/envoy/service/tap/v2alpha"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+const (
+	FilterName = "envoy.filters.http.tap"
+
+	// the config id taps are started under on the envoy admin endpoint, unless one is specified
+	DefaultAdminConfigId = "gloo-tap"
+)
+
+// tap requests as they were received, before any other filter acts on them
+var pluginStage = plugins.BeforeStage(plugins.FaultStage)
+
+var (
+	MissingSinkErr       = eris.New("tap must specify a sink")
+	MissingPathPrefixErr = eris.New("tap file per tap sink must specify a path prefix")
+	AdminSinkMatchErr    = eris.New("tap match conditions only apply to the file per tap sink; " +
+		"taps started through the admin endpoint specify their own")
+)
+
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+var _ plugins.Plugin = new(Plugin)
+var _ plugins.HttpFilterPlugin = new(Plugin)
+
+type Plugin struct {
+}
+
+func (p *Plugin) Init(params plugins.InitParams) error {
+	return nil
+}
+
+func (p *Plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	tapConfig := listener.GetOptions().GetTap()
+	if tapConfig == nil {
+		return nil, nil
+	}
+
+	commonConfig, err := translateTap(params.Ctx, tapConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	tapFilter, err := plugins.NewStagedFilterWithConfig(FilterName, &envoytap.Tap{
+		CommonConfig: commonConfig,
+	}, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+
+	return []plugins.StagedHttpFilter{tapFilter}, nil
+}
+
+func translateTap(ctx context.Context, in *tap.Tap) (*envoytapcommon.CommonExtensionConfig, error) {
+	switch sink := in.GetSink().(type) {
+	case *tap.Tap_Admin:
+		if in.GetMatch() != nil {
+			return nil, AdminSinkMatchErr
+		}
+		configId := sink.Admin.GetConfigId()
+		if configId == "" {
+			configId = DefaultAdminConfigId
+		}
+		return &envoytapcommon.CommonExtensionConfig{
+			ConfigType: &envoytapcommon.CommonExtensionConfig_AdminConfig{
+				AdminConfig: &envoytapcommon.AdminConfig{
+					ConfigId: configId,
+				},
+			},
+		}, nil
+	case *tap.Tap_FilePerTap:
+		if sink.FilePerTap.GetPathPrefix() == "" {
+			return nil, MissingPathPrefixErr
+		}
+		return &envoytapcommon.CommonExtensionConfig{
+			ConfigType: &envoytapcommon.CommonExtensionConfig_StaticConfig{
+				StaticConfig: &envoytapservice.TapConfig{
+					MatchConfig: TapMatchPredicate(ctx, in.GetMatch()),
+					OutputConfig: &envoytapservice.OutputConfig{
+						Sinks: []*envoytapservice.OutputSink{{
+							Format: envoytapservice.OutputSink_JSON_BODY_AS_STRING,
+							OutputSinkType: &envoytapservice.OutputSink_FilePerTap{
+								FilePerTap: &envoytapservice.FilePerTapSink{
+									PathPrefix: sink.FilePerTap.GetPathPrefix(),
+								},
+							},
+						}},
+					},
+				},
+			},
+		}, nil
+	}
+	return nil, MissingSinkErr
+}
+
+// TapMatchPredicate converts tap match conditions into an envoy match predicate, matching every request
+// if there are no conditions.
+func TapMatchPredicate(ctx context.Context, match *tap.TapMatch) *envoytapservice.MatchPredicate {
+	headers := pluginutils.EnvoyHeaderMatchers(ctx, match.GetHeaders())
+	if match.GetPathPrefix() != "" {
+		headers = append(headers, &envoyroute.HeaderMatcher{
+			Name: ":path",
+			HeaderMatchSpecifier: &envoyroute.HeaderMatcher_PrefixMatch{
+				PrefixMatch: match.GetPathPrefix(),
+			},
+		})
+	}
+
+	if len(headers) == 0 {
+		return &envoytapservice.MatchPredicate{
+			Rule: &envoytapservice.MatchPredicate_AnyMatch{AnyMatch: true},
+		}
+	}
+	return &envoytapservice.MatchPredicate{
+		Rule: &envoytapservice.MatchPredicate_HttpRequestHeadersMatch{
+			HttpRequestHeadersMatch: &envoytapservice.HttpHeadersMatch{
+				Headers: headers,
+			},
+		},
+	}
+}
@@ -0,0 +1,148 @@
+package tap_test
+
+import (
+	"context"
+
+	envoyroute "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoytapcommon "github.com/envoyproxy/go-control-plane/envoy/config/common/tap/v2alpha"
+	envoytap "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/tap/v2alpha"
+	envoytapservice "github.com/envoyproxy/go-control-plane/envoy/service/tap/v2alpha"
+	"github.com/envoyproxy/go-control-plane/pkg/conversion"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/tap"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params plugins.Params
+	)
+
+	BeforeEach(func() {
+		params = plugins.Params{Ctx: context.TODO()}
+	})
+
+	tapFilterConfig := func(tapConfig *tap.Tap) (*envoytap.Tap, error) {
+		filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				Tap: tapConfig,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+		Expect(filters[0].Stage).To(Equal(plugins.BeforeStage(plugins.FaultStage)))
+
+		var cfg envoytap.Tap
+		err = conversion.StructToMessage(filters[0].HttpFilter.GetConfig(), &cfg)
+		Expect(err).NotTo(HaveOccurred())
+		return &cfg, nil
+	}
+
+	It("does not add the filter when tap is not configured", func() {
+		filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("writes matching requests to a file per tap", func() {
+		cfg, err := tapFilterConfig(&tap.Tap{
+			Match: &tap.TapMatch{
+				Headers: []*matchers.HeaderMatcher{{
+					Name:  "x-debug",
+					Value: "true",
+				}},
+				PathPrefix: "/api",
+			},
+			Sink: &tap.Tap_FilePerTap{
+				FilePerTap: &tap.FilePerTapSink{
+					PathPrefix: "/var/log/tap/trace",
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.GetCommonConfig().GetStaticConfig()).To(Equal(&envoytapservice.TapConfig{
+			MatchConfig: &envoytapservice.MatchPredicate{
+				Rule: &envoytapservice.MatchPredicate_HttpRequestHeadersMatch{
+					HttpRequestHeadersMatch: &envoytapservice.HttpHeadersMatch{
+						Headers: []*envoyroute.HeaderMatcher{
+							{
+								Name:                 "x-debug",
+								HeaderMatchSpecifier: &envoyroute.HeaderMatcher_ExactMatch{ExactMatch: "true"},
+							},
+							{
+								Name:                 ":path",
+								HeaderMatchSpecifier: &envoyroute.HeaderMatcher_PrefixMatch{PrefixMatch: "/api"},
+							},
+						},
+					},
+				},
+			},
+			OutputConfig: &envoytapservice.OutputConfig{
+				Sinks: []*envoytapservice.OutputSink{{
+					Format: envoytapservice.OutputSink_JSON_BODY_AS_STRING,
+					OutputSinkType: &envoytapservice.OutputSink_FilePerTap{
+						FilePerTap: &envoytapservice.FilePerTapSink{
+							PathPrefix: "/var/log/tap/trace",
+						},
+					},
+				}},
+			},
+		}))
+	})
+
+	It("taps every request when there are no match conditions", func() {
+		cfg, err := tapFilterConfig(&tap.Tap{
+			Sink: &tap.Tap_FilePerTap{
+				FilePerTap: &tap.FilePerTapSink{
+					PathPrefix: "/var/log/tap/trace",
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.GetCommonConfig().GetStaticConfig().GetMatchConfig().GetAnyMatch()).To(BeTrue())
+	})
+
+	It("lets taps be started through the admin endpoint", func() {
+		cfg, err := tapFilterConfig(&tap.Tap{
+			Sink: &tap.Tap_Admin{
+				Admin: &tap.AdminSink{},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.GetCommonConfig()).To(Equal(&envoytapcommon.CommonExtensionConfig{
+			ConfigType: &envoytapcommon.CommonExtensionConfig_AdminConfig{
+				AdminConfig: &envoytapcommon.AdminConfig{
+					ConfigId: DefaultAdminConfigId,
+				},
+			},
+		}))
+	})
+
+	It("rejects invalid tap configs", func() {
+		_, err := tapFilterConfig(&tap.Tap{})
+		Expect(err).To(MatchError(MissingSinkErr))
+
+		_, err = tapFilterConfig(&tap.Tap{
+			Sink: &tap.Tap_FilePerTap{
+				FilePerTap: &tap.FilePerTapSink{},
+			},
+		})
+		Expect(err).To(MatchError(MissingPathPrefixErr))
+
+		_, err = tapFilterConfig(&tap.Tap{
+			Match: &tap.TapMatch{PathPrefix: "/api"},
+			Sink: &tap.Tap_Admin{
+				Admin: &tap.AdminSink{ConfigId: "debug"},
+			},
+		})
+		Expect(err).To(MatchError(AdminSinkMatchErr))
+	})
+})
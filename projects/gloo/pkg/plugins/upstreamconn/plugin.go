@@ -7,6 +7,7 @@ import (
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 
 	types "github.com/gogo/protobuf/types"
@@ -14,6 +15,21 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 )
 
+var (
+	InvalidWindowSizeErr = func(field string, size uint32) error {
+		return eris.Errorf("%v must be between %v and %v, got %v", field, minWindowSize, maxWindowSize, size)
+	}
+	InvalidMaxConcurrentStreamsErr = func(streams uint32) error {
+		return eris.Errorf("max concurrent streams must be between 1 and %v, got %v", maxConcurrentStreams, streams)
+	}
+)
+
+const (
+	minWindowSize        = 65535
+	maxWindowSize        = 2147483647
+	maxConcurrentStreams = 2147483647
+)
+
 type Plugin struct{}
 
 func NewPlugin() *Plugin {
@@ -25,6 +41,9 @@ func (p *Plugin) Init(params plugins.InitParams) error {
 }
 
 func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *envoyapi.Cluster) error {
+	if err := processProtocolOptions(in.GetProtocolOptions(), out); err != nil {
+		return err
+	}
 
 	cfg := in.GetConnectionConfig()
 	if cfg == nil {
@@ -54,6 +73,44 @@ func (p *Plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 	return nil
 }
 
+func processProtocolOptions(cfg *v1.ProtocolOptions, out *envoyapi.Cluster) error {
+	if common := cfg.GetCommonHttpProtocolOptions(); common != nil {
+		out.CommonHttpProtocolOptions = &envoycore.HttpProtocolOptions{
+			IdleTimeout:     gogoutils.DurationStdToProto(common.GetIdleTimeout()),
+			MaxHeadersCount: gogoutils.UInt32GogoToProto(common.GetMaxHeadersCount()),
+		}
+	}
+
+	if cfg.GetHttp1ProtocolOptions().GetProperCaseHeaderKeyFormat() {
+		out.HttpProtocolOptions = &envoycore.Http1ProtocolOptions{
+			HeaderKeyFormat: &envoycore.Http1ProtocolOptions_HeaderKeyFormat{
+				HeaderFormat: &envoycore.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords_{
+					ProperCaseWords: &envoycore.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords{},
+				},
+			},
+		}
+	}
+
+	if http2 := cfg.GetHttp2ProtocolOptions(); http2 != nil {
+		if streams := http2.GetMaxConcurrentStreams(); streams != nil && (streams.Value < 1 || streams.Value > maxConcurrentStreams) {
+			return InvalidMaxConcurrentStreamsErr(streams.Value)
+		}
+		if size := http2.GetInitialStreamWindowSize(); size != nil && (size.Value < minWindowSize || size.Value > maxWindowSize) {
+			return InvalidWindowSizeErr("initial stream window size", size.Value)
+		}
+		if size := http2.GetInitialConnectionWindowSize(); size != nil && (size.Value < minWindowSize || size.Value > maxWindowSize) {
+			return InvalidWindowSizeErr("initial connection window size", size.Value)
+		}
+		out.Http2ProtocolOptions = &envoycore.Http2ProtocolOptions{
+			MaxConcurrentStreams:        gogoutils.UInt32GogoToProto(http2.GetMaxConcurrentStreams()),
+			InitialStreamWindowSize:     gogoutils.UInt32GogoToProto(http2.GetInitialStreamWindowSize()),
+			InitialConnectionWindowSize: gogoutils.UInt32GogoToProto(http2.GetInitialConnectionWindowSize()),
+			HpackTableSize:              gogoutils.UInt32GogoToProto(http2.GetHpackTableSize()),
+		}
+	}
+	return nil
+}
+
 func convertTcpKeepAlive(tcp *v1.ConnectionConfig_TcpKeepAlive) *envoycore.TcpKeepalive {
 	var probes *types.UInt32Value
 	if tcp.KeepaliveProbes > 0 {
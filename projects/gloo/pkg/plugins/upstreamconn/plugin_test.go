@@ -94,4 +94,78 @@ var _ = Describe("Plugin", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(out.GetPerConnectionBufferLimitBytes().Value).To(BeEquivalentTo(uint32(4096)))
 	})
+
+	Context("protocol options", func() {
+
+		It("should set common http protocol options", func() {
+			minute := time.Minute
+			upstream.ProtocolOptions = &v1.ProtocolOptions{
+				CommonHttpProtocolOptions: &v1.ProtocolOptions_HttpProtocolOptions{
+					IdleTimeout:     &minute,
+					MaxHeadersCount: &types.UInt32Value{Value: 200},
+				},
+			}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetCommonHttpProtocolOptions()).To(Equal(&envoycore.HttpProtocolOptions{
+				IdleTimeout:     gogoutils.DurationStdToProto(&minute),
+				MaxHeadersCount: &wrappers.UInt32Value{Value: 200},
+			}))
+		})
+
+		It("should set proper case header keys", func() {
+			upstream.ProtocolOptions = &v1.ProtocolOptions{
+				Http1ProtocolOptions: &v1.ProtocolOptions_Http1ProtocolOptions{
+					ProperCaseHeaderKeyFormat: true,
+				},
+			}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetHttpProtocolOptions().GetHeaderKeyFormat().GetProperCaseWords()).NotTo(BeNil())
+		})
+
+		It("should set http2 protocol options", func() {
+			upstream.ProtocolOptions = &v1.ProtocolOptions{
+				Http2ProtocolOptions: &v1.ProtocolOptions_Http2ProtocolOptions{
+					MaxConcurrentStreams:        &types.UInt32Value{Value: 100},
+					InitialStreamWindowSize:     &types.UInt32Value{Value: 65536},
+					InitialConnectionWindowSize: &types.UInt32Value{Value: 1048576},
+					HpackTableSize:              &types.UInt32Value{Value: 0},
+				},
+			}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetHttp2ProtocolOptions()).To(Equal(&envoycore.Http2ProtocolOptions{
+				MaxConcurrentStreams:        &wrappers.UInt32Value{Value: 100},
+				InitialStreamWindowSize:     &wrappers.UInt32Value{Value: 65536},
+				InitialConnectionWindowSize: &wrappers.UInt32Value{Value: 1048576},
+				HpackTableSize:              &wrappers.UInt32Value{Value: 0},
+			}))
+		})
+
+		It("should error on invalid http2 window sizes", func() {
+			upstream.ProtocolOptions = &v1.ProtocolOptions{
+				Http2ProtocolOptions: &v1.ProtocolOptions_Http2ProtocolOptions{
+					InitialStreamWindowSize: &types.UInt32Value{Value: 1024},
+				},
+			}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(InvalidWindowSizeErr("initial stream window size", 1024).Error()))
+		})
+
+		It("should error on zero max concurrent streams", func() {
+			upstream.ProtocolOptions = &v1.ProtocolOptions{
+				Http2ProtocolOptions: &v1.ProtocolOptions_Http2ProtocolOptions{
+					MaxConcurrentStreams: &types.UInt32Value{Value: 0},
+				},
+			}
+
+			err := plugin.ProcessUpstream(params, upstream, out)
+			Expect(err).To(MatchError(InvalidMaxConcurrentStreamsErr(0).Error()))
+		})
+	})
 })
@@ -21,6 +21,15 @@ func UpdateUpstream(original, desired *v1.Upstream) {
 	if desired.ConnectionConfig == nil {
 		desired.ConnectionConfig = original.ConnectionConfig
 	}
+	if desired.Failover == nil {
+		desired.Failover = original.Failover
+	}
+	if desired.ProtocolOptions == nil {
+		desired.ProtocolOptions = original.ProtocolOptions
+	}
+	if desired.HealthCheckSpecs == nil {
+		desired.HealthCheckSpecs = original.HealthCheckSpecs
+	}
 
 	if desiredSubsetMutator, ok := desired.UpstreamType.(v1.SubsetSpecMutator); ok {
 		if desiredSubsetMutator.GetSubsetSpec() == nil {
@@ -0,0 +1,63 @@
+package utils_test
+
+import (
+	"github.com/gogo/protobuf/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("UpdateUpstream", func() {
+
+	var (
+		original *v1.Upstream
+		desired  *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		original = &v1.Upstream{
+			Metadata: core.Metadata{Name: "svc-8080", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Kube{
+				Kube: &kubernetes.UpstreamSpec{ServiceName: "svc", ServiceNamespace: "default", ServicePort: 8080},
+			},
+			Failover: &v1.Failover{
+				PrioritizedUpstreams: []*core.ResourceRef{{Name: "backup", Namespace: "gloo-system"}},
+			},
+			ProtocolOptions: &v1.ProtocolOptions{
+				Http2ProtocolOptions: &v1.ProtocolOptions_Http2ProtocolOptions{
+					MaxConcurrentStreams: &types.UInt32Value{Value: 100},
+				},
+			},
+		}
+		// discovery only sets the fields it discovers
+		desired = &v1.Upstream{
+			Metadata: core.Metadata{Name: "svc-8080", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Kube{
+				Kube: &kubernetes.UpstreamSpec{ServiceName: "svc", ServiceNamespace: "default", ServicePort: 8080},
+			},
+		}
+	})
+
+	It("keeps the failover config set by the user on a discovery resync", func() {
+		utils.UpdateUpstream(original, desired)
+		Expect(desired.Failover).To(Equal(original.Failover))
+	})
+
+	It("keeps the protocol options set by the user on a discovery resync", func() {
+		utils.UpdateUpstream(original, desired)
+		Expect(desired.ProtocolOptions).To(Equal(original.ProtocolOptions))
+		Expect(desired.Failover).To(Equal(original.Failover))
+	})
+
+	It("does not override the failover config set by discovery", func() {
+		discovered := &v1.Failover{
+			PrioritizedUpstreams: []*core.ResourceRef{{Name: "discovered", Namespace: "gloo-system"}},
+		}
+		desired.Failover = discovered
+		utils.UpdateUpstream(original, desired)
+		Expect(desired.Failover).To(Equal(discovered))
+	})
+})
@@ -456,7 +456,9 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 
 	t := translator.NewTranslator(sslutils.NewSslConfigTranslator(), opts.Settings, getPlugins)
 
-	validator := validation.NewValidator(watchOpts.Ctx, t)
+	// the validator gets its own translator, so that validating proxies does not change which endpoints are
+	// warming up in the snapshots sent to envoy
+	validator := validation.NewValidator(watchOpts.Ctx, translator.NewTranslator(sslutils.NewSslConfigTranslator(), opts.Settings, getPlugins))
 	if opts.ValidationServer.Server != nil {
 		opts.ValidationServer.Server.SetValidator(validator)
 	}
@@ -2,6 +2,8 @@ package syncer
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
@@ -10,8 +12,10 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
+	"github.com/solo-io/go-utils/contextutils"
 	envoycache "github.com/solo-io/solo-kit/pkg/api/v1/control-plane/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
+	"go.uber.org/zap"
 )
 
 type translatorSyncer struct {
@@ -26,6 +30,11 @@ type translatorSyncer struct {
 	// used to track which envoy node IDs exist without belonging to a proxy
 	extensionKeys map[string]struct{}
 	settings      *v1.Settings
+
+	// serializes syncs, as the snapshot may be synced again when endpoints warm up
+	syncLock sync.Mutex
+	// syncs the latest snapshot again when the weights of warming up endpoints change
+	slowStartTimer *time.Timer
 }
 
 type TranslatorSyncerExtensionParams struct {
@@ -59,6 +68,13 @@ func NewTranslatorSyncer(translator translator.Translator, xdsCache envoycache.S
 }
 
 func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	s.syncLock.Lock()
+	defer s.syncLock.Unlock()
+	return s.sync(ctx, snap)
+}
+
+func (s *translatorSyncer) sync(ctx context.Context, snap *v1.ApiSnapshot) error {
+	defer s.scheduleSlowStartResync(ctx, snap)
 	var multiErr *multierror.Error
 	err := s.syncEnvoy(ctx, snap)
 	if err != nil {
@@ -74,3 +90,30 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.ApiSnapshot) error
 	}
 	return multiErr.ErrorOrNil()
 }
+
+// the snapshot does not change while endpoints warm up, so sync it again when their weights change
+func (s *translatorSyncer) scheduleSlowStartResync(ctx context.Context, snap *v1.ApiSnapshot) {
+	if s.slowStartTimer != nil {
+		s.slowStartTimer.Stop()
+		s.slowStartTimer = nil
+	}
+	scheduler, ok := s.translator.(translator.SlowStartScheduler)
+	if !ok {
+		return
+	}
+	next := scheduler.NextSlowStartUpdate()
+	if next.IsZero() {
+		return
+	}
+	s.slowStartTimer = time.AfterFunc(time.Until(next), func() {
+		s.syncLock.Lock()
+		defer s.syncLock.Unlock()
+		// a newer snapshot was synced in the meantime
+		if ctx.Err() != nil || s.latestSnap != snap {
+			return
+		}
+		if err := s.sync(ctx, snap); err != nil {
+			contextutils.LoggerFrom(ctx).Warnw("failed to sync warming up endpoints", zap.Error(err))
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package translator
+
+import (
+	"context"
+	"time"
+
+	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// logs a warning if the certificate the upstream presents to its endpoints expired or expires soon.
+// only certificates from secrets are checked, as gloo cannot read the files mounted into envoy.
+// this is not reported on the upstream, as warnings on the upstream would reject the whole xds snapshot.
+func logUpstreamCertificateExpiry(ctx context.Context, upstream *v1.Upstream, secrets v1.SecretList, now time.Time) {
+	ref := upstream.GetSslConfig().GetSecretRef()
+	if ref == nil {
+		return
+	}
+	if warning := utils.CertificateExpiryWarning(*ref, secrets, now, utils.DefaultCertificateExpiryWarningPeriod); warning != "" {
+		contextutils.LoggerFrom(ctx).Warnw(warning, "upstream", upstream.GetMetadata().Ref())
+	}
+}
+
+// warns on the virtual hosts that are served with a certificate that expired or expires soon. an ssl config
+// serves the virtual hosts that match one of its sni domains, or all virtual hosts if it has none.
+// these warnings end up on the status of the virtual services, but do not reject the proxy.
+func reportVirtualHostCertificateExpiry(listener *v1.Listener, secrets v1.SecretList, now time.Time, listenerReport *validationapi.ListenerReport) {
+	virtualHostReports := listenerReport.GetHttpListenerReport().GetVirtualHostReports()
+	virtualHosts := listener.GetHttpListener().GetVirtualHosts()
+	if len(virtualHostReports) != len(virtualHosts) {
+		return
+	}
+	for _, sslConfig := range listener.GetSslConfigurations() {
+		ref := sslConfig.GetSecretRef()
+		if ref == nil {
+			continue
+		}
+		warning := utils.CertificateExpiryWarning(*ref, secrets, now, utils.DefaultCertificateExpiryWarningPeriod)
+		if warning == "" {
+			continue
+		}
+		for i, virtualHost := range virtualHosts {
+			if len(sslConfig.GetSniDomains()) > 0 && !sharesDomain(sslConfig.GetSniDomains(), virtualHost.GetDomains()) {
+				continue
+			}
+			validation.AppendVirtualHostWarning(virtualHostReports[i], validationapi.VirtualHostReport_Warning_CertificateExpiryWarning, warning)
+		}
+	}
+}
+
+func sharesDomain(sniDomains, domains []string) bool {
+	for _, sniDomain := range sniDomains {
+		for _, domain := range domains {
+			if sniDomain == domain {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -7,18 +7,21 @@ import (
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoycluster "github.com/envoyproxy/go-control-plane/envoy/api/v2/cluster"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoydfpcluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/dynamic_forward_proxy/v2alpha"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type"
 	"github.com/gogo/protobuf/types"
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v2/reporter"
 	"go.opencensus.io/trace"
 )
 
-func (t *translatorInstance) computeClusters(params plugins.Params, reports reporter.ResourceReports) []*envoyapi.Cluster {
+func (t *translatorInstance) computeClusters(params plugins.Params, proxy *v1.Proxy, reports reporter.ResourceReports) []*envoyapi.Cluster {
 
 	ctx, span := trace.StartSpan(params.Ctx, "gloo.translator.computeClusters")
 	params.Ctx = ctx
@@ -34,6 +37,46 @@ func (t *translatorInstance) computeClusters(params plugins.Params, reports repo
 		cluster := t.computeCluster(params, upstream, reports)
 		clusters = append(clusters, cluster)
 	}
+
+	clusters = append(clusters, computeDynamicForwardProxyClusters(proxy, reports)...)
+	return clusters
+}
+
+// listeners with the dynamic forward proxy enabled route to a cluster that resolves hosts from a shared DNS cache.
+// listeners with identical DNS cache configs share a single cluster.
+func computeDynamicForwardProxyClusters(proxy *v1.Proxy, reports reporter.ResourceReports) []*envoyapi.Cluster {
+	var clusters []*envoyapi.Cluster
+	seen := make(map[string]bool)
+	for _, listener := range proxy.GetListeners() {
+		dfpConfig := listener.GetHttpListener().GetOptions().GetDynamicForwardProxy()
+		if dfpConfig == nil {
+			continue
+		}
+		name := DynamicForwardProxyClusterName(dfpConfig)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		clusterConfig, err := pluginutils.MessageToAny(&envoydfpcluster.ClusterConfig{
+			DnsCacheConfig: dynamicForwardProxyDnsCacheConfig(dfpConfig),
+		})
+		if err != nil {
+			reports.AddError(proxy, eris.Wrapf(err, "generating dynamic forward proxy cluster for listener %v", listener.GetName()))
+			continue
+		}
+		clusters = append(clusters, &envoyapi.Cluster{
+			Name:           name,
+			ConnectTimeout: gogoutils.DurationStdToProto(&ClusterConnectionTimeout),
+			LbPolicy:       envoyapi.Cluster_CLUSTER_PROVIDED,
+			ClusterDiscoveryType: &envoyapi.Cluster_ClusterType{
+				ClusterType: &envoyapi.Cluster_CustomClusterType{
+					Name:        DynamicForwardProxyClusterType,
+					TypedConfig: clusterConfig,
+				},
+			},
+		})
+	}
 	return clusters
 }
 
@@ -51,6 +94,13 @@ func (t *translatorInstance) computeCluster(params plugins.Params, upstream *v1.
 			reports.AddError(upstream, err)
 		}
 	}
+	if err := applyFailover(upstream, params.Snapshot.Upstreams, params.Snapshot.Endpoints, out); err != nil {
+		reports.AddError(upstream, err)
+	}
+	if err := validateGrpcHealthCheckSpecs(upstream, out); err != nil {
+		reports.AddError(upstream, err)
+	}
+	logUpstreamCertificateExpiry(params.Ctx, upstream, params.Snapshot.Secrets, t.now())
 	if err := validateCluster(out); err != nil {
 		reports.AddError(upstream, eris.Wrapf(err, "cluster was configured improperly "+
 			"by one or more plugins: %v", out))
@@ -68,11 +118,14 @@ func (t *translatorInstance) initializeCluster(upstream *v1.Upstream, endpoints
 		reports.AddError(upstream, err)
 	}
 
-	circuitBreakers := t.settings.GetGloo().GetCircuitBreakers()
+	circuitBreakers, err := getCircuitBreakers(upstream.CircuitBreakers, t.settings.GetGloo().GetCircuitBreakers())
+	if err != nil {
+		reports.AddError(upstream, err)
+	}
 	out := &envoyapi.Cluster{
 		Name:             UpstreamToClusterName(upstream.Metadata.Ref()),
 		Metadata:         new(envoycore.Metadata),
-		CircuitBreakers:  getCircuitBreakers(upstream.CircuitBreakers, circuitBreakers),
+		CircuitBreakers:  circuitBreakers,
 		LbSubsetConfig:   createLbConfig(upstream),
 		HealthChecks:     hcConfig,
 		OutlierDetection: detectCfg,
@@ -97,6 +150,9 @@ var (
 	NilFieldError = func(fieldName string) error {
 		return eris.Errorf("The field %s cannot be nil", fieldName)
 	}
+	InvalidRetryBudgetPercentErr = func(percent float64) error {
+		return eris.Errorf("the retry budget percent of a circuit breaker must be between 0 and 100, got %v", percent)
+	}
 )
 
 func createHealthCheckConfig(upstream *v1.Upstream) ([]*envoycore.HealthCheck, error) {
@@ -121,7 +177,11 @@ func createHealthCheckConfig(upstream *v1.Upstream) ([]*envoycore.HealthCheck, e
 		}
 		result = append(result, converted)
 	}
-	return result, nil
+	specs, err := convertHealthCheckSpecs(upstream.GetHealthCheckSpecs())
+	if err != nil {
+		return nil, err
+	}
+	return append(result, specs...), nil
 }
 
 func createOutlierDetectionConfig(upstream *v1.Upstream) (*envoycluster.OutlierDetection, error) {
@@ -175,20 +235,56 @@ func validateCluster(c *envoyapi.Cluster) error {
 }
 
 // Convert the first non nil circuit breaker.
-func getCircuitBreakers(cfgs ...*v1.CircuitBreakerConfig) *envoycluster.CircuitBreakers {
+func getCircuitBreakers(cfgs ...*v1.CircuitBreakerConfig) (*envoycluster.CircuitBreakers, error) {
 	for _, cfg := range cfgs {
 		if cfg != nil {
+			defaultThresholds, err := getThresholds(envoycore.RoutingPriority_DEFAULT, &v1.CircuitBreakerConfig_Thresholds{
+				MaxConnections:     cfg.MaxConnections,
+				MaxPendingRequests: cfg.MaxPendingRequests,
+				MaxRequests:        cfg.MaxRequests,
+				MaxRetries:         cfg.MaxRetries,
+				RetryBudget:        cfg.RetryBudget,
+				TrackRemaining:     cfg.TrackRemaining,
+			})
+			if err != nil {
+				return nil, err
+			}
 			envoyCfg := &envoycluster.CircuitBreakers{}
-			envoyCfg.Thresholds = []*envoycluster.CircuitBreakers_Thresholds{{
-				MaxConnections:     gogoutils.UInt32GogoToProto(cfg.MaxConnections),
-				MaxPendingRequests: gogoutils.UInt32GogoToProto(cfg.MaxPendingRequests),
-				MaxRequests:        gogoutils.UInt32GogoToProto(cfg.MaxRequests),
-				MaxRetries:         gogoutils.UInt32GogoToProto(cfg.MaxRetries),
-			}}
-			return envoyCfg
+			envoyCfg.Thresholds = []*envoycluster.CircuitBreakers_Thresholds{defaultThresholds}
+			if cfg.HighPriority != nil {
+				highPriorityThresholds, err := getThresholds(envoycore.RoutingPriority_HIGH, cfg.HighPriority)
+				if err != nil {
+					return nil, err
+				}
+				envoyCfg.Thresholds = append(envoyCfg.Thresholds, highPriorityThresholds)
+			}
+			return envoyCfg, nil
 		}
 	}
-	return nil
+	return nil, nil
+}
+
+func getThresholds(priority envoycore.RoutingPriority, cfg *v1.CircuitBreakerConfig_Thresholds) (*envoycluster.CircuitBreakers_Thresholds, error) {
+	thresholds := &envoycluster.CircuitBreakers_Thresholds{
+		Priority:           priority,
+		MaxConnections:     gogoutils.UInt32GogoToProto(cfg.MaxConnections),
+		MaxPendingRequests: gogoutils.UInt32GogoToProto(cfg.MaxPendingRequests),
+		MaxRequests:        gogoutils.UInt32GogoToProto(cfg.MaxRequests),
+		MaxRetries:         gogoutils.UInt32GogoToProto(cfg.MaxRetries),
+		TrackRemaining:     cfg.TrackRemaining,
+	}
+	if budget := cfg.RetryBudget; budget != nil {
+		thresholds.RetryBudget = &envoycluster.CircuitBreakers_Thresholds_RetryBudget{
+			MinRetryConcurrency: gogoutils.UInt32GogoToProto(budget.MinRetryConcurrency),
+		}
+		if budget.BudgetPercent != nil {
+			if budget.BudgetPercent.Value < 0 || budget.BudgetPercent.Value > 100 {
+				return nil, InvalidRetryBudgetPercentErr(budget.BudgetPercent.Value)
+			}
+			thresholds.RetryBudget.BudgetPercent = &envoytype.Percent{Value: budget.BudgetPercent.Value}
+		}
+	}
+	return thresholds, nil
 }
 
 func getHttp2ptions(us *v1.Upstream) *envoycore.Http2ProtocolOptions {
@@ -0,0 +1,76 @@
+package translator
+
+import (
+	"fmt"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoydfpcommon "github.com/envoyproxy/go-control-plane/envoy/config/common/dynamic_forward_proxy/v2alpha"
+	envoydfpfilter "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/dynamic_forward_proxy/v2alpha"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/gogoutils"
+	"github.com/solo-io/gloo/pkg/utils/protoutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
+	"github.com/solo-io/go-utils/hashutils"
+)
+
+const (
+	DynamicForwardProxyFilterName  = "envoy.filters.http.dynamic_forward_proxy"
+	DynamicForwardProxyClusterType = "envoy.clusters.dynamic_forward_proxy"
+)
+
+var (
+	MissingDynamicForwardProxyErr = func(listenerName string) error {
+		return errors.Errorf("route has a dynamic forward proxy destination, but listener %v does not "+
+			"enable the dynamic forward proxy", listenerName)
+	}
+)
+
+// returns the name of the cluster (and DNS cache) created for a dynamic forward proxy config.
+// envoy requires that DNS caches with the same name have identical configs, and that the filter
+// and cluster of a listener share a DNS cache, so we name both after a hash of the config.
+func DynamicForwardProxyClusterName(cfg *dynamic_forward_proxy.FilterConfig) string {
+	return fmt.Sprintf("dynamic_forward_proxy:%d", hashutils.MustHash(cfg.GetDnsCacheConfig()))
+}
+
+func dynamicForwardProxyDnsCacheConfig(cfg *dynamic_forward_proxy.FilterConfig) *envoydfpcommon.DnsCacheConfig {
+	dnsCacheConfig := cfg.GetDnsCacheConfig()
+	return &envoydfpcommon.DnsCacheConfig{
+		Name:            DynamicForwardProxyClusterName(cfg),
+		DnsLookupFamily: envoyapi.Cluster_DnsLookupFamily(dnsCacheConfig.GetDnsLookupFamily()),
+		DnsRefreshRate:  gogoutils.DurationStdToProto(dnsCacheConfig.GetDnsRefreshRate()),
+		HostTtl:         gogoutils.DurationStdToProto(dnsCacheConfig.GetHostTtl()),
+		MaxHosts:        gogoutils.UInt32GogoToProto(dnsCacheConfig.GetMaxHosts()),
+	}
+}
+
+func dynamicForwardProxyHttpFilter(cfg *dynamic_forward_proxy.FilterConfig) (*envoyhttp.HttpFilter, error) {
+	filterConfig, err := protoutils.MarshalStruct(&envoydfpfilter.FilterConfig{
+		DnsCacheConfig: dynamicForwardProxyDnsCacheConfig(cfg),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &envoyhttp.HttpFilter{
+		Name:       DynamicForwardProxyFilterName,
+		ConfigType: &envoyhttp.HttpFilter_Config{Config: filterConfig},
+	}, nil
+}
+
+func dynamicForwardProxyPerRouteConfig(in *dynamic_forward_proxy.PerRouteConfig) *envoydfpfilter.PerRouteConfig {
+	switch hostRewrite := in.GetHostRewriteSpecifier().(type) {
+	case *dynamic_forward_proxy.PerRouteConfig_HostRewrite:
+		return &envoydfpfilter.PerRouteConfig{
+			HostRewriteSpecifier: &envoydfpfilter.PerRouteConfig_HostRewrite{
+				HostRewrite: hostRewrite.HostRewrite,
+			},
+		}
+	case *dynamic_forward_proxy.PerRouteConfig_AutoHostRewriteHeader:
+		return &envoydfpfilter.PerRouteConfig{
+			HostRewriteSpecifier: &envoydfpfilter.PerRouteConfig_AutoHostRewriteHeader{
+				AutoHostRewriteHeader: hostRewrite.AutoHostRewriteHeader,
+			},
+		}
+	}
+	return nil
+}
@@ -2,13 +2,17 @@ package translator
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"go.opencensus.io/trace"
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoyendpoints "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	"github.com/solo-io/gloo/pkg/utils/gogoutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 )
 
@@ -17,7 +21,7 @@ const SoloAnnotations = "io.solo.annotations"
 
 // Endpoints
 
-func computeClusterEndpoints(ctx context.Context, upstreams []*v1.Upstream, endpoints []*v1.Endpoint) []*envoyapi.ClusterLoadAssignment {
+func computeClusterEndpoints(ctx context.Context, upstreams v1.UpstreamList, endpoints []*v1.Endpoint) []*envoyapi.ClusterLoadAssignment {
 
 	_, span := trace.StartSpan(ctx, "gloo.translator.computeClusterEndpoints")
 	defer span.End()
@@ -25,9 +29,16 @@ func computeClusterEndpoints(ctx context.Context, upstreams []*v1.Upstream, endp
 	var clusterEndpointAssignments []*envoyapi.ClusterLoadAssignment
 	for _, upstream := range upstreams {
 		clusterEndpoints := endpointsForUpstream(upstream, endpoints)
+		// the endpoints of backup upstreams are only added to eds clusters here, others get them
+		// in their cluster's load assignment. errors are reported on the upstream when computing its cluster.
+		var failoverEndpoints []*envoyendpoints.LocalityLbEndpoints
+		if isEdsUpstream(upstream) {
+			failoverEndpoints, _ = failoverLocalityEndpoints(upstream, upstreams, endpoints)
+		}
 		// if there are any endpoints for this upstream, it's using eds and we need to create a load assignment for it
-		if len(clusterEndpoints) > 0 {
+		if len(clusterEndpoints) > 0 || len(failoverEndpoints) > 0 {
 			loadAssignment := loadAssignmentForUpstream(upstream, clusterEndpoints)
+			loadAssignment.Endpoints = append(loadAssignment.Endpoints, failoverEndpoints...)
 			clusterEndpointAssignments = append(clusterEndpointAssignments, loadAssignment)
 		}
 	}
@@ -36,6 +47,73 @@ func computeClusterEndpoints(ctx context.Context, upstreams []*v1.Upstream, endp
 
 func loadAssignmentForUpstream(upstream *v1.Upstream, clusterEndpoints []*v1.Endpoint) *envoyapi.ClusterLoadAssignment {
 	clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
+	return &envoyapi.ClusterLoadAssignment{
+		ClusterName: clusterName,
+		Endpoints:   localityLbEndpointsForUpstream(upstream, clusterEndpoints, 0),
+	}
+}
+
+// groups the endpoints by locality, giving all the localities the given priority. always returns at least one
+// locality, so that the priorities of the localities stay contiguous.
+func localityLbEndpointsForUpstream(upstream *v1.Upstream, clusterEndpoints []*v1.Endpoint, priority uint32) []*envoyendpoints.LocalityLbEndpoints {
+	type localityKey struct {
+		Region, Zone, SubZone string
+	}
+	endpointsByLocality := map[localityKey][]*v1.Endpoint{}
+	var localities []localityKey
+	for _, ep := range clusterEndpoints {
+		locality := localityKey{ep.GetLocality().GetRegion(), ep.GetLocality().GetZone(), ep.GetLocality().GetSubZone()}
+		if _, ok := endpointsByLocality[locality]; !ok {
+			localities = append(localities, locality)
+		}
+		endpointsByLocality[locality] = append(endpointsByLocality[locality], ep)
+	}
+	if len(localities) == 0 {
+		return []*envoyendpoints.LocalityLbEndpoints{{Priority: priority}}
+	}
+	sort.Slice(localities, func(i, j int) bool {
+		return fmt.Sprint(localities[i]) < fmt.Sprint(localities[j])
+	})
+
+	// envoy ignores localities without a weight when locality weighted load balancing is enabled
+	weighted := upstream.GetLoadBalancerConfig().GetLocalityWeightedLbConfig() != nil
+	var out []*envoyendpoints.LocalityLbEndpoints
+	for _, locality := range localities {
+		lbEndpoints := lbEndpointsForUpstream(upstream, endpointsByLocality[locality])
+		localityLbEndpoints := &envoyendpoints.LocalityLbEndpoints{
+			LbEndpoints: lbEndpoints,
+			Priority:    priority,
+		}
+		if locality != (localityKey{}) {
+			localityLbEndpoints.Locality = &envoycore.Locality{
+				Region:  locality.Region,
+				Zone:    locality.Zone,
+				SubZone: locality.SubZone,
+			}
+		}
+		if weighted {
+			localityLbEndpoints.LoadBalancingWeight = &wrappers.UInt32Value{Value: localityWeight(lbEndpoints)}
+		}
+		out = append(out, localityLbEndpoints)
+	}
+	return out
+}
+
+// the weight of a locality is the sum of the weights of its endpoints, endpoints without a weight count as 1
+func localityWeight(lbEndpoints []*envoyendpoints.LbEndpoint) uint32 {
+	var weight uint32
+	for _, lbEndpoint := range lbEndpoints {
+		if lbEndpoint.GetLoadBalancingWeight() != nil {
+			weight += lbEndpoint.GetLoadBalancingWeight().GetValue()
+		} else {
+			weight++
+		}
+	}
+	return weight
+}
+
+// the subset metadata of the endpoints is computed from the given upstream's subset spec
+func lbEndpointsForUpstream(upstream *v1.Upstream, clusterEndpoints []*v1.Endpoint) []*envoyendpoints.LbEndpoint {
 	var endpoints []*envoyendpoints.LbEndpoint
 	for _, addr := range clusterEndpoints {
 		metadata := getLbMetadata(upstream, addr.Metadata.Labels, "")
@@ -65,16 +143,11 @@ func loadAssignmentForUpstream(upstream *v1.Upstream, clusterEndpoints []*v1.End
 					Hostname:          addr.GetHostname(),
 				},
 			},
+			LoadBalancingWeight: gogoutils.UInt32GogoToProto(addr.GetLoadBalancingWeight()),
 		}
 		endpoints = append(endpoints, &lbEndpoint)
 	}
-
-	return &envoyapi.ClusterLoadAssignment{
-		ClusterName: clusterName,
-		Endpoints: []*envoyendpoints.LocalityLbEndpoints{{
-			LbEndpoints: endpoints,
-		}},
-	}
+	return endpoints
 }
 
 func endpointsForUpstream(upstream *v1.Upstream, endpoints []*v1.Endpoint) []*v1.Endpoint {
@@ -0,0 +1,157 @@
+package translator
+
+import (
+	"net"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyendpoints "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var (
+	FailoverUnsupportedUpstreamErr = func(ref core.ResourceRef) error {
+		return eris.Errorf("upstream %v does not support failover, only kubernetes, consul, ec2 and static upstreams do", ref.Key())
+	}
+	FailoverUpstreamNotFoundErr = func(ref core.ResourceRef) error {
+		return eris.Errorf("failover upstream %v not found", ref.Key())
+	}
+	FailoverSelfReferenceErr     = eris.New("an upstream cannot be its own failover upstream")
+	FailoverDuplicateUpstreamErr = func(ref core.ResourceRef) error {
+		return eris.Errorf("failover upstream %v is listed more than once", ref.Key())
+	}
+	FailoverStaticHostNotIpErr = func(ref core.ResourceRef, addr string) error {
+		return eris.Errorf("host %v of failover upstream %v must be an IP address", addr, ref.Key())
+	}
+)
+
+// returns the endpoints of the failover upstreams of the upstream. the upstream's own endpoints have priority 0,
+// and the endpoints of each failover upstream are given the next lowest priority. a failover upstream without
+// endpoints still takes up a priority, so that the priorities stay contiguous.
+func failoverLocalityEndpoints(upstream *v1.Upstream, upstreams v1.UpstreamList, endpoints []*v1.Endpoint) ([]*envoyendpoints.LocalityLbEndpoints, error) {
+	failoverRefs := upstream.GetFailover().GetPrioritizedUpstreams()
+	if len(failoverRefs) == 0 {
+		return nil, nil
+	}
+	if !supportsFailover(upstream) {
+		return nil, FailoverUnsupportedUpstreamErr(upstream.Metadata.Ref())
+	}
+
+	seen := map[core.ResourceRef]bool{}
+	var localities []*envoyendpoints.LocalityLbEndpoints
+	for i, ref := range failoverRefs {
+		if *ref == upstream.Metadata.Ref() {
+			return nil, FailoverSelfReferenceErr
+		}
+		if seen[*ref] {
+			return nil, FailoverDuplicateUpstreamErr(*ref)
+		}
+		seen[*ref] = true
+
+		failoverUpstream, err := upstreams.Find(ref.Strings())
+		if err != nil {
+			return nil, FailoverUpstreamNotFoundErr(*ref)
+		}
+		if !supportsFailover(failoverUpstream) {
+			return nil, FailoverUnsupportedUpstreamErr(*ref)
+		}
+
+		priority := uint32(i + 1)
+		if staticSpec := failoverUpstream.GetStatic(); staticSpec != nil {
+			lbEndpoints, err := staticLbEndpoints(*ref, staticSpec.GetHosts())
+			if err != nil {
+				return nil, err
+			}
+			locality := &envoyendpoints.LocalityLbEndpoints{
+				LbEndpoints: lbEndpoints,
+				Priority:    priority,
+			}
+			setLocalityWeights(upstream, []*envoyendpoints.LocalityLbEndpoints{locality})
+			localities = append(localities, locality)
+			continue
+		}
+		// the subset metadata is computed from the primary upstream, whose subset config the cluster uses
+		localities = append(localities, localityLbEndpointsForUpstream(upstream, endpointsForUpstream(failoverUpstream, endpoints), priority)...)
+	}
+	return localities, nil
+}
+
+// adds the endpoints of the failover upstreams to clusters whose endpoints are not discovered through EDS, and
+// weighs the localities of these clusters for locality weighted load balancing
+func applyFailover(upstream *v1.Upstream, upstreams v1.UpstreamList, endpoints []*v1.Endpoint, out *envoyapi.Cluster) error {
+	localities, err := failoverLocalityEndpoints(upstream, upstreams, endpoints)
+	if err != nil {
+		return err
+	}
+	if len(localities) > 0 && out.GetType() != envoyapi.Cluster_EDS {
+		if out.LoadAssignment == nil {
+			out.LoadAssignment = &envoyapi.ClusterLoadAssignment{
+				ClusterName: out.Name,
+				Endpoints:   []*envoyendpoints.LocalityLbEndpoints{{}},
+			}
+		}
+		out.LoadAssignment.Endpoints = append(out.LoadAssignment.Endpoints, localities...)
+	}
+	// the upstream's own localities are not weighted by the plugin that built the load assignment, whether or not
+	// the upstream fails over
+	setLocalityWeights(upstream, out.GetLoadAssignment().GetEndpoints())
+	return nil
+}
+
+// envoy ignores localities without a weight when locality weighted load balancing is enabled.
+// localities without endpoints are left unweighted, as envoy requires weights to be positive.
+func setLocalityWeights(upstream *v1.Upstream, localities []*envoyendpoints.LocalityLbEndpoints) {
+	if upstream.GetLoadBalancerConfig().GetLocalityWeightedLbConfig() == nil {
+		return
+	}
+	for _, locality := range localities {
+		if locality.GetLoadBalancingWeight() == nil && len(locality.GetLbEndpoints()) > 0 {
+			locality.LoadBalancingWeight = &wrappers.UInt32Value{Value: localityWeight(locality.GetLbEndpoints())}
+		}
+	}
+}
+
+// eds does not resolve hostnames, so only static hosts with IP addresses can be used
+func staticLbEndpoints(ref core.ResourceRef, hosts []*static.Host) ([]*envoyendpoints.LbEndpoint, error) {
+	var lbEndpoints []*envoyendpoints.LbEndpoint
+	for _, host := range hosts {
+		if net.ParseIP(host.GetAddr()) == nil {
+			return nil, FailoverStaticHostNotIpErr(ref, host.GetAddr())
+		}
+		lbEndpoints = append(lbEndpoints, &envoyendpoints.LbEndpoint{
+			HostIdentifier: &envoyendpoints.LbEndpoint_Endpoint{
+				Endpoint: &envoyendpoints.Endpoint{
+					Address: &envoycore.Address{
+						Address: &envoycore.Address_SocketAddress{
+							SocketAddress: &envoycore.SocketAddress{
+								Protocol: envoycore.SocketAddress_TCP,
+								Address:  host.GetAddr(),
+								PortSpecifier: &envoycore.SocketAddress_PortValue{
+									PortValue: host.GetPort(),
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	return lbEndpoints, nil
+}
+
+// upstreams whose endpoints are discovered, and sent to envoy through EDS
+func isEdsUpstream(upstream *v1.Upstream) bool {
+	switch upstream.GetUpstreamType().(type) {
+	case *v1.Upstream_Kube, *v1.Upstream_Consul, *v1.Upstream_AwsEc2, *v1.Upstream_DnsSrv:
+		return true
+	}
+	return false
+}
+
+func supportsFailover(upstream *v1.Upstream) bool {
+	return isEdsUpstream(upstream) || upstream.GetStatic() != nil
+}
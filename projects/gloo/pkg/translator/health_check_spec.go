@@ -0,0 +1,180 @@
+package translator
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/gogoutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+var (
+	DefaultHealthCheckSpecTimeout            = time.Second * 5
+	DefaultHealthCheckSpecInterval           = time.Second * 10
+	DefaultHealthCheckSpecUnhealthyThreshold = uint32(3)
+	DefaultHealthCheckSpecHealthyThreshold   = uint32(2)
+
+	MissingHealthCheckerErr = func(index int) error {
+		return eris.Errorf("health check spec %d must be an http, grpc or tcp health check", index)
+	}
+	InvalidHealthCheckDurationErr = func(index int, field string, duration time.Duration) error {
+		return eris.Errorf("the %v of health check spec %d must be positive, got %v", field, index, duration)
+	}
+	InvalidHealthCheckThresholdErr = func(index int, field string) error {
+		return eris.Errorf("the %v of health check spec %d must be at least 1", field, index)
+	}
+	InvalidHealthCheckPathErr = func(index int, path string) error {
+		return eris.Errorf("the path of http health check spec %d must start with '/', got %q", index, path)
+	}
+	InvalidHealthCheckStatusErr = func(index int, status uint32) error {
+		return eris.Errorf("the expected status %v of http health check spec %d is not between 100 and 599", status, index)
+	}
+	InvalidHealthCheckPayloadErr = func(index int, payload string) error {
+		return eris.Errorf("the payload %q of tcp health check spec %d is not hex encoded", payload, index)
+	}
+	GrpcHealthCheckWithoutHttp2Err = eris.New("grpc health checks require the upstream to use http2")
+)
+
+// converts the gloo health check specs of the upstream to envoy health checks, using defaults for unset fields
+func convertHealthCheckSpecs(specs []*v1.HealthCheckSpec) ([]*envoycore.HealthCheck, error) {
+	var result []*envoycore.HealthCheck
+	for i, spec := range specs {
+		healthCheck, err := convertHealthCheckSpec(i, spec)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, healthCheck)
+	}
+	return result, nil
+}
+
+func convertHealthCheckSpec(index int, spec *v1.HealthCheckSpec) (*envoycore.HealthCheck, error) {
+	timeout := DefaultHealthCheckSpecTimeout
+	if spec.GetTimeout() != nil {
+		if *spec.GetTimeout() <= 0 {
+			return nil, InvalidHealthCheckDurationErr(index, "timeout", *spec.GetTimeout())
+		}
+		timeout = *spec.GetTimeout()
+	}
+	interval := DefaultHealthCheckSpecInterval
+	if spec.GetInterval() != nil {
+		if *spec.GetInterval() <= 0 {
+			return nil, InvalidHealthCheckDurationErr(index, "interval", *spec.GetInterval())
+		}
+		interval = *spec.GetInterval()
+	}
+	unhealthyThreshold := DefaultHealthCheckSpecUnhealthyThreshold
+	if spec.GetUnhealthyThreshold() != nil {
+		if spec.GetUnhealthyThreshold().GetValue() == 0 {
+			return nil, InvalidHealthCheckThresholdErr(index, "unhealthy threshold")
+		}
+		unhealthyThreshold = spec.GetUnhealthyThreshold().GetValue()
+	}
+	healthyThreshold := DefaultHealthCheckSpecHealthyThreshold
+	if spec.GetHealthyThreshold() != nil {
+		if spec.GetHealthyThreshold().GetValue() == 0 {
+			return nil, InvalidHealthCheckThresholdErr(index, "healthy threshold")
+		}
+		healthyThreshold = spec.GetHealthyThreshold().GetValue()
+	}
+
+	healthCheck := &envoycore.HealthCheck{
+		Timeout:            gogoutils.DurationStdToProto(&timeout),
+		Interval:           gogoutils.DurationStdToProto(&interval),
+		UnhealthyThreshold: &wrappers.UInt32Value{Value: unhealthyThreshold},
+		HealthyThreshold:   &wrappers.UInt32Value{Value: healthyThreshold},
+	}
+
+	switch checker := spec.GetHealthChecker().(type) {
+	case *v1.HealthCheckSpec_Http:
+		httpHealthCheck, err := convertHttpHealthCheck(index, checker.Http)
+		if err != nil {
+			return nil, err
+		}
+		healthCheck.HealthChecker = &envoycore.HealthCheck_HttpHealthCheck_{
+			HttpHealthCheck: httpHealthCheck,
+		}
+	case *v1.HealthCheckSpec_Grpc:
+		healthCheck.HealthChecker = &envoycore.HealthCheck_GrpcHealthCheck_{
+			GrpcHealthCheck: &envoycore.HealthCheck_GrpcHealthCheck{
+				ServiceName: checker.Grpc.GetServiceName(),
+				Authority:   checker.Grpc.GetAuthority(),
+			},
+		}
+	case *v1.HealthCheckSpec_Tcp:
+		tcpHealthCheck, err := convertTcpHealthCheck(index, checker.Tcp)
+		if err != nil {
+			return nil, err
+		}
+		healthCheck.HealthChecker = &envoycore.HealthCheck_TcpHealthCheck_{
+			TcpHealthCheck: tcpHealthCheck,
+		}
+	default:
+		return nil, MissingHealthCheckerErr(index)
+	}
+	return healthCheck, nil
+}
+
+func convertHttpHealthCheck(index int, spec *v1.HealthCheckSpec_HttpHealthCheck) (*envoycore.HealthCheck_HttpHealthCheck, error) {
+	if !strings.HasPrefix(spec.GetPath(), "/") {
+		return nil, InvalidHealthCheckPathErr(index, spec.GetPath())
+	}
+	httpHealthCheck := &envoycore.HealthCheck_HttpHealthCheck{
+		Host: spec.GetHost(),
+		Path: spec.GetPath(),
+	}
+	for _, status := range spec.GetExpectedStatuses() {
+		if status < 100 || status > 599 {
+			return nil, InvalidHealthCheckStatusErr(index, status)
+		}
+		// envoy's ranges are half open
+		httpHealthCheck.ExpectedStatuses = append(httpHealthCheck.ExpectedStatuses, &envoytype.Int64Range{
+			Start: int64(status),
+			End:   int64(status) + 1,
+		})
+	}
+	if spec.GetUseHttp2() {
+		httpHealthCheck.CodecClientType = envoytype.CodecClientType_HTTP2
+	}
+	return httpHealthCheck, nil
+}
+
+func convertTcpHealthCheck(index int, spec *v1.HealthCheckSpec_TcpHealthCheck) (*envoycore.HealthCheck_TcpHealthCheck, error) {
+	tcpHealthCheck := &envoycore.HealthCheck_TcpHealthCheck{}
+	if spec.GetSend() != "" {
+		if _, err := hex.DecodeString(spec.GetSend()); err != nil {
+			return nil, InvalidHealthCheckPayloadErr(index, spec.GetSend())
+		}
+		tcpHealthCheck.Send = &envoycore.HealthCheck_Payload{
+			Payload: &envoycore.HealthCheck_Payload_Text{Text: spec.GetSend()},
+		}
+	}
+	for _, receive := range spec.GetReceive() {
+		if _, err := hex.DecodeString(receive); err != nil {
+			return nil, InvalidHealthCheckPayloadErr(index, receive)
+		}
+		tcpHealthCheck.Receive = append(tcpHealthCheck.Receive, &envoycore.HealthCheck_Payload{
+			Payload: &envoycore.HealthCheck_Payload_Text{Text: receive},
+		})
+	}
+	return tcpHealthCheck, nil
+}
+
+// envoy rejects grpc health checks of clusters that do not use http2, which is only known once the plugins ran
+func validateGrpcHealthCheckSpecs(upstream *v1.Upstream, out *envoyapi.Cluster) error {
+	if out.GetHttp2ProtocolOptions() != nil {
+		return nil
+	}
+	for _, spec := range upstream.GetHealthCheckSpecs() {
+		if spec.GetGrpc() != nil {
+			return GrpcHealthCheckWithoutHttp2Err
+		}
+	}
+	return nil
+}
@@ -83,6 +83,17 @@ func (t *translatorInstance) computeHttpFilters(params plugins.Params, listener
 
 	// sort filters by stage
 	envoyHttpFilters := sortFilters(httpFilters)
+
+	// the dynamic forward proxy filter resolves the host the router will forward to, so it runs right before the router
+	if dfpConfig := listener.GetOptions().GetDynamicForwardProxy(); dfpConfig != nil {
+		dfpFilter, err := dynamicForwardProxyHttpFilter(dfpConfig)
+		if err != nil {
+			validation.AppendHTTPListenerError(httpListenerReport, validationapi.HttpListenerReport_Error_ProcessingError, err.Error())
+		} else {
+			envoyHttpFilters = append(envoyHttpFilters, dfpFilter)
+		}
+	}
+
 	envoyHttpFilters = append(envoyHttpFilters, &envoyhttp.HttpFilter{Name: util.Router})
 	return envoyHttpFilters
 }
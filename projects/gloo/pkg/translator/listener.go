@@ -34,6 +34,7 @@ func (t *translatorInstance) computeListener(params plugins.Params, proxy *v1.Pr
 			return nil
 		}
 		filterChains = t.computeFilterChainsFromSslConfig(params.Snapshot, listener, listenerFilters, listenerReport)
+		reportVirtualHostCertificateExpiry(listener, params.Snapshot.Secrets, t.now(), listenerReport)
 	case *v1.Listener_TcpListener:
 		// run the tcp filter chain plugins
 		for _, plug := range t.plugins {
@@ -182,7 +182,7 @@ func initRoutes(params plugins.RouteParams, in *v1.Route, routeReport *validatio
 // utility function to transform gloo matcher to envoy route matcher
 func GlooMatcherToEnvoyMatcher(params plugins.Params, matcher *matchers.Matcher) envoyroute.RouteMatch {
 	match := envoyroute.RouteMatch{
-		Headers:         envoyHeaderMatcher(params, matcher.GetHeaders()),
+		Headers:         pluginutils.EnvoyHeaderMatchers(params.Ctx, matcher.GetHeaders()),
 		QueryParameters: envoyQueryMatcher(params, matcher.GetQueryParameters()),
 	}
 	if len(matcher.GetMethods()) > 0 {
@@ -226,6 +226,15 @@ func (t *translatorInstance) setAction(params plugins.RouteParams, routeReport *
 			}
 		}
 
+		if dfpPerRouteConfig := dynamicForwardProxyPerRouteConfig(action.RouteAction.GetDynamicForwardProxy()); dfpPerRouteConfig != nil {
+			if err := pluginutils.SetRoutePerFilterConfig(out, DynamicForwardProxyFilterName, dfpPerRouteConfig); err != nil {
+				validation.AppendRouteError(routeReport,
+					validationapi.RouteReport_Error_ProcessingError,
+					err.Error(),
+				)
+			}
+		}
+
 		// run the plugins for RoutePlugin
 		for _, plug := range t.plugins {
 			routePlugin, ok := plug.(plugins.RoutePlugin)
@@ -343,6 +352,15 @@ func (t *translatorInstance) setRouteAction(params plugins.RouteParams, in *v1.R
 			Destinations: upstreamGroup.Destinations,
 		}
 		return t.setWeightedClusters(params, md, out, routeReport)
+	case *v1.RouteAction_DynamicForwardProxy:
+		dfpConfig := params.Listener.GetHttpListener().GetOptions().GetDynamicForwardProxy()
+		if dfpConfig == nil {
+			return MissingDynamicForwardProxyErr(params.Listener.GetName())
+		}
+		out.ClusterSpecifier = &envoyroute.RouteAction_Cluster{
+			Cluster: DynamicForwardProxyClusterName(dfpConfig),
+		}
+		return nil
 	}
 	return errors.Errorf("unknown upstream destination type")
 }
@@ -492,38 +510,6 @@ func setEnvoyPathMatcher(params plugins.Params, in *matchers.Matcher, out *envoy
 	}
 }
 
-func envoyHeaderMatcher(params plugins.Params, in []*matchers.HeaderMatcher) []*envoyroute.HeaderMatcher {
-	var out []*envoyroute.HeaderMatcher
-	for _, matcher := range in {
-
-		envoyMatch := &envoyroute.HeaderMatcher{
-			Name: matcher.Name,
-		}
-		if matcher.Value == "" {
-			envoyMatch.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_PresentMatch{
-				PresentMatch: true,
-			}
-		} else {
-			if matcher.Regex {
-				envoyMatch.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_SafeRegexMatch{
-					SafeRegexMatch: regexutils.NewRegex(params.Ctx, matcher.Value),
-				}
-			} else {
-				envoyMatch.HeaderMatchSpecifier = &envoyroute.HeaderMatcher_ExactMatch{
-					ExactMatch: matcher.Value,
-				}
-			}
-		}
-
-		if matcher.InvertMatch {
-			envoyMatch.InvertMatch = true
-		}
-
-		out = append(out, envoyMatch)
-	}
-	return out
-}
-
 func envoyQueryMatcher(params plugins.Params, in []*matchers.QueryParameterMatcher) []*envoyroute.QueryParameterMatcher {
 	var out []*envoyroute.QueryParameterMatcher
 	for _, matcher := range in {
@@ -615,8 +601,11 @@ func ValidateRouteDestinations(snap *v1.ApiSnapshot, action *v1.RouteAction) err
 		return validateMultiDestination(upstreams, dest.Multi.Destinations)
 	case *v1.RouteAction_UpstreamGroup:
 		return validateUpstreamGroup(snap, dest.UpstreamGroup)
+	case *v1.RouteAction_DynamicForwardProxy:
+		// hosts are resolved at request time, there are no upstreams to validate
+		return nil
 	}
-	return errors.Errorf("must specify either 'singleDestination', 'multipleDestinations' or 'upstreamGroup' for action")
+	return errors.Errorf("must specify either 'singleDestination', 'multipleDestinations', 'upstreamGroup' or 'dynamicForwardProxy' for action")
 }
 
 func validateUpstreamGroup(snap *v1.ApiSnapshot, ref *core.ResourceRef) error {
@@ -0,0 +1,133 @@
+package translator
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+const (
+	// the weight of endpoints of upstreams with a slow start window, once they are warmed up
+	SlowStartFullWeight = 100
+	// the number of steps in which the weight of a new endpoint is ramped up
+	slowStartSteps = 10
+)
+
+// SlowStartScheduler is implemented by translators that emulate slow start. The weights of the endpoints that
+// are warming up change over time, so the snapshot has to be translated again at the returned time even if it
+// does not change. Returns the zero time if no endpoint is warming up.
+type SlowStartScheduler interface {
+	NextSlowStartUpdate() time.Time
+}
+
+// remembers when each endpoint was first discovered, so that the weights of new endpoints can be ramped up
+// over the slow start window of their upstream. envoy does not support slow start natively, so the weights are
+// lowered in the load assignments instead, and recomputed on each translation.
+type slowStartTracker struct {
+	lock       sync.Mutex
+	now        func() time.Time
+	firstSeen  map[string]time.Time
+	nextUpdate time.Time
+	// the endpoints of the first translation were discovered before gloo started, and are not warmed up
+	initialized bool
+}
+
+func newSlowStartTracker(now func() time.Time) *slowStartTracker {
+	return &slowStartTracker{
+		now:       now,
+		firstSeen: map[string]time.Time{},
+	}
+}
+
+func (s *slowStartTracker) NextSlowStartUpdate() time.Time {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.nextUpdate
+}
+
+// lowers the weights of the endpoints of the load assignments that are warming up. endpoints that are not part
+// of the load assignments are forgotten, so that they warm up again if they come back.
+func (s *slowStartTracker) applyWeights(upstreams v1.UpstreamList, loadAssignments []*envoyapi.ClusterLoadAssignment) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	windows := map[string]time.Duration{}
+	for _, upstream := range upstreams {
+		if window := upstream.GetLoadBalancerConfig().GetSlowStartWindow(); window != nil && *window > 0 {
+			windows[UpstreamToClusterName(upstream.Metadata.Ref())] = *window
+		}
+	}
+
+	now := s.now()
+	seen := map[string]bool{}
+	var nextUpdate time.Time
+	for _, loadAssignment := range loadAssignments {
+		window, slowStart := windows[loadAssignment.GetClusterName()]
+		for _, locality := range loadAssignment.GetEndpoints() {
+			for _, lbEndpoint := range locality.GetLbEndpoints() {
+				socketAddress := lbEndpoint.GetEndpoint().GetAddress().GetSocketAddress()
+				key := fmt.Sprintf("%v/%v:%v", loadAssignment.GetClusterName(), socketAddress.GetAddress(), socketAddress.GetPortValue())
+				seen[key] = true
+				firstSeen, ok := s.firstSeen[key]
+				if !ok {
+					firstSeen = now
+					if !s.initialized {
+						firstSeen = time.Time{}
+					}
+					s.firstSeen[key] = firstSeen
+				}
+				if !slowStart {
+					continue
+				}
+				weight, update := slowStartWeight(now.Sub(firstSeen), window)
+				// endpoints with a weight of their own are ramped up to that weight
+				if endpointWeight := lbEndpoint.GetLoadBalancingWeight().GetValue(); endpointWeight > 0 {
+					weight = scaleSlowStartWeight(weight, endpointWeight)
+				}
+				lbEndpoint.LoadBalancingWeight = &wrappers.UInt32Value{Value: weight}
+				if update > 0 && (nextUpdate.IsZero() || now.Add(update).Before(nextUpdate)) {
+					nextUpdate = now.Add(update)
+				}
+			}
+		}
+	}
+
+	for key := range s.firstSeen {
+		if !seen[key] {
+			delete(s.firstSeen, key)
+		}
+	}
+	s.initialized = true
+	s.nextUpdate = nextUpdate
+}
+
+// returns the weight of an endpoint discovered the given time ago, and how long until its weight changes
+// (0 once it is warmed up). the weight is ramped up in steps, to bound the number of translations.
+func slowStartWeight(age, window time.Duration) (uint32, time.Duration) {
+	if age >= window {
+		return SlowStartFullWeight, 0
+	}
+	stepDuration := window / slowStartSteps
+	if stepDuration <= 0 {
+		return SlowStartFullWeight, 0
+	}
+	step := int64(age/stepDuration) + 1
+	if step >= slowStartSteps {
+		return SlowStartFullWeight, 0
+	}
+	return uint32(SlowStartFullWeight * step / slowStartSteps), time.Duration(step)*stepDuration - age
+}
+
+// scales a slow start weight to the weight of the endpoint, keeping it above 0 as envoy rejects endpoints
+// without weight
+func scaleSlowStartWeight(weight, endpointWeight uint32) uint32 {
+	scaled := uint64(weight) * uint64(endpointWeight) / SlowStartFullWeight
+	if scaled == 0 {
+		return 1
+	}
+	return uint32(scaled)
+}
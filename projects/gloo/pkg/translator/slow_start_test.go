@@ -0,0 +1,124 @@
+package translator
+
+import (
+	"time"
+
+	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyendpoints "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Slow start", func() {
+	var (
+		now      time.Time
+		tracker  *slowStartTracker
+		upstream *v1.Upstream
+	)
+
+	BeforeEach(func() {
+		now = time.Unix(1000, 0)
+		tracker = newSlowStartTracker(func() time.Time { return now })
+		window := 100 * time.Second
+		upstream = &v1.Upstream{
+			Metadata: core.Metadata{Name: "us", Namespace: "gloo-system"},
+			LoadBalancerConfig: &v1.LoadBalancerConfig{
+				SlowStartWindow: &window,
+			},
+		}
+	})
+
+	loadAssignment := func(addresses ...string) *envoyapi.ClusterLoadAssignment {
+		var lbEndpoints []*envoyendpoints.LbEndpoint
+		for _, address := range addresses {
+			lbEndpoints = append(lbEndpoints, &envoyendpoints.LbEndpoint{
+				HostIdentifier: &envoyendpoints.LbEndpoint_Endpoint{
+					Endpoint: &envoyendpoints.Endpoint{
+						Address: &envoycore.Address{
+							Address: &envoycore.Address_SocketAddress{
+								SocketAddress: &envoycore.SocketAddress{
+									Address:       address,
+									PortSpecifier: &envoycore.SocketAddress_PortValue{PortValue: 80},
+								},
+							},
+						},
+					},
+				},
+			})
+		}
+		return &envoyapi.ClusterLoadAssignment{
+			ClusterName: UpstreamToClusterName(upstream.Metadata.Ref()),
+			Endpoints:   []*envoyendpoints.LocalityLbEndpoints{{LbEndpoints: lbEndpoints}},
+		}
+	}
+
+	weights := func(cla *envoyapi.ClusterLoadAssignment) []uint32 {
+		var weights []uint32
+		for _, lbEndpoint := range cla.Endpoints[0].LbEndpoints {
+			weights = append(weights, lbEndpoint.GetLoadBalancingWeight().GetValue())
+		}
+		return weights
+	}
+
+	It("ramps up the weight of new endpoints in steps", func() {
+		tracker.applyWeights(v1.UpstreamList{upstream}, []*envoyapi.ClusterLoadAssignment{loadAssignment("1.1.1.1")})
+
+		now = now.Add(time.Second)
+		cla := loadAssignment("1.1.1.1", "2.2.2.2")
+		tracker.applyWeights(v1.UpstreamList{upstream}, []*envoyapi.ClusterLoadAssignment{cla})
+		Expect(weights(cla)).To(Equal([]uint32{100, 10}))
+		Expect(tracker.NextSlowStartUpdate()).To(Equal(now.Add(10 * time.Second)))
+
+		now = now.Add(45 * time.Second)
+		cla = loadAssignment("1.1.1.1", "2.2.2.2")
+		tracker.applyWeights(v1.UpstreamList{upstream}, []*envoyapi.ClusterLoadAssignment{cla})
+		Expect(weights(cla)).To(Equal([]uint32{100, 50}))
+		Expect(tracker.NextSlowStartUpdate()).To(Equal(now.Add(5 * time.Second)))
+
+		now = now.Add(45 * time.Second)
+		cla = loadAssignment("1.1.1.1", "2.2.2.2")
+		tracker.applyWeights(v1.UpstreamList{upstream}, []*envoyapi.ClusterLoadAssignment{cla})
+		Expect(weights(cla)).To(Equal([]uint32{100, 100}))
+		Expect(tracker.NextSlowStartUpdate().IsZero()).To(BeTrue())
+	})
+
+	It("forgets endpoints that are removed", func() {
+		tracker.applyWeights(v1.UpstreamList{upstream}, []*envoyapi.ClusterLoadAssignment{loadAssignment("1.1.1.1", "2.2.2.2")})
+		tracker.applyWeights(v1.UpstreamList{upstream}, []*envoyapi.ClusterLoadAssignment{loadAssignment("1.1.1.1")})
+
+		cla := loadAssignment("1.1.1.1", "2.2.2.2")
+		tracker.applyWeights(v1.UpstreamList{upstream}, []*envoyapi.ClusterLoadAssignment{cla})
+		Expect(weights(cla)).To(Equal([]uint32{100, 10}))
+	})
+
+	It("ramps up endpoints to their own weight", func() {
+		tracker.applyWeights(v1.UpstreamList{upstream}, nil)
+
+		now = now.Add(time.Second)
+		cla := loadAssignment("1.1.1.1", "2.2.2.2")
+		cla.Endpoints[0].LbEndpoints[0].LoadBalancingWeight = &wrappers.UInt32Value{Value: 20}
+		cla.Endpoints[0].LbEndpoints[1].LoadBalancingWeight = &wrappers.UInt32Value{Value: 5}
+		tracker.applyWeights(v1.UpstreamList{upstream}, []*envoyapi.ClusterLoadAssignment{cla})
+		Expect(weights(cla)).To(Equal([]uint32{2, 1}))
+
+		now = now.Add(100 * time.Second)
+		cla = loadAssignment("1.1.1.1", "2.2.2.2")
+		cla.Endpoints[0].LbEndpoints[0].LoadBalancingWeight = &wrappers.UInt32Value{Value: 20}
+		cla.Endpoints[0].LbEndpoints[1].LoadBalancingWeight = &wrappers.UInt32Value{Value: 5}
+		tracker.applyWeights(v1.UpstreamList{upstream}, []*envoyapi.ClusterLoadAssignment{cla})
+		Expect(weights(cla)).To(Equal([]uint32{20, 5}))
+	})
+
+	It("does not set weights for upstreams without a slow start window", func() {
+		upstream.LoadBalancerConfig = nil
+		tracker.applyWeights(v1.UpstreamList{upstream}, nil)
+
+		cla := loadAssignment("1.1.1.1")
+		tracker.applyWeights(v1.UpstreamList{upstream}, []*envoyapi.ClusterLoadAssignment{cla})
+		Expect(weights(cla)).To(Equal([]uint32{0}))
+	})
+})
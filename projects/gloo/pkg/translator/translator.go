@@ -2,6 +2,7 @@ package translator
 
 import (
 	"fmt"
+	"time"
 
 	validationapi "github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
@@ -24,10 +25,18 @@ type Translator interface {
 }
 
 func NewTranslator(sslConfigTranslator utils.SslConfigTranslator, settings *v1.Settings, getPlugins func() []plugins.Plugin) Translator {
+	return NewTranslatorWithClock(sslConfigTranslator, settings, getPlugins, time.Now)
+}
+
+// NewTranslatorWithClock returns a translator that reads the current time from the given clock, e.g. to decide
+// whether certificates expire soon or to ramp up the weights of new endpoints.
+func NewTranslatorWithClock(sslConfigTranslator utils.SslConfigTranslator, settings *v1.Settings, getPlugins func() []plugins.Plugin, now func() time.Time) Translator {
 	return &translatorFactory{
 		getPlugins:          getPlugins,
 		settings:            settings,
 		sslConfigTranslator: sslConfigTranslator,
+		now:                 now,
+		slowStart:           newSlowStartTracker(now),
 	}
 }
 
@@ -35,6 +44,15 @@ type translatorFactory struct {
 	getPlugins          func() []plugins.Plugin
 	settings            *v1.Settings
 	sslConfigTranslator utils.SslConfigTranslator
+	now                 func() time.Time
+	// shared between translations, to know when endpoints were discovered
+	slowStart *slowStartTracker
+}
+
+var _ SlowStartScheduler = new(translatorFactory)
+
+func (t *translatorFactory) NextSlowStartUpdate() time.Time {
+	return t.slowStart.NextSlowStartUpdate()
 }
 
 func (t *translatorFactory) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validationapi.ProxyReport, error) {
@@ -42,6 +60,8 @@ func (t *translatorFactory) Translate(params plugins.Params, proxy *v1.Proxy) (e
 		plugins:             t.getPlugins(),
 		settings:            t.settings,
 		sslConfigTranslator: t.sslConfigTranslator,
+		now:                 t.now,
+		slowStart:           t.slowStart,
 	}
 	return instance.Translate(params, proxy)
 }
@@ -51,6 +71,8 @@ type translatorInstance struct {
 	plugins             []plugins.Plugin
 	settings            *v1.Settings
 	sslConfigTranslator utils.SslConfigTranslator
+	now                 func() time.Time
+	slowStart           *slowStartTracker
 }
 
 func (t *translatorInstance) Translate(params plugins.Params, proxy *v1.Proxy) (envoycache.Snapshot, reporter.ResourceReports, *validationapi.ProxyReport, error) {
@@ -77,10 +99,11 @@ func (t *translatorInstance) Translate(params plugins.Params, proxy *v1.Proxy) (
 
 	// endpoints and listeners are shared between listeners
 	logger.Debugf("computing envoy clusters for proxy: %v", proxy.Metadata.Name)
-	clusters := t.computeClusters(params, reports)
+	clusters := t.computeClusters(params, proxy, reports)
 	logger.Debugf("computing envoy endpoints for proxy: %v", proxy.Metadata.Name)
 
 	endpoints := computeClusterEndpoints(params.Ctx, params.Snapshot.Upstreams, params.Snapshot.Endpoints)
+	t.slowStart.applyWeights(params.Snapshot.Upstreams, endpoints)
 
 	// Find all the EDS clusters without endpoints (can happen with kube service that have no endpoints), and create a zero sized load assignment
 	// this is important as otherwise envoy will wait for them forever wondering their fate and not doing much else.
@@ -3,15 +3,22 @@ package translator_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoyendpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
 	envoylistener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	envoyrouteapi "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoydfpcluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/dynamic_forward_proxy/v2alpha"
+	envoydfpcommon "github.com/envoyproxy/go-control-plane/envoy/config/common/dynamic_forward_proxy/v2alpha"
+	envoydfpfilter "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/dynamic_forward_proxy/v2alpha"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/tcp_proxy/v2"
 	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
+	"github.com/envoyproxy/go-control-plane/pkg/conversion"
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/duration"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -21,8 +28,10 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/grpc/validation"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	extauth "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/dynamic_forward_proxy"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/headers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
 	mock_consul "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
 	validationutils "github.com/solo-io/gloo/projects/gloo/pkg/utils/validation"
 
@@ -33,6 +42,7 @@ import (
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/kubernetes"
 	sslutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	gloohelpers "github.com/solo-io/gloo/test/helpers"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
 	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
@@ -43,6 +53,7 @@ import (
 
 	envoyapi "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
 	. "github.com/solo-io/gloo/projects/gloo/pkg/translator"
@@ -606,6 +617,124 @@ var _ = Describe("Translator", func() {
 			Expect(cluster.HealthChecks).To(BeEquivalentTo(expectedResult))
 		})
 
+		It("can translate health check specs with defaults", func() {
+			upstream.HealthCheckSpecs = []*v1.HealthCheckSpec{
+				{
+					HealthChecker: &v1.HealthCheckSpec_Http{
+						Http: &v1.HealthCheckSpec_HttpHealthCheck{
+							Path:             "/healthz",
+							Host:             "host",
+							ExpectedStatuses: []uint32{200, 204},
+							UseHttp2:         true,
+						},
+					},
+				},
+				{
+					UnhealthyThreshold: &types.UInt32Value{Value: 5},
+					HealthChecker: &v1.HealthCheckSpec_Tcp{
+						Tcp: &v1.HealthCheckSpec_TcpHealthCheck{
+							Send:    "000000ff",
+							Receive: []string{"01"},
+						},
+					},
+				},
+			}
+			translate()
+
+			timeout := gogoutils.DurationStdToProto(&DefaultHealthCheckSpecTimeout)
+			interval := gogoutils.DurationStdToProto(&DefaultHealthCheckSpecInterval)
+			Expect(cluster.HealthChecks).To(Equal([]*envoycore.HealthCheck{
+				{
+					Timeout:            timeout,
+					Interval:           interval,
+					UnhealthyThreshold: &wrappers.UInt32Value{Value: 3},
+					HealthyThreshold:   &wrappers.UInt32Value{Value: 2},
+					HealthChecker: &envoycore.HealthCheck_HttpHealthCheck_{
+						HttpHealthCheck: &envoycore.HealthCheck_HttpHealthCheck{
+							Host: "host",
+							Path: "/healthz",
+							ExpectedStatuses: []*envoy_type.Int64Range{
+								{Start: 200, End: 201},
+								{Start: 204, End: 205},
+							},
+							CodecClientType: envoy_type.CodecClientType_HTTP2,
+						},
+					},
+				},
+				{
+					Timeout:            timeout,
+					Interval:           interval,
+					UnhealthyThreshold: &wrappers.UInt32Value{Value: 5},
+					HealthyThreshold:   &wrappers.UInt32Value{Value: 2},
+					HealthChecker: &envoycore.HealthCheck_TcpHealthCheck_{
+						TcpHealthCheck: &envoycore.HealthCheck_TcpHealthCheck{
+							Send: &envoycore.HealthCheck_Payload{
+								Payload: &envoycore.HealthCheck_Payload_Text{Text: "000000ff"},
+							},
+							Receive: []*envoycore.HealthCheck_Payload{
+								{Payload: &envoycore.HealthCheck_Payload_Text{Text: "01"}},
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("can translate grpc health check specs of http2 upstreams", func() {
+			upstream.UseHttp2 = true
+			upstream.HealthCheckSpecs = []*v1.HealthCheckSpec{
+				{
+					HealthChecker: &v1.HealthCheckSpec_Grpc{
+						Grpc: &v1.HealthCheckSpec_GrpcHealthCheck{
+							ServiceName: "svc",
+						},
+					},
+				},
+			}
+			translate()
+
+			Expect(cluster.HealthChecks).To(HaveLen(1))
+			Expect(cluster.HealthChecks[0].GetGrpcHealthCheck().GetServiceName()).To(Equal("svc"))
+		})
+
+		It("reports grpc health check specs of upstreams without http2", func() {
+			upstream.HealthCheckSpecs = []*v1.HealthCheckSpec{
+				{
+					HealthChecker: &v1.HealthCheckSpec_Grpc{
+						Grpc: &v1.HealthCheckSpec_GrpcHealthCheck{},
+					},
+				},
+			}
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs.Validate()).To(MatchError(ContainSubstring(GrpcHealthCheckWithoutHttp2Err.Error())))
+		})
+
+		DescribeTable("reports invalid health check specs",
+			func(spec *v1.HealthCheckSpec, expectedErr error) {
+				upstream.HealthCheckSpecs = []*v1.HealthCheckSpec{spec}
+
+				_, errs, _, err := translator.Translate(params, proxy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(errs.Validate()).To(MatchError(ContainSubstring(expectedErr.Error())))
+			},
+			Entry("missing checker", &v1.HealthCheckSpec{}, MissingHealthCheckerErr(0)),
+			Entry("relative path", &v1.HealthCheckSpec{
+				HealthChecker: &v1.HealthCheckSpec_Http{Http: &v1.HealthCheckSpec_HttpHealthCheck{Path: "healthz"}},
+			}, InvalidHealthCheckPathErr(0, "healthz")),
+			Entry("invalid status", &v1.HealthCheckSpec{
+				HealthChecker: &v1.HealthCheckSpec_Http{Http: &v1.HealthCheckSpec_HttpHealthCheck{Path: "/", ExpectedStatuses: []uint32{700}}},
+			}, InvalidHealthCheckStatusErr(0, 700)),
+			Entry("invalid payload", &v1.HealthCheckSpec{
+				HealthChecker: &v1.HealthCheckSpec_Tcp{Tcp: &v1.HealthCheckSpec_TcpHealthCheck{Send: "xyz"}},
+			}, InvalidHealthCheckPayloadErr(0, "xyz")),
+			Entry("zero threshold", &v1.HealthCheckSpec{
+				HealthyThreshold: &types.UInt32Value{},
+				HealthChecker:    &v1.HealthCheckSpec_Tcp{Tcp: &v1.HealthCheckSpec_TcpHealthCheck{}},
+			}, InvalidHealthCheckThresholdErr(0, "healthy threshold")),
+		)
+
 		It("can properly translate outlier detection config", func() {
 			dur := &duration.Duration{Seconds: 1}
 			expectedResult := &envoycluster.OutlierDetection{
@@ -726,6 +855,57 @@ var _ = Describe("Translator", func() {
 
 			Expect(cluster.CircuitBreakers).To(BeEquivalentTo(expectedCircuitBreakers))
 		})
+
+		It("should translate high priority thresholds, retry budgets and remaining resource tracking", func() {
+
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				MaxRequests: &types.UInt32Value{Value: 3},
+				RetryBudget: &v1.CircuitBreakerConfig_RetryBudget{
+					BudgetPercent:       &types.DoubleValue{Value: 25},
+					MinRetryConcurrency: &types.UInt32Value{Value: 5},
+				},
+				TrackRemaining: true,
+				HighPriority: &v1.CircuitBreakerConfig_Thresholds{
+					MaxRequests: &types.UInt32Value{Value: 30},
+					MaxRetries:  &types.UInt32Value{Value: 10},
+				},
+			}
+
+			expectedCircuitBreakers := &envoycluster.CircuitBreakers{
+				Thresholds: []*envoycluster.CircuitBreakers_Thresholds{
+					{
+						Priority:    envoycore.RoutingPriority_DEFAULT,
+						MaxRequests: &wrappers.UInt32Value{Value: 3},
+						RetryBudget: &envoycluster.CircuitBreakers_Thresholds_RetryBudget{
+							BudgetPercent:       &envoy_type.Percent{Value: 25},
+							MinRetryConcurrency: &wrappers.UInt32Value{Value: 5},
+						},
+						TrackRemaining: true,
+					},
+					{
+						Priority:    envoycore.RoutingPriority_HIGH,
+						MaxRequests: &wrappers.UInt32Value{Value: 30},
+						MaxRetries:  &wrappers.UInt32Value{Value: 10},
+					},
+				},
+			}
+			translate()
+
+			Expect(cluster.CircuitBreakers).To(BeEquivalentTo(expectedCircuitBreakers))
+		})
+
+		It("should report invalid retry budget percentages", func() {
+
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				RetryBudget: &v1.CircuitBreakerConfig_RetryBudget{
+					BudgetPercent: &types.DoubleValue{Value: 150},
+				},
+			}
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs.Validate()).To(MatchError(ContainSubstring(InvalidRetryBudgetPercentErr(150).Error())))
+		})
 	})
 
 	Context("eds", func() {
@@ -1003,6 +1183,302 @@ var _ = Describe("Translator", func() {
 		})
 	})
 
+	Context("failover", func() {
+		var (
+			secondary *v1.Upstream
+			tertiary  *v1.Upstream
+		)
+
+		BeforeEach(func() {
+			upstream.UpstreamType = &v1.Upstream_Kube{
+				Kube: &v1kubernetes.UpstreamSpec{},
+			}
+			secondary = &v1.Upstream{
+				Metadata: core.Metadata{Name: "secondary", Namespace: "gloo-system"},
+				UpstreamType: &v1.Upstream_Kube{
+					Kube: &v1kubernetes.UpstreamSpec{},
+				},
+			}
+			tertiary = &v1.Upstream{
+				Metadata: core.Metadata{Name: "tertiary", Namespace: "gloo-system"},
+				UpstreamType: &v1.Upstream_Static{
+					Static: &v1static.UpstreamSpec{
+						Hosts: []*v1static.Host{{Addr: "5.6.7.8", Port: 80}},
+					},
+				},
+			}
+			upstream.Failover = &v1.Failover{
+				PrioritizedUpstreams: []*core.ResourceRef{
+					utils.ResourceRefPtr(secondary.Metadata.Ref()),
+					utils.ResourceRefPtr(tertiary.Metadata.Ref()),
+				},
+			}
+			params.Snapshot.Upstreams = v1.UpstreamList{upstream, secondary, tertiary}
+			params.Snapshot.Endpoints = append(params.Snapshot.Endpoints, &v1.Endpoint{
+				Upstreams: []*core.ResourceRef{utils.ResourceRefPtr(secondary.Metadata.Ref())},
+				Address:   "2.3.4.5",
+				Port:      33,
+				Metadata: core.Metadata{
+					Name:      "secondary-ep",
+					Namespace: "gloo-system",
+				},
+			})
+		})
+
+		address := func(lbEndpoint *envoyendpoint.LbEndpoint) string {
+			return lbEndpoint.GetEndpoint().GetAddress().GetSocketAddress().GetAddress()
+		}
+
+		loadAssignment := func() *envoyapi.ClusterLoadAssignment {
+			clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
+			Expect(endpoints.Items).To(HaveKey(clusterName))
+			return endpoints.Items[clusterName].ResourceProto().(*envoyapi.ClusterLoadAssignment)
+		}
+
+		It("adds the endpoints of the failover upstreams at increasing priorities", func() {
+			translate()
+
+			cla := loadAssignment()
+			Expect(cla.Endpoints).To(HaveLen(3))
+			for i, locality := range cla.Endpoints {
+				Expect(locality.Priority).To(Equal(uint32(i)))
+				Expect(locality.LbEndpoints).To(HaveLen(1))
+			}
+			Expect(address(cla.Endpoints[0].LbEndpoints[0])).To(Equal("1.2.3.4"))
+			Expect(address(cla.Endpoints[1].LbEndpoints[0])).To(Equal("2.3.4.5"))
+			Expect(address(cla.Endpoints[2].LbEndpoints[0])).To(Equal("5.6.7.8"))
+		})
+
+		It("fails over when the upstream has no endpoints", func() {
+			params.Snapshot.Endpoints = params.Snapshot.Endpoints[1:]
+			translate()
+
+			cla := loadAssignment()
+			Expect(cla.Endpoints).To(HaveLen(3))
+			Expect(cla.Endpoints[0].Priority).To(Equal(uint32(0)))
+			Expect(cla.Endpoints[0].LbEndpoints).To(BeEmpty())
+			Expect(address(cla.Endpoints[1].LbEndpoints[0])).To(Equal("2.3.4.5"))
+		})
+
+		It("adds the endpoints of the failover upstreams to the load assignment of static upstreams", func() {
+			upstream.UpstreamType = &v1.Upstream_Static{
+				Static: &v1static.UpstreamSpec{
+					Hosts: []*v1static.Host{{Addr: "1.1.1.1", Port: 80}},
+				},
+			}
+			params.Snapshot.Endpoints = params.Snapshot.Endpoints[1:]
+			translate()
+
+			Expect(cluster.GetType()).To(Equal(envoyapi.Cluster_STATIC))
+			Expect(endpoints.Items).NotTo(HaveKey(UpstreamToClusterName(upstream.Metadata.Ref())))
+			localities := cluster.GetLoadAssignment().GetEndpoints()
+			Expect(localities).To(HaveLen(3))
+			Expect(address(localities[0].LbEndpoints[0])).To(Equal("1.1.1.1"))
+			Expect(localities[1].Priority).To(Equal(uint32(1)))
+			Expect(address(localities[1].LbEndpoints[0])).To(Equal("2.3.4.5"))
+			Expect(localities[2].Priority).To(Equal(uint32(2)))
+			Expect(address(localities[2].LbEndpoints[0])).To(Equal("5.6.7.8"))
+		})
+
+		Context("with locality weighted load balancing", func() {
+			BeforeEach(func() {
+				upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+					LocalityConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig_{
+						LocalityWeightedLbConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig{},
+					},
+				}
+				tertiary.GetStatic().Hosts = append(tertiary.GetStatic().Hosts, &v1static.Host{Addr: "6.7.8.9", Port: 80})
+			})
+
+			It("weighs the localities of every priority", func() {
+				translate()
+
+				cla := loadAssignment()
+				Expect(cla.Endpoints).To(HaveLen(3))
+				Expect(cla.Endpoints[0].LoadBalancingWeight.GetValue()).To(Equal(uint32(1)))
+				Expect(cla.Endpoints[1].LoadBalancingWeight.GetValue()).To(Equal(uint32(1)))
+				Expect(cla.Endpoints[2].LoadBalancingWeight.GetValue()).To(Equal(uint32(2)))
+			})
+
+			It("weighs the localities of static upstreams", func() {
+				upstream.UpstreamType = &v1.Upstream_Static{
+					Static: &v1static.UpstreamSpec{
+						Hosts: []*v1static.Host{{Addr: "1.1.1.1", Port: 80}},
+					},
+				}
+				params.Snapshot.Endpoints = params.Snapshot.Endpoints[1:]
+				translate()
+
+				localities := cluster.GetLoadAssignment().GetEndpoints()
+				Expect(localities).To(HaveLen(3))
+				Expect(localities[0].LoadBalancingWeight.GetValue()).To(Equal(uint32(1)))
+				Expect(localities[1].LoadBalancingWeight.GetValue()).To(Equal(uint32(1)))
+				Expect(localities[2].LoadBalancingWeight.GetValue()).To(Equal(uint32(2)))
+			})
+		})
+
+		It("reports missing failover upstreams", func() {
+			missing := core.ResourceRef{Name: "missing", Namespace: "gloo-system"}
+			upstream.Failover.PrioritizedUpstreams = append(upstream.Failover.PrioritizedUpstreams, &missing)
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs.Validate()).To(MatchError(ContainSubstring(FailoverUpstreamNotFoundErr(missing).Error())))
+		})
+
+		It("reports static failover upstreams with hostnames", func() {
+			tertiary.GetStatic().Hosts[0].Addr = "example.com"
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs.Validate()).To(MatchError(ContainSubstring(FailoverStaticHostNotIpErr(tertiary.Metadata.Ref(), "example.com").Error())))
+		})
+
+		It("reports upstreams that fail over to themselves", func() {
+			upstream.Failover.PrioritizedUpstreams = []*core.ResourceRef{utils.ResourceRefPtr(upstream.Metadata.Ref())}
+
+			_, errs, _, err := translator.Translate(params, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errs.Validate()).To(MatchError(ContainSubstring(FailoverSelfReferenceErr.Error())))
+		})
+	})
+
+	Context("locality", func() {
+		BeforeEach(func() {
+			upstream.UpstreamType = &v1.Upstream_Kube{
+				Kube: &v1kubernetes.UpstreamSpec{},
+			}
+			ref := upstream.Metadata.Ref()
+			newEndpoint := func(name, address string, locality *v1.Locality) *v1.Endpoint {
+				return &v1.Endpoint{
+					Upstreams: []*core.ResourceRef{&ref},
+					Address:   address,
+					Port:      80,
+					Locality:  locality,
+					Metadata:  core.Metadata{Name: name, Namespace: "gloo-system"},
+				}
+			}
+			zoneB := &v1.Locality{Region: "us-east-1", Zone: "us-east-1b"}
+			params.Snapshot.Endpoints = v1.EndpointList{
+				newEndpoint("ep-1", "1.1.1.1", zoneB),
+				newEndpoint("ep-2", "2.2.2.2", &v1.Locality{Region: "us-east-1", Zone: "us-east-1a"}),
+				newEndpoint("ep-3", "3.3.3.3", zoneB),
+			}
+		})
+
+		loadAssignment := func() *envoyapi.ClusterLoadAssignment {
+			clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
+			Expect(endpoints.Items).To(HaveKey(clusterName))
+			return endpoints.Items[clusterName].ResourceProto().(*envoyapi.ClusterLoadAssignment)
+		}
+
+		It("groups the endpoints by locality", func() {
+			translate()
+
+			cla := loadAssignment()
+			Expect(cla.Endpoints).To(HaveLen(2))
+			Expect(cla.Endpoints[0].Locality).To(Equal(&envoycore.Locality{Region: "us-east-1", Zone: "us-east-1a"}))
+			Expect(cla.Endpoints[0].LbEndpoints).To(HaveLen(1))
+			Expect(cla.Endpoints[0].LoadBalancingWeight).To(BeNil())
+			Expect(cla.Endpoints[1].Locality).To(Equal(&envoycore.Locality{Region: "us-east-1", Zone: "us-east-1b"}))
+			Expect(cla.Endpoints[1].LbEndpoints).To(HaveLen(2))
+		})
+
+		It("weighs the localities by their number of endpoints with locality weighted load balancing", func() {
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				LocalityConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig_{
+					LocalityWeightedLbConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig{},
+				},
+			}
+			translate()
+
+			cla := loadAssignment()
+			Expect(cla.Endpoints).To(HaveLen(2))
+			Expect(cla.Endpoints[0].LoadBalancingWeight.GetValue()).To(Equal(uint32(1)))
+			Expect(cla.Endpoints[1].LoadBalancingWeight.GetValue()).To(Equal(uint32(2)))
+			Expect(cluster.GetCommonLbConfig().GetLocalityWeightedLbConfig()).NotTo(BeNil())
+		})
+
+		It("weighs the localities of static upstreams without failover", func() {
+			upstream.UpstreamType = &v1.Upstream_Static{
+				Static: &v1static.UpstreamSpec{
+					Hosts: []*v1static.Host{{Addr: "1.1.1.1", Port: 80}, {Addr: "2.2.2.2", Port: 80}},
+				},
+			}
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				LocalityConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig_{
+					LocalityWeightedLbConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig{},
+				},
+			}
+			translate()
+
+			Expect(cluster.GetType()).To(Equal(envoyapi.Cluster_STATIC))
+			Expect(cluster.GetCommonLbConfig().GetLocalityWeightedLbConfig()).NotTo(BeNil())
+			localities := cluster.GetLoadAssignment().GetEndpoints()
+			Expect(localities).To(HaveLen(1))
+			Expect(localities[0].LbEndpoints).To(HaveLen(2))
+			Expect(localities[0].LoadBalancingWeight.GetValue()).To(Equal(uint32(2)))
+		})
+
+		It("sets the weights of the endpoints and sums them up in the locality weights", func() {
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				LocalityConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig_{
+					LocalityWeightedLbConfig: &v1.LoadBalancerConfig_LocalityWeightedLbConfig{},
+				},
+			}
+			params.Snapshot.Endpoints[0].LoadBalancingWeight = &types.UInt32Value{Value: 5}
+			translate()
+
+			cla := loadAssignment()
+			Expect(cla.Endpoints).To(HaveLen(2))
+			Expect(cla.Endpoints[1].LbEndpoints[0].LoadBalancingWeight.GetValue()).To(Equal(uint32(5)))
+			Expect(cla.Endpoints[1].LbEndpoints[1].LoadBalancingWeight).To(BeNil())
+			Expect(cla.Endpoints[0].LoadBalancingWeight.GetValue()).To(Equal(uint32(1)))
+			Expect(cla.Endpoints[1].LoadBalancingWeight.GetValue()).To(Equal(uint32(6)))
+		})
+	})
+
+	Context("slow start", func() {
+		BeforeEach(func() {
+			upstream.UpstreamType = &v1.Upstream_Kube{
+				Kube: &v1kubernetes.UpstreamSpec{},
+			}
+			window := time.Hour
+			upstream.LoadBalancerConfig = &v1.LoadBalancerConfig{
+				SlowStartWindow: &window,
+			}
+		})
+
+		weights := func() map[string]uint32 {
+			clusterName := UpstreamToClusterName(upstream.Metadata.Ref())
+			Expect(endpoints.Items).To(HaveKey(clusterName))
+			cla := endpoints.Items[clusterName].ResourceProto().(*envoyapi.ClusterLoadAssignment)
+			weights := map[string]uint32{}
+			for _, lbEndpoint := range cla.Endpoints[0].LbEndpoints {
+				weights[lbEndpoint.GetEndpoint().GetAddress().GetSocketAddress().GetAddress()] = lbEndpoint.GetLoadBalancingWeight().GetValue()
+			}
+			return weights
+		}
+
+		It("lowers the weights of endpoints discovered after the first translation", func() {
+			translate()
+			Expect(weights()).To(Equal(map[string]uint32{"1.2.3.4": SlowStartFullWeight}))
+			Expect(translator.(SlowStartScheduler).NextSlowStartUpdate().IsZero()).To(BeTrue())
+
+			ref := upstream.Metadata.Ref()
+			params.Snapshot.Endpoints = append(params.Snapshot.Endpoints, &v1.Endpoint{
+				Upstreams: []*core.ResourceRef{&ref},
+				Address:   "5.6.7.8",
+				Port:      80,
+				Metadata:  core.Metadata{Name: "new-ep", Namespace: "gloo-system"},
+			})
+			translate()
+			Expect(weights()).To(Equal(map[string]uint32{"1.2.3.4": SlowStartFullWeight, "5.6.7.8": SlowStartFullWeight / 10}))
+			next := translator.(SlowStartScheduler).NextSlowStartUpdate()
+			Expect(next).To(BeTemporally("~", time.Now().Add(6*time.Minute), time.Minute))
+		})
+	})
+
 	Context("when handling subsets", func() {
 		var (
 			claConfiguration *envoyapi.ClusterLoadAssignment
@@ -1551,6 +2027,89 @@ var _ = Describe("Translator", func() {
 
 	})
 
+	Context("dynamic forward proxy", func() {
+
+		var dfpConfig *dynamic_forward_proxy.FilterConfig
+
+		BeforeEach(func() {
+			refreshRate := time.Minute
+			dfpConfig = &dynamic_forward_proxy.FilterConfig{
+				DnsCacheConfig: &dynamic_forward_proxy.DnsCacheConfig{
+					DnsLookupFamily: dynamic_forward_proxy.DnsLookupFamily_V4_ONLY,
+					DnsRefreshRate:  &refreshRate,
+					MaxHosts:        &types.UInt32Value{Value: 100},
+				},
+			}
+			routes = append(routes, &v1.Route{
+				Name: "dfpRoute",
+				Matchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/egress"},
+				}},
+				Action: &v1.Route_RouteAction{
+					RouteAction: &v1.RouteAction{
+						Destination: &v1.RouteAction_DynamicForwardProxy{
+							DynamicForwardProxy: &dynamic_forward_proxy.PerRouteConfig{
+								HostRewriteSpecifier: &dynamic_forward_proxy.PerRouteConfig_AutoHostRewriteHeader{
+									AutoHostRewriteHeader: "x-egress-host",
+								},
+							},
+						},
+					},
+				},
+			})
+		})
+
+		It("routes to a dynamic forward proxy cluster sharing a dns cache with the filter", func() {
+			proxy.Listeners[0].GetHttpListener().Options = &v1.HttpListenerOptions{
+				DynamicForwardProxy: dfpConfig,
+			}
+			translate()
+
+			clusterName := DynamicForwardProxyClusterName(dfpConfig)
+			dfpRoute := routeConfiguration.VirtualHosts[0].Routes[1]
+			Expect(dfpRoute.GetRoute().GetCluster()).To(Equal(clusterName))
+
+			var perRouteConfig envoydfpfilter.PerRouteConfig
+			err := conversion.StructToMessage(dfpRoute.GetPerFilterConfig()[DynamicForwardProxyFilterName], &perRouteConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perRouteConfig.GetAutoHostRewriteHeader()).To(Equal("x-egress-host"))
+
+			// the filter must come right before the router
+			httpFilters := hcmCfg.GetHttpFilters()
+			Expect(len(httpFilters)).To(BeNumerically(">=", 2))
+			dfpFilter := httpFilters[len(httpFilters)-2]
+			Expect(dfpFilter.GetName()).To(Equal(DynamicForwardProxyFilterName))
+			var filterConfig envoydfpfilter.FilterConfig
+			err = ParseConfig(dfpFilter, &filterConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedDnsCacheConfig := &envoydfpcommon.DnsCacheConfig{
+				Name:            clusterName,
+				DnsLookupFamily: envoyapi.Cluster_V4_ONLY,
+				DnsRefreshRate:  &duration.Duration{Seconds: 60},
+				MaxHosts:        &wrappers.UInt32Value{Value: 100},
+			}
+			Expect(filterConfig.GetDnsCacheConfig()).To(Equal(expectedDnsCacheConfig))
+
+			clusters := snapshot.GetResources(xds.ClusterType)
+			Expect(clusters.Items).To(HaveKey(clusterName))
+			dfpCluster := clusters.Items[clusterName].ResourceProto().(*envoyapi.Cluster)
+			Expect(dfpCluster.GetLbPolicy()).To(Equal(envoyapi.Cluster_CLUSTER_PROVIDED))
+			Expect(dfpCluster.GetClusterType().GetName()).To(Equal(DynamicForwardProxyClusterType))
+			var clusterConfig envoydfpcluster.ClusterConfig
+			err = ptypes.UnmarshalAny(dfpCluster.GetClusterType().GetTypedConfig(), &clusterConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterConfig.GetDnsCacheConfig()).To(Equal(expectedDnsCacheConfig))
+		})
+
+		It("errors when the listener does not enable the dynamic forward proxy", func() {
+			report := translateWithError()
+			routeReport := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()[0].GetRouteReports()[1]
+			Expect(routeReport.GetErrors()).To(HaveLen(1))
+			Expect(routeReport.GetErrors()[0].GetReason()).To(ContainSubstring(MissingDynamicForwardProxyErr("http-listener").Error()))
+		})
+	})
+
 	Context("TCP", func() {
 		It("can properly create a tcp listener", func() {
 			translate()
@@ -1877,9 +2436,106 @@ var _ = Describe("Translator", func() {
 				Expect(tlsContext(fc).GetCommonTlsContext().GetValidationContext()).To(BeNil())
 				Expect(fc.FilterChainMatch.ServerNames).To(Equal([]string{"c.com"}))
 			})
+
+			It("should warn on virtual hosts served with an expiring certificate", func() {
+				validFor := time.Hour
+				cert, key := gloohelpers.GetCerts(gloohelpers.Params{Hosts: "a.com", ValidFor: &validFor})
+				params.Snapshot.Secrets = append(params.Snapshot.Secrets, &v1.Secret{
+					Metadata: core.Metadata{
+						Name:      "solo",
+						Namespace: "solo.io",
+					},
+					Kind: &v1.Secret_Tls{
+						Tls: &v1.TlsSecret{
+							CertChain:  cert,
+							PrivateKey: key,
+						},
+					},
+				})
+				proxy.Listeners = []*v1.Listener{{
+					Name:        "http-listener",
+					BindAddress: "127.0.0.1",
+					BindPort:    80,
+					ListenerType: &v1.Listener_HttpListener{
+						HttpListener: &v1.HttpListener{
+							VirtualHosts: []*v1.VirtualHost{
+								{
+									Name:    "a",
+									Domains: []string{"a.com"},
+									Routes:  routes,
+								},
+								{
+									Name:    "b",
+									Domains: []string{"b.com"},
+									Routes:  routes,
+								},
+							},
+						},
+					},
+					SslConfigurations: []*v1.SslConfig{{
+						SslSecrets: &v1.SslConfig_SecretRef{
+							SecretRef: &core.ResourceRef{
+								Name:      "solo",
+								Namespace: "solo.io",
+							},
+						},
+						SniDomains: []string{"a.com"},
+					}},
+				}}
+
+				_, errs, report, err := translator.Translate(params, proxy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+				vhReports := report.GetListenerReports()[0].GetHttpListenerReport().GetVirtualHostReports()
+				Expect(vhReports[0].GetWarnings()).To(HaveLen(1))
+				Expect(vhReports[0].GetWarnings()[0].GetType()).To(Equal(validation.VirtualHostReport_Warning_CertificateExpiryWarning))
+				Expect(vhReports[1].GetWarnings()).To(BeEmpty())
+			})
 		})
 	})
 
+	It("should accept the snapshot of upstreams that use an expiring client certificate", func() {
+		validFor := 60 * 24 * time.Hour
+		cert, key := gloohelpers.GetCerts(gloohelpers.Params{Hosts: "test", ValidFor: &validFor})
+		params.Snapshot.Secrets = append(params.Snapshot.Secrets, &v1.Secret{
+			Metadata: core.Metadata{
+				Name:      "client",
+				Namespace: "gloo-system",
+			},
+			Kind: &v1.Secret_Tls{
+				Tls: &v1.TlsSecret{
+					CertChain:  cert,
+					PrivateKey: key,
+				},
+			},
+		})
+		upstream.SslConfig = &v1.UpstreamSslConfig{
+			SslSecrets: &v1.UpstreamSslConfig_SecretRef{
+				SecretRef: &core.ResourceRef{
+					Name:      "client",
+					Namespace: "gloo-system",
+				},
+			},
+		}
+
+		// the certificate expires within the warning period 45 days from now
+		now := time.Now().Add(45 * 24 * time.Hour)
+		getPlugins := func() []plugins.Plugin {
+			return registeredPlugins
+		}
+		translator = NewTranslatorWithClock(sslutils.NewSslConfigTranslator(), settings, getPlugins, func() time.Time { return now })
+
+		snap, errs, _, err := translator.Translate(params, proxy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errs.ValidateStrict()).NotTo(HaveOccurred())
+
+		routeSanitizer, err := sanitizer.NewRouteReplacingSanitizer(&v1.GlooOptions_InvalidConfigPolicy{})
+		Expect(err).NotTo(HaveOccurred())
+		sanitized, err := routeSanitizer.SanitizeSnapshot(context.Background(), params.Snapshot, snap, errs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sanitized).To(Equal(snap))
+	})
+
 	It("Should report an error for virtual services with empty domains", func() {
 		virtualHosts := []*v1.VirtualHost{
 			{
@@ -0,0 +1,189 @@
+package consul
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go"
+	consulapi "github.com/hashicorp/consul/api"
+)
+
+const (
+	// The names of the SDS secrets the Connect certificates are served as to envoy
+	ConnectLeafCertSecretName = "consul_connect_leaf_cert"
+	ConnectRootsSecretName    = "consul_connect_roots"
+)
+
+// The certificates used to originate Consul Connect mTLS to the proxies of Connect-enabled services
+type ConnectCertificates struct {
+	// The PEM encoded leaf certificate and private key issued by the Connect CA for the service Gloo identifies as
+	CertChain  string
+	PrivateKey string
+	// The PEM encoded certificates of the trusted Connect CA roots, used to validate the certificates of the proxies
+	RootCas string
+}
+
+// Matches the SPIFFE IDs of the leaf certificates that Consul Connect issues to the given service.
+// The trust domain and data center of the service are not known in advance, so any are accepted: the
+// certificates are validated against the roots of the Connect CA anyway.
+func ConnectServiceSpiffeIdRegex(service string) string {
+	return fmt.Sprintf("^spiffe://[^/]+/ns/[^/]+/dc/[^/]+/svc/%s$", regexp.QuoteMeta(service))
+}
+
+// Watches the Connect leaf certificate of the given service and the Connect CA roots using blocking queries against
+// the local agent. The agent renews the leaf certificate before it expires, and whenever the CA roots are rotated.
+// Sends the certificates once both are known, and then whenever either of them changes.
+func WatchConnectCertificates(ctx context.Context, client ConsulClient, service string) (<-chan *ConnectCertificates, <-chan error) {
+	var (
+		wg         sync.WaitGroup
+		leafChan   = make(chan *consulapi.LeafCert)
+		rootsChan  = make(chan *consulapi.CARootList)
+		errorChan  = make(chan error)
+		outputChan = make(chan *ConnectCertificates)
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var leaf *consulapi.LeafCert
+		watchBlockingQuery(ctx, errorChan, func(q *consulapi.QueryOptions) (uint64, error) {
+			var (
+				queryMeta *consulapi.QueryMeta
+				err       error
+			)
+			leaf, queryMeta, err = client.ConnectCALeaf(service, q)
+			if err != nil {
+				return 0, err
+			}
+			return queryMeta.LastIndex, nil
+		}, func() bool {
+			select {
+			case leafChan <- leaf:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var roots *consulapi.CARootList
+		watchBlockingQuery(ctx, errorChan, func(q *consulapi.QueryOptions) (uint64, error) {
+			var (
+				queryMeta *consulapi.QueryMeta
+				err       error
+			)
+			roots, queryMeta, err = client.ConnectCARoots(q)
+			if err != nil {
+				return 0, err
+			}
+			return queryMeta.LastIndex, nil
+		}, func() bool {
+			select {
+			case rootsChan <- roots:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	go func() {
+		// Wait for the query routines to shut down to avoid writing to closed channels
+		wg.Wait()
+		close(errorChan)
+	}()
+
+	go func() {
+		defer close(outputChan)
+		var (
+			leaf  *consulapi.LeafCert
+			roots *consulapi.CARootList
+		)
+		for {
+			select {
+			case leaf = <-leafChan:
+			case roots = <-rootsChan:
+			case <-ctx.Done():
+				return
+			}
+			if leaf == nil || roots == nil {
+				continue
+			}
+
+			select {
+			case outputChan <- toConnectCertificates(leaf, roots):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return outputChan, errorChan
+}
+
+// Runs the given blocking query until the context is cancelled, and calls onChange whenever its index changes.
+// Stops if onChange returns false.
+func watchBlockingQuery(ctx context.Context, errorChan chan<- error, query func(q *consulapi.QueryOptions) (uint64, error), onChange func() bool) {
+	lastIndex := uint64(0)
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		var index uint64
+		// Use a back-off retry strategy to avoid flooding the error channel
+		err := retry.Do(
+			func() error {
+				var err error
+
+				// The first invocation (with lastIndex equal to zero) will return immediately
+				index, err = query((&consulapi.QueryOptions{
+					WaitIndex: lastIndex,
+				}).WithContext(ctx))
+
+				return err
+			},
+			retry.Attempts(6),
+			//  Last delay is 2^6 * 100ms = 3.2s
+			retry.Delay(100*time.Millisecond),
+			retry.DelayType(retry.BackOffDelay),
+		)
+
+		if err != nil {
+			select {
+			case errorChan <- err:
+			case <-ctx.Done():
+				return
+			}
+			continue
+		}
+
+		// If index is the same, there have been no changes since last query
+		if index == lastIndex {
+			continue
+		}
+		if !onChange() {
+			return
+		}
+		lastIndex = index
+	}
+}
+
+func toConnectCertificates(leaf *consulapi.LeafCert, roots *consulapi.CARootList) *ConnectCertificates {
+	var rootCas []string
+	for _, root := range roots.Roots {
+		rootCas = append(rootCas, strings.TrimSpace(root.RootCertPEM))
+	}
+	return &ConnectCertificates{
+		CertChain:  leaf.CertPEM,
+		PrivateKey: leaf.PrivateKeyPEM,
+		RootCas:    strings.Join(rootCas, "\n") + "\n",
+	}
+}
@@ -0,0 +1,79 @@
+package consul_test
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/golang/mock/gomock"
+	consulapi "github.com/hashicorp/consul/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul/mocks"
+)
+
+var _ = Describe("Connect", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		ctrl   *gomock.Controller
+		client *MockConsulClient
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctrl = gomock.NewController(T)
+		client = NewMockConsulClient(ctrl)
+	})
+
+	AfterEach(func() {
+		if cancel != nil {
+			cancel()
+		}
+		ctrl.Finish()
+	})
+
+	// Simulates a blocking query that returns immediately for the first query, and then blocks until the context
+	// of the query is cancelled.
+	blockAfterFirstQuery := func(q *consulapi.QueryOptions) {
+		if q.WaitIndex > 0 {
+			<-q.Context().Done()
+		}
+	}
+
+	It("sends the leaf certificate of the service and the CA roots", func() {
+		client.EXPECT().ConnectCALeaf("gloo", gomock.Any()).DoAndReturn(
+			func(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+				blockAfterFirstQuery(q)
+				return &consulapi.LeafCert{CertPEM: "cert", PrivateKeyPEM: "key"}, &consulapi.QueryMeta{LastIndex: 1}, q.Context().Err()
+			}).AnyTimes()
+		client.EXPECT().ConnectCARoots(gomock.Any()).DoAndReturn(
+			func(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+				blockAfterFirstQuery(q)
+				return &consulapi.CARootList{Roots: []*consulapi.CARoot{
+					{RootCertPEM: "root-1\n"},
+					{RootCertPEM: "root-2"},
+				}}, &consulapi.QueryMeta{LastIndex: 1}, q.Context().Err()
+			}).AnyTimes()
+
+		certsChan, errChan := WatchConnectCertificates(ctx, client, "gloo")
+
+		var certs *ConnectCertificates
+		Eventually(certsChan).Should(Receive(&certs))
+		Expect(certs).To(Equal(&ConnectCertificates{
+			CertChain:  "cert",
+			PrivateKey: "key",
+			RootCas:    "root-1\nroot-2\n",
+		}))
+		Consistently(errChan).ShouldNot(Receive())
+	})
+
+	It("matches the SPIFFE IDs of the service", func() {
+		spiffeId := regexp.MustCompile(ConnectServiceSpiffeIdRegex("my.svc"))
+		Expect(spiffeId.MatchString("spiffe://1234.consul/ns/default/dc/dc1/svc/my.svc")).To(BeTrue())
+		Expect(spiffeId.MatchString("spiffe://1234.consul/ns/default/dc/dc1/svc/my-svc")).To(BeFalse())
+		Expect(spiffeId.MatchString("spiffe://1234.consul/ns/default/dc/dc1/svc/my.svc-proxy")).To(BeFalse())
+	})
+})
@@ -24,6 +24,15 @@ type ConsulClient interface {
 	Service(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error)
 	// Connect is used to query catalog entries for a given Connect-enabled service
 	Connect(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.CatalogService, *consulapi.QueryMeta, error)
+	// ServiceHealth is used to query the instances of a given service together with their health checks,
+	// including the checks of the nodes they are registered on
+	ServiceHealth(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.ServiceEntry, *consulapi.QueryMeta, error)
+	// ConnectHealth is used to query the Connect-capable instances of a given service together with their health checks
+	ConnectHealth(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.ServiceEntry, *consulapi.QueryMeta, error)
+	// ConnectCARoots is used to query the trusted Connect CA roots from the local agent
+	ConnectCARoots(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error)
+	// ConnectCALeaf is used to query the Connect leaf certificate of a given service from the local agent
+	ConnectCALeaf(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error)
 }
 
 func NewConsulClient(client *consulapi.Client, dataCenters []string) (ConsulClient, error) {
@@ -73,6 +82,29 @@ func (c *consul) Connect(service, tag string, q *consulapi.QueryOptions) ([]*con
 	return c.api.Catalog().Connect(service, tag, q)
 }
 
+func (c *consul) ServiceHealth(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.ServiceEntry, *consulapi.QueryMeta, error) {
+	if err := c.validateDataCenter(q.Datacenter); err != nil {
+		return nil, nil, err
+	}
+	return c.api.Health().Service(service, tag, false, q)
+}
+
+func (c *consul) ConnectHealth(service, tag string, q *consulapi.QueryOptions) ([]*consulapi.ServiceEntry, *consulapi.QueryMeta, error) {
+	if err := c.validateDataCenter(q.Datacenter); err != nil {
+		return nil, nil, err
+	}
+	return c.api.Health().Connect(service, tag, false, q)
+}
+
+// The Connect CA endpoints are served by the local agent, so the data center whitelist does not apply
+func (c *consul) ConnectCARoots(q *consulapi.QueryOptions) (*consulapi.CARootList, *consulapi.QueryMeta, error) {
+	return c.api.Agent().ConnectCARoots(q)
+}
+
+func (c *consul) ConnectCALeaf(service string, q *consulapi.QueryOptions) (*consulapi.LeafCert, *consulapi.QueryMeta, error) {
+	return c.api.Agent().ConnectCALeaf(service, q)
+}
+
 // Filters out the data centers not listed in the config
 func (c *consul) filter(dataCenters []string) []string {
 
@@ -96,3 +96,67 @@ func (mr *MockConsulClientMockRecorder) Connect(service, tag, q interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockConsulClient)(nil).Connect), service, tag, q)
 }
+
+// ServiceHealth mocks base method
+func (m *MockConsulClient) ServiceHealth(service, tag string, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceHealth", service, tag, q)
+	ret0, _ := ret[0].([]*api.ServiceEntry)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ServiceHealth indicates an expected call of ServiceHealth
+func (mr *MockConsulClientMockRecorder) ServiceHealth(service, tag, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceHealth", reflect.TypeOf((*MockConsulClient)(nil).ServiceHealth), service, tag, q)
+}
+
+// ConnectHealth mocks base method
+func (m *MockConsulClient) ConnectHealth(service, tag string, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectHealth", service, tag, q)
+	ret0, _ := ret[0].([]*api.ServiceEntry)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectHealth indicates an expected call of ConnectHealth
+func (mr *MockConsulClientMockRecorder) ConnectHealth(service, tag, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectHealth", reflect.TypeOf((*MockConsulClient)(nil).ConnectHealth), service, tag, q)
+}
+
+// ConnectCARoots mocks base method
+func (m *MockConsulClient) ConnectCARoots(q *api.QueryOptions) (*api.CARootList, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCARoots", q)
+	ret0, _ := ret[0].(*api.CARootList)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCARoots indicates an expected call of ConnectCARoots
+func (mr *MockConsulClientMockRecorder) ConnectCARoots(q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCARoots", reflect.TypeOf((*MockConsulClient)(nil).ConnectCARoots), q)
+}
+
+// ConnectCALeaf mocks base method
+func (m *MockConsulClient) ConnectCALeaf(service string, q *api.QueryOptions) (*api.LeafCert, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCALeaf", service, q)
+	ret0, _ := ret[0].(*api.LeafCert)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCALeaf indicates an expected call of ConnectCALeaf
+func (mr *MockConsulClientMockRecorder) ConnectCALeaf(service, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCALeaf", reflect.TypeOf((*MockConsulClient)(nil).ConnectCALeaf), service, q)
+}
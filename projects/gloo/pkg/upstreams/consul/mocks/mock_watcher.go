@@ -99,6 +99,70 @@ func (mr *MockConsulWatcherMockRecorder) Connect(service, tag, q interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockConsulWatcher)(nil).Connect), service, tag, q)
 }
 
+// ServiceHealth mocks base method
+func (m *MockConsulWatcher) ServiceHealth(service, tag string, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceHealth", service, tag, q)
+	ret0, _ := ret[0].([]*api.ServiceEntry)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ServiceHealth indicates an expected call of ServiceHealth
+func (mr *MockConsulWatcherMockRecorder) ServiceHealth(service, tag, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceHealth", reflect.TypeOf((*MockConsulWatcher)(nil).ServiceHealth), service, tag, q)
+}
+
+// ConnectHealth mocks base method
+func (m *MockConsulWatcher) ConnectHealth(service, tag string, q *api.QueryOptions) ([]*api.ServiceEntry, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectHealth", service, tag, q)
+	ret0, _ := ret[0].([]*api.ServiceEntry)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectHealth indicates an expected call of ConnectHealth
+func (mr *MockConsulWatcherMockRecorder) ConnectHealth(service, tag, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectHealth", reflect.TypeOf((*MockConsulWatcher)(nil).ConnectHealth), service, tag, q)
+}
+
+// ConnectCARoots mocks base method
+func (m *MockConsulWatcher) ConnectCARoots(q *api.QueryOptions) (*api.CARootList, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCARoots", q)
+	ret0, _ := ret[0].(*api.CARootList)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCARoots indicates an expected call of ConnectCARoots
+func (mr *MockConsulWatcherMockRecorder) ConnectCARoots(q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCARoots", reflect.TypeOf((*MockConsulWatcher)(nil).ConnectCARoots), q)
+}
+
+// ConnectCALeaf mocks base method
+func (m *MockConsulWatcher) ConnectCALeaf(service string, q *api.QueryOptions) (*api.LeafCert, *api.QueryMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectCALeaf", service, q)
+	ret0, _ := ret[0].(*api.LeafCert)
+	ret1, _ := ret[1].(*api.QueryMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConnectCALeaf indicates an expected call of ConnectCALeaf
+func (mr *MockConsulWatcherMockRecorder) ConnectCALeaf(service, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectCALeaf", reflect.TypeOf((*MockConsulWatcher)(nil).ConnectCALeaf), service, q)
+}
+
 // WatchServices mocks base method
 func (m *MockConsulWatcher) WatchServices(ctx context.Context, dataCenters []string) (<-chan []*consul.ServiceMeta, <-chan error) {
 	m.ctrl.T.Helper()
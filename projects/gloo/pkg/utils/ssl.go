@@ -1,6 +1,11 @@
 package utils
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	v2alpha "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v2alpha"
@@ -15,6 +20,9 @@ import (
 
 const (
 	MetadataPluginName = "envoy.grpc_credentials.file_based_metadata"
+
+	// certificates that expire within this period are reported on the resources that use them
+	DefaultCertificateExpiryWarningPeriod = 30 * 24 * time.Hour
 )
 
 var (
@@ -31,6 +39,16 @@ var (
 	}
 
 	NoCertificateFoundError = eris.New("no certificate information found")
+
+	NoPemCertificateError = eris.New("no PEM encoded certificate found")
+
+	CertificateExpiredWarning = func(ref core.ResourceRef, notAfter time.Time) string {
+		return fmt.Sprintf("the certificate of TLS secret %v expired on %v", ref.Key(), notAfter.UTC().Format(time.RFC3339))
+	}
+
+	CertificateExpiresSoonWarning = func(ref core.ResourceRef, notAfter time.Time) string {
+		return fmt.Sprintf("the certificate of TLS secret %v expires on %v", ref.Key(), notAfter.UTC().Format(time.RFC3339))
+	}
 )
 
 type SslConfigTranslator interface {
@@ -333,3 +351,72 @@ func convertVersion(v v1.SslParameters_ProtocolVersion) (envoyauth.TlsParameters
 
 	return envoyauth.TlsParameters_TLS_AUTO, TlsVersionNotFoundError(v)
 }
+
+// CertificateNotAfter returns the earliest expiry date of the PEM encoded certificates, e.g. of a certificate chain.
+func CertificateNotAfter(certs string) (time.Time, error) {
+	var notAfter time.Time
+	rest := []byte(certs)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if notAfter.IsZero() || cert.NotAfter.Before(notAfter) {
+			notAfter = cert.NotAfter
+		}
+	}
+	if notAfter.IsZero() {
+		return time.Time{}, NoPemCertificateError
+	}
+	return notAfter, nil
+}
+
+// SecretCertificateNotAfter returns the earliest expiry date of the certificate chain and root CA of a TLS secret.
+func SecretCertificateNotAfter(ref core.ResourceRef, secrets v1.SecretList) (time.Time, error) {
+	certChain, _, rootCa, err := getSslSecrets(ref, secrets)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var notAfter time.Time
+	for _, certs := range []string{certChain, rootCa} {
+		if certs == "" {
+			continue
+		}
+		certsNotAfter, err := CertificateNotAfter(certs)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if notAfter.IsZero() || certsNotAfter.Before(notAfter) {
+			notAfter = certsNotAfter
+		}
+	}
+	if notAfter.IsZero() {
+		return time.Time{}, NoPemCertificateError
+	}
+	return notAfter, nil
+}
+
+// CertificateExpiryWarning returns a warning if the certificates of the referenced TLS secret expired or expire
+// within the given period, and an empty string otherwise. Secrets that cannot be found or parsed are not reported
+// here, as they are reported when the ssl config is resolved.
+func CertificateExpiryWarning(ref core.ResourceRef, secrets v1.SecretList, now time.Time, period time.Duration) string {
+	notAfter, err := SecretCertificateNotAfter(ref, secrets)
+	if err != nil {
+		return ""
+	}
+	if !now.Before(notAfter) {
+		return CertificateExpiredWarning(ref, notAfter)
+	}
+	if notAfter.Sub(now) <= period {
+		return CertificateExpiresSoonWarning(ref, notAfter)
+	}
+	return ""
+}
@@ -1,11 +1,14 @@
 package utils
 
 import (
+	"time"
+
 	envoyauth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoycore "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	v2alpha "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v2alpha"
 	"github.com/golang/protobuf/ptypes"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/test/helpers"
 	. "github.com/solo-io/go-utils/testutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 
@@ -358,6 +361,72 @@ var _ = Describe("Ssl", func() {
 		})
 	})
 
+	Context("certificate expiry", func() {
+		var (
+			ref core.ResourceRef
+			now time.Time
+		)
+
+		tlsSecret := func(validFor time.Duration) v1.SecretList {
+			validFrom := now.Add(-time.Hour)
+			validFor += time.Hour
+			cert, key := helpers.GetCerts(helpers.Params{
+				Hosts:     "gloo.solo.io",
+				ValidFrom: &validFrom,
+				ValidFor:  &validFor,
+			})
+			return v1.SecretList{{
+				Metadata: core.Metadata{Name: ref.Name, Namespace: ref.Namespace},
+				Kind: &v1.Secret_Tls{
+					Tls: &v1.TlsSecret{CertChain: cert, PrivateKey: key},
+				},
+			}}
+		}
+
+		BeforeEach(func() {
+			ref = core.ResourceRef{Name: "cert", Namespace: "gloo-system"}
+			now = time.Now().Truncate(time.Second)
+		})
+
+		It("finds the earliest expiry of a certificate chain", func() {
+			validFrom := now.Add(-time.Hour)
+			validForLonger := 48 * time.Hour
+			validForShorter := 24 * time.Hour
+			longer, _ := helpers.GetCerts(helpers.Params{Hosts: "a", ValidFrom: &validFrom, ValidFor: &validForLonger})
+			shorter, _ := helpers.GetCerts(helpers.Params{Hosts: "b", ValidFrom: &validFrom, ValidFor: &validForShorter})
+
+			notAfter, err := CertificateNotAfter(longer + shorter)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notAfter).To(BeTemporally("==", validFrom.Add(validForShorter)))
+		})
+
+		It("errors on data without certificates", func() {
+			_, err := CertificateNotAfter("not a cert")
+			Expect(err).To(MatchError(NoPemCertificateError))
+		})
+
+		It("does not warn about certificates that expire after the warning period", func() {
+			secrets := tlsSecret(DefaultCertificateExpiryWarningPeriod + time.Hour)
+			Expect(CertificateExpiryWarning(ref, secrets, now, DefaultCertificateExpiryWarningPeriod)).To(BeEmpty())
+		})
+
+		It("warns about certificates that expire within the warning period", func() {
+			secrets := tlsSecret(time.Hour)
+			Expect(CertificateExpiryWarning(ref, secrets, now, DefaultCertificateExpiryWarningPeriod)).To(
+				Equal(CertificateExpiresSoonWarning(ref, now.Add(time.Hour))))
+		})
+
+		It("warns about expired certificates", func() {
+			secrets := tlsSecret(-time.Minute)
+			Expect(CertificateExpiryWarning(ref, secrets, now, DefaultCertificateExpiryWarningPeriod)).To(
+				Equal(CertificateExpiredWarning(ref, now.Add(-time.Minute))))
+		})
+
+		It("does not warn about missing secrets", func() {
+			Expect(CertificateExpiryWarning(ref, nil, now, DefaultCertificateExpiryWarningPeriod)).To(BeEmpty())
+		})
+	})
+
 })
 
 func ValidateCommonContextFiles(tlsCfg *envoyauth.CommonTlsContext, err error) {
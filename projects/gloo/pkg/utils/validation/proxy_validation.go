@@ -93,6 +93,14 @@ func GetRouteErr(route *validation.RouteReport) []error {
 	return errs
 }
 
+func GetVirtualHostWarning(virtualHost *validation.VirtualHostReport) []string {
+	var warnings []string
+	for _, warning := range virtualHost.GetWarnings() {
+		warnings = append(warnings, fmt.Sprintf("%v Warning: %v. Reason: %v", "VirtualHost", warning.Type.String(), warning.Reason))
+	}
+	return warnings
+}
+
 func GetRouteWarning(route *validation.RouteReport) []string {
 	var warnings []string
 	appendWarning := func(level, errType, reason string) {
@@ -165,6 +173,8 @@ func GetProxyError(proxyRpt *validation.ProxyReport) error {
 	return combinedErr
 }
 
+// GetProxyWarning returns the route warnings of the proxy, which reject the proxy like errors do.
+// Virtual host warnings are not included, they are only surfaced on the virtual services.
 func GetProxyWarning(proxyRpt *validation.ProxyReport) []string {
 	var warnings []string
 
@@ -199,6 +209,13 @@ func AppendVirtualHostError(virtualHostReport *validation.VirtualHostReport, err
 	})
 }
 
+func AppendVirtualHostWarning(virtualHostReport *validation.VirtualHostReport, warningType validation.VirtualHostReport_Warning_Type, reason string) {
+	virtualHostReport.Warnings = append(virtualHostReport.Warnings, &validation.VirtualHostReport_Warning{
+		Type:   warningType,
+		Reason: reason,
+	})
+}
+
 func AppendHTTPListenerError(httpListenerReport *validation.HttpListenerReport, errType validation.HttpListenerReport_Error_Type, reason string) {
 	httpListenerReport.Errors = append(httpListenerReport.Errors, &validation.HttpListenerReport_Error{
 		Type:   errType,
@@ -2,6 +2,10 @@ package main
 
 import (
 	"context"
+	"os"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 
 	"github.com/solo-io/gloo/pkg/version"
 	"github.com/solo-io/gloo/projects/sds/pkg/run"
@@ -18,6 +22,14 @@ var (
 	// This must match the value of the sds_config target_uri in the envoy instance that it is providing
 	// secrets to.
 	sdsServerAddress = "127.0.0.1:8234"
+
+	// The name of the consul service gloo identifies as when originating consul connect mTLS.
+	// If set, the connect leaf certificate of that service and the connect CA roots are served as well.
+	consulConnectServiceEnv = "CONSUL_CONNECT_SERVICE"
+
+	// Set to "false" to not serve the gloo mtls certificates, e.g. if the server only serves the consul connect
+	// certificates. They are served by default.
+	glooMtlsEnabledEnv = "GLOO_MTLS_ENABLED"
 )
 
 func main() {
@@ -25,7 +37,37 @@ func main() {
 	ctx := contextutils.WithLogger(context.Background(), "sds_server")
 	ctx = contextutils.WithLoggerValues(ctx, "version", version.Version)
 
-	if err := run.Run(ctx, sslKeyFile, sslCertFile, sslCaFile, sdsServerAddress); err != nil {
+	connectOpts, err := consulConnectOptions()
+	if err != nil {
 		contextutils.LoggerFrom(ctx).Fatal(err)
 	}
+
+	if os.Getenv(glooMtlsEnabledEnv) == "false" {
+		sslKeyFile, sslCertFile, sslCaFile = "", "", ""
+	}
+
+	if err := run.RunWithConsulConnect(ctx, sslKeyFile, sslCertFile, sslCaFile, sdsServerAddress, connectOpts); err != nil {
+		contextutils.LoggerFrom(ctx).Fatal(err)
+	}
+}
+
+// The consul connect certificates are only served if the service gloo identifies as is set. The consul agent
+// is configured with the standard consul environment variables (e.g. CONSUL_HTTP_ADDR, CONSUL_HTTP_TOKEN).
+func consulConnectOptions() (*run.ConsulConnectOptions, error) {
+	service := os.Getenv(consulConnectServiceEnv)
+	if service == "" {
+		return nil, nil
+	}
+	apiClient, err := consulapi.NewClient(consulapi.DefaultConfig())
+	if err != nil {
+		return nil, err
+	}
+	client, err := consul.NewConsulClient(apiClient, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &run.ConsulConnectOptions{
+		Client:  client,
+		Service: service,
+	}, nil
 }
@@ -10,11 +10,25 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	"github.com/solo-io/gloo/projects/sds/pkg/server"
 	"github.com/solo-io/go-utils/contextutils"
 )
 
+// Options to also serve the consul connect certificates of a service, used by envoy to originate connect mTLS
+type ConsulConnectOptions struct {
+	Client consul.ConsulClient
+	// The service whose connect leaf certificate is served
+	Service string
+}
+
 func Run(ctx context.Context, sslKeyFile, sslCertFile, sslCaFile, sdsServerAddress string) error {
+	return RunWithConsulConnect(ctx, sslKeyFile, sslCertFile, sslCaFile, sdsServerAddress, nil)
+}
+
+// RunWithConsulConnect also serves the consul connect certificates if the options are set. The gloo mtls
+// certificates are not served if their files are empty, e.g. if gloo mtls is disabled.
+func RunWithConsulConnect(ctx context.Context, sslKeyFile, sslCertFile, sslCaFile, sdsServerAddress string, connectOpts *ConsulConnectOptions) error {
 	ctx, cancel := context.WithCancel(ctx)
 
 	// Set up the gRPC server
@@ -39,6 +53,16 @@ func Run(ctx context.Context, sslKeyFile, sslCertFile, sslCaFile, sdsServerAddre
 	}
 	defer watcher.Close()
 
+	// Watch the consul connect certificates, if enabled. They are added to the snapshot once they are known.
+	var (
+		connectCerts     *consul.ConnectCertificates
+		connectCertsChan <-chan *consul.ConnectCertificates
+		connectErrChan   <-chan error
+	)
+	if connectOpts != nil {
+		connectCertsChan, connectErrChan = consul.WatchConnectCertificates(ctx, connectOpts.Client, connectOpts.Service)
+	}
+
 	// Wire in signal handling
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
@@ -49,8 +73,23 @@ func Run(ctx context.Context, sslKeyFile, sslCertFile, sslCaFile, sdsServerAddre
 			// watch for events
 			case event := <-watcher.Events:
 				contextutils.LoggerFrom(ctx).Infow("received event", zap.Any("event", event))
-				server.UpdateSDSConfig(ctx, sslKeyFile, sslCertFile, sslCaFile, snapshotCache)
+				server.UpdateSDSConfigWithConsulConnect(ctx, sslKeyFile, sslCertFile, sslCaFile, connectCerts, snapshotCache)
 				watchFiles(ctx, watcher, sslKeyFile, sslCertFile, sslCaFile)
+			// watch for consul connect certificate rotations
+			case certs, ok := <-connectCertsChan:
+				if !ok {
+					connectCertsChan = nil
+					continue
+				}
+				contextutils.LoggerFrom(ctx).Infof("received consul connect certificates for service %s", connectOpts.Service)
+				connectCerts = certs
+				server.UpdateSDSConfigWithConsulConnect(ctx, sslKeyFile, sslCertFile, sslCaFile, connectCerts, snapshotCache)
+			case err, ok := <-connectErrChan:
+				if !ok {
+					connectErrChan = nil
+					continue
+				}
+				contextutils.LoggerFrom(ctx).Warnw("Received error from consul connect certificate watch", zap.Error(err))
 			// watch for errors
 			case err := <-watcher.Errors:
 				contextutils.LoggerFrom(ctx).Warnw("Received error from file watcher", zap.Error(err))
@@ -59,7 +98,9 @@ func Run(ctx context.Context, sslKeyFile, sslCertFile, sslCaFile, sdsServerAddre
 			}
 		}
 	}()
-	watchFiles(ctx, watcher, sslKeyFile, sslCertFile, sslCaFile)
+	if sslKeyFile != "" {
+		watchFiles(ctx, watcher, sslKeyFile, sslCertFile, sslCaFile)
+	}
 
 	<-sigs
 	cancel()
@@ -6,7 +6,9 @@ import (
 	"hash/fnv"
 	"io/ioutil"
 	"net"
+	"strings"
 
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	"github.com/solo-io/go-utils/hashutils"
 	"go.uber.org/zap"
 
@@ -89,18 +91,55 @@ func GetSnapshotVersion(sslKeyFile, sslCertFile, sslCaFile string) (string, erro
 	return fmt.Sprintf("%d", hash), err
 }
 
+// GetConsulConnectSnapshotVersion returns a version identifying the consul connect certificates.
+func GetConsulConnectSnapshotVersion(connectCerts *consul.ConnectCertificates) (string, error) {
+	hash, err := hashutils.HashAllSafe(fnv.New64(), []byte(connectCerts.CertChain), []byte(connectCerts.PrivateKey), []byte(connectCerts.RootCas))
+	return fmt.Sprintf("%d", hash), err
+}
+
 func UpdateSDSConfig(ctx context.Context, sslKeyFile, sslCertFile, sslCaFile string, snapshotCache cache.SnapshotCache) error {
-	snapshotVersion, err := GetSnapshotVersion(sslKeyFile, sslCertFile, sslCaFile)
-	if err != nil {
-		contextutils.LoggerFrom(ctx).Info("Error getting snapshot version", zap.Error(err))
-		return err
+	return UpdateSDSConfigWithConsulConnect(ctx, sslKeyFile, sslCertFile, sslCaFile, nil, snapshotCache)
+}
+
+// UpdateSDSConfigWithConsulConnect also serves the consul connect certificates, if they are not nil.
+// The gloo mtls certificates are not served if their files are not set, e.g. if the server only serves the
+// consul connect certificates.
+func UpdateSDSConfigWithConsulConnect(ctx context.Context, sslKeyFile, sslCertFile, sslCaFile string, connectCerts *consul.ConnectCertificates, snapshotCache cache.SnapshotCache) error {
+	var (
+		versions []string
+		items    []cache_types.Resource
+	)
+	if sslKeyFile != "" {
+		snapshotVersion, err := GetSnapshotVersion(sslKeyFile, sslCertFile, sslCaFile)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Info("Error getting snapshot version", zap.Error(err))
+			return err
+		}
+		versions = append(versions, snapshotVersion)
+		items = append(items,
+			serverCertSecret(sslCertFile, sslKeyFile),
+			validationContextSecret(sslCaFile),
+		)
+	}
+	if connectCerts != nil {
+		connectVersion, err := GetConsulConnectSnapshotVersion(connectCerts)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Info("Error getting consul connect snapshot version", zap.Error(err))
+			return err
+		}
+		versions = append(versions, connectVersion)
+		items = append(items,
+			consulConnectLeafCertSecret(connectCerts),
+			consulConnectRootsSecret(connectCerts),
+		)
 	}
+	if len(items) == 0 {
+		// nothing to serve yet
+		return nil
+	}
+	snapshotVersion := strings.Join(versions, "-")
 	contextutils.LoggerFrom(ctx).Infof("Updating SDS config. Snapshot version is %s", snapshotVersion)
 
-	items := []cache_types.Resource{
-		serverCertSecret(sslCertFile, sslKeyFile),
-		validationContextSecret(sslCaFile),
-	}
 	secretSnapshot := cache.Snapshot{}
 	secretSnapshot.Resources[cache_types.Secret] = cache.NewResources(snapshotVersion, items)
 	return snapshotCache.SetSnapshot(sdsClient, secretSnapshot)
@@ -140,3 +179,38 @@ func validationContextSecret(caFile string) cache_types.Resource {
 		},
 	}
 }
+
+func consulConnectLeafCertSecret(connectCerts *consul.ConnectCertificates) cache_types.Resource {
+	return &auth.Secret{
+		Name: consul.ConnectLeafCertSecretName,
+		Type: &auth.Secret_TlsCertificate{
+			TlsCertificate: &auth.TlsCertificate{
+				CertificateChain: &core.DataSource{
+					Specifier: &core.DataSource_InlineString{
+						InlineString: connectCerts.CertChain,
+					},
+				},
+				PrivateKey: &core.DataSource{
+					Specifier: &core.DataSource_InlineString{
+						InlineString: connectCerts.PrivateKey,
+					},
+				},
+			},
+		},
+	}
+}
+
+func consulConnectRootsSecret(connectCerts *consul.ConnectCertificates) cache_types.Resource {
+	return &auth.Secret{
+		Name: consul.ConnectRootsSecretName,
+		Type: &auth.Secret_ValidationContext{
+			ValidationContext: &auth.CertificateValidationContext{
+				TrustedCa: &core.DataSource{
+					Specifier: &core.DataSource_InlineString{
+						InlineString: connectCerts.RootCas,
+					},
+				},
+			},
+		},
+	}
+}
@@ -3,6 +3,7 @@ package server_test
 import (
 	"context"
 
+	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
 	"github.com/solo-io/gloo/projects/sds/pkg/server"
 
 	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
@@ -75,18 +76,20 @@ var _ = Describe("SDS Server", func() {
 			cancel            context.CancelFunc
 			grpcServer        *grpc.Server
 			snapshotCache     cache.SnapshotCache
+			serverStopped     <-chan struct{}
 			testServerAddress = "127.0.0.1:8236"
 		)
 
 		BeforeEach(func() {
 			ctx, cancel = context.WithCancel(context.Background())
 			grpcServer, snapshotCache = server.SetupEnvoySDS()
-			_, err = server.RunSDSServer(ctx, grpcServer, testServerAddress)
+			serverStopped, err = server.RunSDSServer(ctx, grpcServer, testServerAddress)
 			Expect(err).To(BeNil())
 		})
 
 		AfterEach(func() {
 			cancel()
+			Eventually(serverStopped).Should(Receive())
 		})
 
 		It("accepts client connections", func() {
@@ -111,5 +114,43 @@ var _ = Describe("SDS Server", func() {
 			Expect(len(resp.GetResources())).To(Equal(2))
 			Expect(resp.Validate()).To(BeNil())
 		})
+
+		It("serves the consul connect certificates", func() {
+			conn, err := grpc.Dial(testServerAddress, grpc.WithInsecure())
+			Expect(err).To(BeNil())
+			defer conn.Close()
+
+			client := envoy_service_discovery_v2.NewSecretDiscoveryServiceClient(conn)
+
+			connectCerts := &consul.ConnectCertificates{CertChain: "cert", PrivateKey: "key", RootCas: "root"}
+			err = server.UpdateSDSConfigWithConsulConnect(ctx, keyFile.Name(), certFile.Name(), caFile.Name(), connectCerts, snapshotCache)
+			Expect(err).To(BeNil())
+			resp, err := client.FetchSecrets(ctx, &envoy_api_v2.DiscoveryRequest{})
+			Expect(err).To(BeNil())
+			Expect(len(resp.GetResources())).To(Equal(4))
+			Expect(resp.Validate()).To(BeNil())
+		})
+
+		It("serves only the consul connect certificates if gloo mtls is disabled", func() {
+			conn, err := grpc.Dial(testServerAddress, grpc.WithInsecure())
+			Expect(err).To(BeNil())
+			defer conn.Close()
+
+			client := envoy_service_discovery_v2.NewSecretDiscoveryServiceClient(conn)
+
+			// Nothing is served before the connect certificates are known
+			err = server.UpdateSDSConfig(ctx, "", "", "", snapshotCache)
+			Expect(err).To(BeNil())
+			_, err = client.FetchSecrets(ctx, &envoy_api_v2.DiscoveryRequest{})
+			Expect(err).NotTo(BeNil())
+
+			connectCerts := &consul.ConnectCertificates{CertChain: "cert", PrivateKey: "key", RootCas: "root"}
+			err = server.UpdateSDSConfigWithConsulConnect(ctx, "", "", "", connectCerts, snapshotCache)
+			Expect(err).To(BeNil())
+			resp, err := client.FetchSecrets(ctx, &envoy_api_v2.DiscoveryRequest{})
+			Expect(err).To(BeNil())
+			Expect(len(resp.GetResources())).To(Equal(2))
+			Expect(resp.Validate()).To(BeNil())
+		})
 	})
 })
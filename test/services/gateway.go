@@ -192,12 +192,14 @@ func defaultTestConstructOpts(ctx context.Context, runOptions *RunOptions) trans
 	}
 
 	return translator.Opts{
-		WriteNamespace:  runOptions.NsToWrite,
-		WatchNamespaces: runOptions.NsToWatch,
-		Gateways:        f,
-		VirtualServices: f,
-		RouteTables:     f,
-		Proxies:         f,
+		WriteNamespace:     runOptions.NsToWrite,
+		WatchNamespaces:    runOptions.NsToWatch,
+		Gateways:           f,
+		VirtualServices:    f,
+		RouteTables:        f,
+		VirtualHostOptions: f,
+		RouteOptions:       f,
+		Proxies:            f,
 		WatchOpts: clients.WatchOpts{
 			Ctx:         ctx,
 			RefreshRate: time.Minute,